@@ -0,0 +1,54 @@
+// Command echobot is an example ChatSpace bot that repeats every message
+// posted in a space. Create the bot with the createBot mutation and run:
+//
+//	CHATSPACE_URL=http://localhost:8080/query \
+//	CHATSPACE_BOT_TOKEN=<token> \
+//	CHATSPACE_SPACE_ID=<space id> \
+//	go run ./cmd/echobot
+package main
+
+import (
+	"chatspace-server/config"
+	"chatspace-server/pkg/botsdk"
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	zlog := config.NewLogger()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	bot, err := botsdk.New(botsdk.Options{
+		Endpoint: os.Getenv("CHATSPACE_URL"),
+		Token:    os.Getenv("CHATSPACE_BOT_TOKEN"),
+		OnError: func(err error) {
+			zlog.Warn().Err(err).Msg("Bot error")
+		},
+	})
+	if err != nil {
+		zlog.Fatal().Err(err).Msg("Failed create bot")
+	}
+
+	bot.OnMessage(func(ctx context.Context, bot *botsdk.Bot, msg *botsdk.Message) {
+		if msg.User.Bot {
+			return
+		}
+
+		_, err := bot.Reply(ctx, msg, msg.Content)
+		if err != nil {
+			zlog.Error().Err(err).Str("message_id", msg.ID).Msg("Failed echo message")
+		}
+	})
+
+	zlog.Info().Msg("Echo bot running")
+
+	err = bot.Run(ctx, os.Getenv("CHATSPACE_SPACE_ID"))
+	if err != nil && !errors.Is(err, context.Canceled) {
+		zlog.Fatal().Err(err).Msg("Bot stopped")
+	}
+}
@@ -3,8 +3,10 @@ package main
 import (
 	initialize "chatspace-server/cmd/initialize"
 	"chatspace-server/config"
+	"chatspace-server/constant"
 	"chatspace-server/graph/generated"
 	"chatspace-server/handler/middleware"
+	"chatspace-server/handler/rest"
 	"chatspace-server/handler/resolver"
 	"context"
 	"log"
@@ -39,7 +41,7 @@ func main() {
 		return
 	}
 
-	rsvl, err := resolver.NewResolver(app.UcUser, app.UcSpace, app.UcMessage)
+	rsvl, err := resolver.NewResolver(app.UcUser, app.UcSpace, app.UcMessage, app.UcAttachment, app.UcSavedMessage, app.UcScheduledMessage, app.UcPoll, app.UcSlashCommand, app.UcWebhook, app.UcIncomingWebhook)
 	if err != nil {
 		zlog.Err(err)
 		return
@@ -62,6 +64,10 @@ func main() {
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: maxUploadSize(cfg.Storage.MaxSize),
+		MaxMemory:     32 << 20,
+	})
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
 	srv.Use(extension.Introspection{})
@@ -78,7 +84,17 @@ func main() {
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
 	http.Handle("/query", srv)
+	http.Handle("/attachments/", rest.NewAttachmentHandler(app.UcAttachment, zlog))
+	http.Handle(constant.INCOMING_WEBHOOK_PATH, rest.NewIncomingWebhookHandler(app.UcIncomingWebhook, zlog))
 
 	zlog.Info().Msgf("connect to http://localhost:%s for GraphQL playground", address)
 	log.Fatal(http.ListenAndServe(":"+address, nil))
 }
+
+func maxUploadSize(size int64) int64 {
+	if size > 0 {
+		return size
+	}
+
+	return constant.DEFAULT_ATTACHMENT_MAX_SIZE
+}
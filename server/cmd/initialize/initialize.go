@@ -2,17 +2,31 @@ package initialize
 
 import (
 	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/pkg/jobqueue"
+	"chatspace-server/pkg/safehttp"
+	"chatspace-server/pkg/slashcmd"
+	"chatspace-server/pkg/unfurl"
+	"chatspace-server/pkg/webhook"
 	"chatspace-server/repository"
 	"chatspace-server/usecase"
 	"context"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/rs/zerolog"
 )
 
 type App struct {
-	UcUser    *usecase.UcUser
-	UcSpace   *usecase.UcSpace
-	UcMessage *usecase.UcMessage
+	UcUser             *usecase.UcUser
+	UcSpace            *usecase.UcSpace
+	UcMessage          *usecase.UcMessage
+	UcAttachment       *usecase.UcAttachment
+	UcSavedMessage     *usecase.UcSavedMessage
+	UcScheduledMessage *usecase.UcScheduledMessage
+	UcPoll             *usecase.UcPoll
+	UcSlashCommand     *usecase.UcSlashCommand
+	UcWebhook          *usecase.UcWebhook
+	UcIncomingWebhook  *usecase.UcIncomingWebhook
 }
 
 func Bootstrap(ctx context.Context, cfg *config.Config, zlog zerolog.Logger) (App, error) {
@@ -27,10 +41,29 @@ func Bootstrap(ctx context.Context, cfg *config.Config, zlog zerolog.Logger) (Ap
 	}
 
 	// setup redis
-	zlog.Info().Msg("Initialize Redis")
-	rdsConn, err := config.NewRedis(cfg.Redis)
+	var rdsConn *redis.Client
+	if cfg.UsesRedis() {
+		zlog.Info().Msg("Initialize Redis")
+		rdsConn, err = config.NewRedis(cfg.Redis)
+		if err != nil {
+			zlog.Error().Err(err).Msg("Failed initialize redis")
+			return app, err
+		}
+	}
+
+	// setup broker
+	zlog.Info().Msg("Initialize Broker")
+	msgBroker, err := config.NewBroker(cfg, dbConn, rdsConn, zlog)
 	if err != nil {
-		zlog.Error().Err(err).Msg("Failed initialize redis")
+		zlog.Error().Err(err).Msg("Failed initialize broker")
+		return app, err
+	}
+
+	// setup blob storage
+	zlog.Info().Msg("Initialize Blob Storage")
+	blobStore, err := config.NewBlobStore(ctx, cfg.Storage)
+	if err != nil {
+		zlog.Error().Err(err).Msg("Failed initialize blob storage")
 		return app, err
 	}
 
@@ -38,17 +71,63 @@ func Bootstrap(ctx context.Context, cfg *config.Config, zlog zerolog.Logger) (Ap
 	zlog.Info().Msg("Initialize Repository")
 	repoUser := repository.NewUserRepository(dbConn)
 	repoSpace := repository.NewSpaceRepository(dbConn)
-	repoMessage := repository.NewMessageRepository(dbConn, rdsConn)
+	repoMessage := repository.NewMessageRepository(dbConn, msgBroker)
+	repoAttachment := repository.NewAttachmentRepository(dbConn)
+	repoSavedMessage := repository.NewSavedMessageRepository(dbConn)
+	repoScheduledMessage := repository.NewScheduledMessageRepository(dbConn)
+	repoPoll := repository.NewPollRepository(dbConn)
+	repoSlashCommand := repository.NewSlashCommandRepository(dbConn)
+	repoWebhook := repository.NewWebhookRepository(dbConn)
+	repoIncomingWebhook := repository.NewIncomingWebhookRepository(dbConn, rdsConn)
+	repoOutbox := repository.NewOutboxRepository(dbConn)
+	txManager := repository.NewTxManager(dbConn)
 
 	// setup usecase
 	zlog.Info().Msg("Initialize Usecase")
-	ucUser := usecase.NewUserUsecase(cfg, repoUser, zlog)
-	ucSpace := usecase.NewSpaceUseCase(repoSpace, zlog)
-	ucMessage := usecase.NewMessageUseCase(repoMessage, repoUser, repoSpace, zlog)
+	ucUser := usecase.NewUserUsecase(cfg, repoUser, repoSpace, zlog)
+	unfurlQueue := jobqueue.New("unfurl", constant.UNFURL_QUEUE_SIZE, constant.UNFURL_QUEUE_WORKERS, zlog)
+	linkFetcher := unfurl.NewFetcher(unfurl.Options{})
+	webhookClient := webhook.NewClient(safehttp.NewClient(safehttp.Options{Timeout: constant.WEBHOOK_TIMEOUT}))
+	ucWebhook := usecase.NewWebhookUseCase(repoWebhook, repoSpace, webhookClient, zlog)
+	commandClient := slashcmd.NewClient(safehttp.NewClient(safehttp.Options{Timeout: constant.SLASH_COMMAND_TIMEOUT}), constant.SLASH_COMMAND_MAX_RESPONSE)
+	ucSlashCommand := usecase.NewSlashCommandUseCase(repoSlashCommand, repoSpace, repoUser, repoScheduledMessage, repoMessage, ucWebhook, commandClient, zlog)
+	ucOutbox := usecase.NewOutboxUseCase(repoOutbox, repoMessage, zlog)
+	ucMessage := usecase.NewMessageUseCase(cfg, repoMessage, repoUser, repoSpace, repoAttachment, repoPoll, blobStore, unfurlQueue, linkFetcher, ucSlashCommand, ucWebhook, ucOutbox, txManager, zlog)
+	ucSpace := usecase.NewSpaceUseCase(cfg, repoSpace, ucMessage, txManager, zlog)
+	imageQueue := jobqueue.New("image", constant.IMAGE_QUEUE_SIZE, constant.IMAGE_QUEUE_WORKERS, zlog)
+	ucAttachment := usecase.NewAttachmentUseCase(cfg, repoAttachment, repoSpace, blobStore, imageQueue, ucMessage, zlog)
+	ucSavedMessage := usecase.NewSavedMessageUseCase(repoSavedMessage, repoMessage, repoSpace, ucMessage, zlog)
+	ucScheduledMessage := usecase.NewScheduledMessageUseCase(repoScheduledMessage, repoSpace, ucMessage, zlog)
+	ucRetention := usecase.NewRetentionUseCase(cfg, repoSpace, repoMessage, ucMessage, zlog)
+	ucPoll := usecase.NewPollUseCase(repoPoll, repoSpace, ucMessage, txManager, zlog)
+	ucIncomingWebhook := usecase.NewIncomingWebhookUseCase(cfg, repoIncomingWebhook, repoSpace, ucMessage, ucAttachment, zlog)
+
+	// setup background workers
+	zlog.Info().Msg("Initialize Workers")
+	imageQueue.Start(ctx, ucAttachment.ProcessImage)
+	unfurlQueue.Start(ctx, ucMessage.UnfurlLinks)
+	go func() {
+		if err := ucAttachment.EnqueuePendingImages(ctx); err != nil {
+			zlog.Error().Err(err).Msg("Failed re-enqueue pending images")
+		}
+	}()
+	go ucSavedMessage.RunReminders(ctx)
+	go ucScheduledMessage.RunScheduler(ctx)
+	go ucMessage.RunReaper(ctx)
+	go ucRetention.RunPurge(ctx)
+	go ucWebhook.RunDeliveries(ctx)
+	go ucOutbox.RunRelay(ctx)
 
 	return App{
-		UcUser:    ucUser,
-		UcSpace:   ucSpace,
-		UcMessage: ucMessage,
+		UcUser:             ucUser,
+		UcSpace:            ucSpace,
+		UcMessage:          ucMessage,
+		UcAttachment:       ucAttachment,
+		UcSavedMessage:     ucSavedMessage,
+		UcScheduledMessage: ucScheduledMessage,
+		UcPoll:             ucPoll,
+		UcSlashCommand:     ucSlashCommand,
+		UcWebhook:          ucWebhook,
+		UcIncomingWebhook:  ucIncomingWebhook,
 	}, nil
 }
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"chatspace-server/pkg/broker"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+const (
+	BrokerBackendRedis    = "redis"
+	BrokerBackendPostgres = "postgres"
+	BrokerBackendMemory   = "memory"
+)
+
+// UsesRedis reports whether the configuration needs a Redis connection.
+func (c *Config) UsesRedis() bool {
+	return c.Redis.Addr != "" || c.Broker.Backend == "" || c.Broker.Backend == BrokerBackendRedis
+}
+
+func NewBroker(cfg *Config, db *sqlx.DB, rdb *redis.Client, zlog zerolog.Logger) (broker.Broker, error) {
+	maxLen := cfg.Broker.StreamMaxLen
+	if maxLen <= 0 {
+		maxLen = 10000
+	}
+
+	maxAge := time.Duration(cfg.Broker.StreamMaxAge) * time.Second
+	if maxAge <= 0 {
+		maxAge = 24 * time.Hour
+	}
+
+	var log broker.Log
+	switch cfg.Broker.Backend {
+	case "", BrokerBackendRedis:
+		if rdb == nil {
+			return nil, fmt.Errorf("broker backend %q needs REDIS_ADDR", BrokerBackendRedis)
+		}
+		log = broker.NewRedisLog(rdb, int64(maxLen), maxAge)
+	case BrokerBackendPostgres:
+		pgLog, err := broker.NewPostgresLog(db, databaseConnString(cfg.Database), maxAge, zlog)
+		if err != nil {
+			return nil, err
+		}
+		log = pgLog
+	case BrokerBackendMemory:
+		log = broker.NewMemoryLog(maxLen)
+	default:
+		return nil, fmt.Errorf("unknown broker backend %q", cfg.Broker.Backend)
+	}
+
+	return broker.NewHub(log, zlog), nil
+}
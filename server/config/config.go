@@ -1,10 +1,13 @@
 package config
 
 type Config struct {
-	Database Database `mapstructure:",squash"`
-	Server   Server   `mapstructure:",squash"`
-	Settings Settings `mapstructure:",squash"`
-	Redis    Redis    `mapstructure:",squash"`
+	Database  Database  `mapstructure:",squash"`
+	Server    Server    `mapstructure:",squash"`
+	Settings  Settings  `mapstructure:",squash"`
+	Redis     Redis     `mapstructure:",squash"`
+	Broker    Broker    `mapstructure:",squash"`
+	Storage   Storage   `mapstructure:",squash"`
+	Retention Retention `mapstructure:",squash"`
 }
 
 type Database struct {
@@ -24,6 +27,9 @@ type Server struct {
 	Debug   bool   `mapstructure:"SERVER_DEBUG"`
 	Name    string `mapstructure:"SERVER_NAME"`
 	Timeout string `mapstructure:"SERVER_TIMEOUT"`
+	// PublicURL is the externally reachable base URL, used to build links
+	// handed out to other systems such as incoming webhook URLs.
+	PublicURL string `mapstructure:"SERVER_PUBLICURL"`
 }
 
 type Settings struct {
@@ -37,3 +43,35 @@ type Redis struct {
 	Password string `mapstructure:"REDIS_PASSWORD"`
 	DB       int    `mapstructure:"REDIS_DB"`
 }
+
+type Broker struct {
+	// Backend is "redis" (default), "postgres" or "memory". The memory
+	// backend only reaches subscribers of the same process.
+	Backend string `mapstructure:"BROKER_BACKEND"`
+	// StreamMaxLen is roughly how many entries each channel keeps for
+	// subscribers resuming from a cursor; StreamMaxAge (seconds) drops
+	// older entries.
+	StreamMaxLen int `mapstructure:"BROKER_STREAMMAXLEN"`
+	StreamMaxAge int `mapstructure:"BROKER_STREAMMAXAGE"`
+}
+
+type Storage struct {
+	Backend          string `mapstructure:"STORAGE_BACKEND"`
+	LocalPath        string `mapstructure:"STORAGE_LOCALPATH"`
+	S3Endpoint       string `mapstructure:"STORAGE_S3ENDPOINT"`
+	S3AccessKey      string `mapstructure:"STORAGE_S3ACCESSKEY"`
+	S3SecretKey      string `mapstructure:"STORAGE_S3SECRETKEY"`
+	S3Bucket         string `mapstructure:"STORAGE_S3BUCKET"`
+	S3Region         string `mapstructure:"STORAGE_S3REGION"`
+	S3UseSSL         bool   `mapstructure:"STORAGE_S3USESSL"`
+	MaxSize          int64  `mapstructure:"STORAGE_MAXSIZE"`
+	AllowedMimeTypes string `mapstructure:"STORAGE_ALLOWEDMIMETYPES"`
+	URLExpiry        int    `mapstructure:"STORAGE_URLEXPIRY"`
+}
+
+type Retention struct {
+	DefaultMode  string `mapstructure:"RETENTION_DEFAULTMODE"`
+	DefaultValue int    `mapstructure:"RETENTION_DEFAULTVALUE"`
+	BatchSize    int    `mapstructure:"RETENTION_BATCHSIZE"`
+	Interval     int    `mapstructure:"RETENTION_INTERVAL"`
+}
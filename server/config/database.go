@@ -9,8 +9,11 @@ import (
 )
 
 func NewDatabase(cfg Database) (*sqlx.DB, error) {
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable TimeZone=Asia/Jakarta", cfg.Address, cfg.Port, cfg.Username, cfg.Password, cfg.DBName)
-	return connectDB(connStr, cfg)
+	return connectDB(databaseConnString(cfg), cfg)
+}
+
+func databaseConnString(cfg Database) string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable TimeZone=Asia/Jakarta", cfg.Address, cfg.Port, cfg.Username, cfg.Password, cfg.DBName)
 }
 
 func connectDB(connStr string, cfg Database) (*sqlx.DB, error) {
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"chatspace-server/pkg/blobstore"
+	"context"
+	"fmt"
+)
+
+const (
+	StorageBackendLocal = "local"
+	StorageBackendS3    = "s3"
+)
+
+func NewBlobStore(ctx context.Context, cfg Storage) (blobstore.BlobStore, error) {
+	switch cfg.Backend {
+	case "", StorageBackendLocal:
+		path := cfg.LocalPath
+		if path == "" {
+			path = "data/attachments"
+		}
+		return blobstore.NewLocalStore(path)
+	case StorageBackendS3:
+		return blobstore.NewS3Store(ctx, blobstore.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			Bucket:    cfg.S3Bucket,
+			Region:    cfg.S3Region,
+			UseSSL:    cfg.S3UseSSL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
@@ -1,6 +1,155 @@
 package constant
 
+import "time"
+
 const (
 	ROLE_ADMIN  = "admin"
 	ROLE_MEMBER = "member"
 )
+
+const (
+	SPACE_SORT_RELEVANCE = "relevance"
+	SPACE_SORT_MEMBERS   = "members"
+	SPACE_SORT_ACTIVITY  = "activity"
+	SPACE_SORT_CREATED   = "created"
+)
+
+const (
+	DEFAULT_PAGE_SIZE = 20
+	MAX_PAGE_SIZE     = 100
+)
+
+// Search snippets mark matches with these control characters rather than
+// HTML tags, so the raw content around them can be escaped first.
+const (
+	SEARCH_SNIPPET_START = "\x02"
+	SEARCH_SNIPPET_STOP  = "\x03"
+)
+
+const (
+	DEFAULT_ATTACHMENT_MAX_SIZE   = 10 << 20
+	DEFAULT_ATTACHMENT_URL_EXPIRY = 15 * 60
+)
+
+const (
+	ATTACHMENT_STATUS_PENDING = "pending"
+	ATTACHMENT_STATUS_READY   = "ready"
+	ATTACHMENT_STATUS_FAILED  = "failed"
+)
+
+const (
+	IMAGE_QUEUE_SIZE    = 100
+	IMAGE_QUEUE_WORKERS = 2
+)
+
+const (
+	UNFURL_QUEUE_SIZE    = 100
+	UNFURL_QUEUE_WORKERS = 2
+)
+
+const (
+	LINK_PREVIEW_STATUS_OK       = "ok"
+	LINK_PREVIEW_STATUS_FAILED   = "failed"
+	LINK_PREVIEW_MAX_PER_MESSAGE = 5
+	LINK_PREVIEW_TTL             = 24 * time.Hour
+)
+
+const MAX_PINNED_MESSAGES_PER_SPACE = 50
+
+// REACTION_MAX_EMOJI_LENGTH bounds reactions in runes, enough for emoji
+// ZWJ sequences with skin tone modifiers.
+const REACTION_MAX_EMOJI_LENGTH = 16
+
+const USER_CHANNEL_PREFIX = "user:"
+
+const (
+	SAVED_MESSAGE_NOTE_MAX_LENGTH   = 1000
+	SAVED_MESSAGE_REMINDER_INTERVAL = 30 * time.Second
+	SAVED_MESSAGE_REMINDER_BATCH    = 100
+)
+
+const (
+	SCHEDULED_MESSAGE_STATUS_PENDING  = "pending"
+	SCHEDULED_MESSAGE_STATUS_SENT     = "sent"
+	SCHEDULED_MESSAGE_STATUS_CANCELED = "canceled"
+	SCHEDULED_MESSAGE_STATUS_FAILED   = "failed"
+	SCHEDULED_MESSAGE_POLL_INTERVAL   = 10 * time.Second
+)
+
+const (
+	MAX_MESSAGE_TTL           = 30 * 24 * 60 * 60
+	MESSAGE_REAPER_INTERVAL   = 15 * time.Second
+	MESSAGE_REAPER_BATCH      = 500
+	CLIENT_MESSAGE_ID_MAX_LEN = 64
+)
+
+const (
+	RETENTION_MODE_DEFAULT   = "default"
+	RETENTION_MODE_FOREVER   = "forever"
+	RETENTION_MODE_DAYS      = "days"
+	RETENTION_MODE_MESSAGES  = "messages"
+	MAX_RETENTION_DAYS       = 36500
+	MAX_RETENTION_MESSAGES   = 10000000
+	DEFAULT_RETENTION_BATCH  = 1000
+	DEFAULT_RETENTION_PERIOD = time.Hour
+	RETENTION_BATCH_PAUSE    = 100 * time.Millisecond
+)
+
+const (
+	POLL_MIN_OPTIONS         = 2
+	POLL_MAX_OPTIONS         = 10
+	POLL_MAX_QUESTION_LENGTH = 300
+	POLL_MAX_OPTION_LENGTH   = 200
+)
+
+const (
+	SLASH_COMMAND_TIMEOUT       = 3 * time.Second
+	SLASH_COMMAND_MAX_RESPONSE  = 16 << 10
+	SLASH_COMMAND_MAX_PER_SPACE = 50
+)
+
+const (
+	WEBHOOK_DELIVERY_STATUS_PENDING   = "pending"
+	WEBHOOK_DELIVERY_STATUS_SUCCEEDED = "succeeded"
+	WEBHOOK_DELIVERY_STATUS_FAILED    = "failed"
+	WEBHOOK_MAX_PER_SPACE             = 20
+	WEBHOOK_MAX_ATTEMPTS              = 8
+	WEBHOOK_TIMEOUT                   = 10 * time.Second
+	WEBHOOK_POLL_INTERVAL             = 5 * time.Second
+	WEBHOOK_BATCH                     = 50
+	WEBHOOK_WORKERS                   = 4
+	WEBHOOK_LEASE                     = 2 * time.Minute
+	WEBHOOK_BACKOFF_BASE              = 30 * time.Second
+	WEBHOOK_BACKOFF_MAX               = 6 * time.Hour
+)
+
+const (
+	OUTBOX_BATCH         = 100
+	OUTBOX_POLL_INTERVAL = time.Second
+	OUTBOX_BUSY_WAIT     = 100 * time.Millisecond
+	OUTBOX_RETRY_WAIT    = 5 * time.Second
+	OUTBOX_MAX_ATTEMPTS  = 10
+)
+
+const (
+	INCOMING_WEBHOOK_RATE_LIMIT      = 30
+	INCOMING_WEBHOOK_RATE_WINDOW     = time.Minute
+	INCOMING_WEBHOOK_MAX_BODY        = 32 << 20
+	INCOMING_WEBHOOK_MAX_ATTACHMENTS = 5
+	INCOMING_WEBHOOK_PATH            = "/hooks/"
+	BOT_EMAIL_DOMAIN                 = "bots.chatspace.invalid"
+	BOT_NAME_MAX_LENGTH              = 80
+	BOT_TOKEN_DURATION               = 365 * 24 * time.Hour
+)
+
+// Token types, carried in the "typ" claim of every JWT. Only access tokens
+// authenticate requests; refresh and bot tokens are exchanged for them.
+const (
+	TOKEN_TYPE_ACCESS  = "access"
+	TOKEN_TYPE_REFRESH = "refresh"
+	TOKEN_TYPE_BOT     = "bot"
+)
+
+var THUMBNAIL_SIZES = []int{64, 256, 1024}
+
+var DEFAULT_ATTACHMENT_MIME_TYPES = []string{"image/*", "application/pdf", "text/plain"}
@@ -12,19 +12,70 @@ var (
 	ErrForbiddenAccess    = errors.New("forbidden access")
 	ErrMissingCredentials = errors.New("email and password are required")
 	ErrHashingPassword    = errors.New("failed to hash password")
+	ErrBlockSelf          = errors.New("cannot block yourself")
 
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrInvalidBotToken     = errors.New("invalid or revoked bot token")
 	ErrInvalidClaims       = errors.New("invalid claims in token")
 	ErrInvalidSubject      = errors.New("invalid subject in token")
 	ErrGeneratingJWT       = errors.New("failed to generate token")
+
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrCursorExpired    = errors.New("cursor expired, resubscribe without since and reload history")
+	ErrEmptySearchQuery = errors.New("search query must not be empty")
+
+	ErrNotSpaceMember           = errors.New("you are not a member of this space")
+	ErrNotSpaceAdmin            = errors.New("only space admins can perform this action")
+	ErrAttachmentNotFound       = errors.New("attachment not found")
+	ErrAttachmentFailed         = errors.New("attachment failed to process")
+	ErrAttachmentTooLarge       = errors.New("attachment exceeds the size limit of this space")
+	ErrAttachmentTypeNotAllowed = errors.New("attachment type is not allowed in this space")
+	ErrAttachmentPolicyInvalid  = errors.New("attachment size limit must be between 1 byte and the server limit")
+	ErrInvalidSignature         = errors.New("invalid or expired signature")
+	ErrMessageNotFound          = errors.New("message not found")
+	ErrPinLimitReached          = errors.New("this space has reached its pinned message limit")
+	ErrInvalidReaction          = errors.New("reaction must be a single emoji or a known :shortcode:")
+	ErrSavedNoteTooLong         = errors.New("saved message note is too long")
+	ErrReminderInPast           = errors.New("reminder time must be in the future")
+	ErrSendAtInPast             = errors.New("scheduled time must be in the future")
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+	ErrInvalidMessageTTL        = errors.New("message lifetime must be between 1 second and 30 days")
+	ErrClientMessageIDTooLong   = errors.New("client message ID must be at most 64 characters")
+	ErrInvalidRetentionPolicy   = errors.New("retention value is out of range for the selected mode")
+	ErrPollNotFound             = errors.New("poll not found")
+	ErrPollClosed               = errors.New("poll is closed")
+	ErrPollSingleChoice         = errors.New("this poll accepts a single choice")
+	ErrPollInvalidOption        = errors.New("option does not belong to this poll")
+	ErrPollOptionsCount         = errors.New("a poll needs between 2 and 10 options")
+	ErrPollOptionTooLong        = errors.New("poll option is too long")
+	ErrPollDuplicateOption      = errors.New("poll options must be unique")
+	ErrPollQuestionTooLong      = errors.New("poll question is too long")
+	ErrPollClosesInPast         = errors.New("poll closing time must be in the future")
+	ErrSlashCommandNotFound     = errors.New("slash command not found")
+	ErrInvalidSlashCommandName  = errors.New("command names are 1-32 lowercase letters, digits, '-' or '_'")
+	ErrInvalidSlashCommandURL   = errors.New("command URL must be an absolute http or https URL")
+	ErrSlashCommandExists       = errors.New("a command with this name already exists in the space")
+	ErrSlashCommandBuiltin      = errors.New("built-in commands cannot be replaced")
+	ErrSlashCommandLimitReached = errors.New("space has reached the slash command limit")
+	ErrWebhookNotFound          = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound  = errors.New("webhook delivery not found")
+	ErrWebhookNoEvents          = errors.New("a webhook needs at least one event type")
+	ErrWebhookLimitReached      = errors.New("space has reached the webhook limit")
+	ErrInvalidWebhookURL        = errors.New("webhook URL must be an absolute http or https URL")
+	ErrIncomingWebhookNotFound  = errors.New("incoming webhook not found")
+	ErrBotNotFound              = errors.New("bot not found in this space")
+	ErrInvalidBotName           = errors.New("bot name must be 1-80 characters")
+	ErrInvalidAvatarURL         = errors.New("avatar must be an absolute http or https URL")
+	ErrRateLimited              = errors.New("rate limit exceeded, try again later")
+	ErrEmptyMessage             = errors.New("message needs text or attachments")
+	ErrTooManyAttachments       = errors.New("too many attachments")
 )
 
 var (
 	ErrMsgMarshal   = "failed to marshal message"
 	ErrMsgUnmarshal = "failed to unmarshal message"
-	ErrMsgPublish   = "failed to publish message to redis"
-	ErrMsgSubscribe = "failed to subscribe message from redis"
-	ErrMsgSubsFull  = "subscriber channel full, skipping message"
+	ErrMsgPublish   = "failed to publish message to broker"
+	ErrMsgSubscribe = "failed to subscribe message from broker"
 )
 
 func ErrMissingField(field string) error {
@@ -39,6 +90,14 @@ func ErrGetField(field string) error {
 	return fmt.Errorf("failed to get %s", field)
 }
 
+func ErrUpdatingField(field string) error {
+	return fmt.Errorf("failed to update %s", field)
+}
+
+func ErrDeletingField(field string) error {
+	return fmt.Errorf("failed to delete %s", field)
+}
+
 func ErrWithMsg(errMsg, err error) error {
 	return fmt.Errorf("%w: %v", errMsg, err)
 }
@@ -3,33 +3,183 @@
 package model
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+type Attachment struct {
+	ID          string                 `json:"id"`
+	Filename    string                 `json:"filename"`
+	ContentType string                 `json:"contentType"`
+	Size        int                    `json:"size"`
+	URL         *string                `json:"url,omitempty"`
+	Status      AttachmentStatus       `json:"status"`
+	Width       *int32                 `json:"width,omitempty"`
+	Height      *int32                 `json:"height,omitempty"`
+	Blurhash    *string                `json:"blurhash,omitempty"`
+	Thumbnails  []*AttachmentThumbnail `json:"thumbnails"`
+	CreatedAt   time.Time              `json:"createdAt"`
+}
+
+type AttachmentPolicy struct {
+	MaxSize   int      `json:"maxSize"`
+	MimeTypes []string `json:"mimeTypes"`
+}
+
+type AttachmentPolicyRequest struct {
+	MaxSize   *int     `json:"maxSize,omitempty"`
+	MimeTypes []string `json:"mimeTypes,omitempty"`
+}
+
+type AttachmentThumbnail struct {
+	Width       int32   `json:"width"`
+	Height      int32   `json:"height"`
+	ContentType string  `json:"contentType"`
+	URL         *string `json:"url,omitempty"`
+}
+
 type AuthResponse struct {
 	Token        string  `json:"token"`
 	RefreshToken *string `json:"refreshToken,omitempty"`
 }
 
+type BotRegistration struct {
+	Bot   *User  `json:"bot"`
+	Token string `json:"token"`
+}
+
+type IncomingWebhook struct {
+	ID        string     `json:"id"`
+	SpaceID   string     `json:"spaceID"`
+	Name      string     `json:"name"`
+	AvatarURL *string    `json:"avatarURL,omitempty"`
+	Bot       *User      `json:"bot"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+type IncomingWebhookRegistration struct {
+	Webhook *IncomingWebhook `json:"webhook"`
+	URL     string           `json:"url"`
+}
+
+type LinkPreview struct {
+	URL         string  `json:"url"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ImageURL    *string `json:"imageURL,omitempty"`
+	SiteName    *string `json:"siteName,omitempty"`
+}
+
 type LoginRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
 type Message struct {
-	ID        string    `json:"id"`
-	Content   string    `json:"content"`
-	User      *User     `json:"user"`
-	Space     *Space    `json:"space"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID              string          `json:"id"`
+	Content         string          `json:"content"`
+	Format          MessageFormat   `json:"format"`
+	Blocks          []*MessageBlock `json:"blocks"`
+	HTML            string          `json:"html"`
+	User            *User           `json:"user"`
+	Space           *Space          `json:"space"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	ExpiresAt       *time.Time      `json:"expiresAt,omitempty"`
+	Ephemeral       bool            `json:"ephemeral"`
+	FromBlockedUser bool            `json:"fromBlockedUser"`
+	Attachments     []*Attachment   `json:"attachments"`
+	LinkPreviews    []*LinkPreview  `json:"linkPreviews"`
+	Poll            *Poll           `json:"poll,omitempty"`
+	Cursor          *string         `json:"cursor,omitempty"`
+	ClientMessageID *string         `json:"clientMessageID,omitempty"`
+	Seq             *int            `json:"seq,omitempty"`
+	Reactions       []*Reaction     `json:"reactions"`
+}
+
+type MessageBlock struct {
+	Type     MessageBlockType `json:"type"`
+	Language *string          `json:"language,omitempty"`
+	Text     *string          `json:"text,omitempty"`
+	Inlines  []*MessageInline `json:"inlines"`
+}
+
+type MessageInline struct {
+	Type     MessageInlineType `json:"type"`
+	Text     string            `json:"text"`
+	URL      *string           `json:"url,omitempty"`
+	UserID   *string           `json:"userID,omitempty"`
+	Emoji    *string           `json:"emoji,omitempty"`
+	Children []*MessageInline  `json:"children,omitempty"`
+}
+
+type MessageSearchConnection struct {
+	Edges    []*MessageSearchResult `json:"edges"`
+	PageInfo *PageInfo              `json:"pageInfo"`
+}
+
+type MessageSearchFilter struct {
+	SpaceID       *string    `json:"spaceID,omitempty"`
+	FromUserID    *string    `json:"fromUserID,omitempty"`
+	Before        *time.Time `json:"before,omitempty"`
+	After         *time.Time `json:"after,omitempty"`
+	HasAttachment *bool      `json:"hasAttachment,omitempty"`
+}
+
+type MessageSearchResult struct {
+	Message *Message `json:"message"`
+	Snippet string   `json:"snippet"`
+	Rank    float64  `json:"rank"`
 }
 
 type Mutation struct {
 }
 
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor,omitempty"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+type Poll struct {
+	ID          string        `json:"id"`
+	Question    string        `json:"question"`
+	Options     []*PollOption `json:"options"`
+	MultiChoice bool          `json:"multiChoice"`
+	Anonymous   bool          `json:"anonymous"`
+	ClosesAt    *time.Time    `json:"closesAt,omitempty"`
+	Closed      bool          `json:"closed"`
+	TotalVoters int32         `json:"totalVoters"`
+	MyVotes     []string      `json:"myVotes,omitempty"`
+}
+
+type PollOption struct {
+	ID        string  `json:"id"`
+	Text      string  `json:"text"`
+	VoteCount int32   `json:"voteCount"`
+	Voters    []*User `json:"voters,omitempty"`
+}
+
+type PollRequest struct {
+	SpaceID     string     `json:"spaceID"`
+	Question    string     `json:"question"`
+	Options     []string   `json:"options"`
+	MultiChoice *bool      `json:"multiChoice,omitempty"`
+	Anonymous   *bool      `json:"anonymous,omitempty"`
+	ClosesAt    *time.Time `json:"closesAt,omitempty"`
+}
+
 type Query struct {
 }
 
+type Reaction struct {
+	Emoji   string `json:"emoji"`
+	Count   int32  `json:"count"`
+	Reacted bool   `json:"reacted"`
+}
+
 type RefreshRequest struct {
 	RefreshToken string `json:"refreshToken"`
 }
@@ -40,13 +190,92 @@ type RegisterRequest struct {
 	Password string `json:"password"`
 }
 
+type RetentionPolicy struct {
+	Mode      RetentionMode `json:"mode"`
+	Value     *int32        `json:"value,omitempty"`
+	Inherited bool          `json:"inherited"`
+}
+
+type RetentionPolicyRequest struct {
+	Mode  RetentionMode `json:"mode"`
+	Value *int32        `json:"value,omitempty"`
+}
+
+type SavedMessage struct {
+	ID        string     `json:"id"`
+	Message   *Message   `json:"message"`
+	Note      *string    `json:"note,omitempty"`
+	RemindAt  *time.Time `json:"remindAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type SavedMessageConnection struct {
+	Edges    []*SavedMessage `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type ScheduledMessage struct {
+	ID        string                 `json:"id"`
+	SpaceID   string                 `json:"spaceID"`
+	Content   string                 `json:"content"`
+	Format    MessageFormat          `json:"format"`
+	Ephemeral bool                   `json:"ephemeral"`
+	SendAt    time.Time              `json:"sendAt"`
+	Status    ScheduledMessageStatus `json:"status"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+type SlashCommand struct {
+	ID          string  `json:"id"`
+	SpaceID     string  `json:"spaceID"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	URL         *string `json:"url,omitempty"`
+	Builtin     bool    `json:"builtin"`
+}
+
+type SlashCommandRegistration struct {
+	Command *SlashCommand `json:"command"`
+	Secret  string        `json:"secret"`
+}
+
+type SlashCommandRequest struct {
+	Name        string  `json:"name"`
+	URL         string  `json:"url"`
+	Description *string `json:"description,omitempty"`
+}
+
 type Space struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	Description *string    `json:"description,omitempty"`
-	Members     []*User    `json:"members"`
-	Admins      []*User    `json:"admins"`
-	Messages    []*Message `json:"Messages"`
+	ID              string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     *string          `json:"description,omitempty"`
+	Members         []*User          `json:"members"`
+	Admins          []*User          `json:"admins"`
+	Messages        []*Message       `json:"Messages"`
+	MemberCount     int32            `json:"memberCount"`
+	LastActivityAt  *time.Time       `json:"lastActivityAt,omitempty"`
+	IsMember        bool             `json:"isMember"`
+	PinnedMessages  []*Message       `json:"pinnedMessages"`
+	MessageTTL      *int32           `json:"messageTTL,omitempty"`
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy"`
+	LastReadSeq     int              `json:"lastReadSeq"`
+	UnreadCount     int32            `json:"unreadCount"`
+}
+
+type SpaceConnection struct {
+	Nodes    []*Space  `json:"nodes"`
+	PageInfo *PageInfo `json:"pageInfo"`
+}
+
+type SpaceEvent struct {
+	Type      SpaceEventType `json:"type"`
+	SpaceID   string         `json:"spaceID"`
+	Message   *Message       `json:"message,omitempty"`
+	MessageID *string        `json:"messageID,omitempty"`
+	Poll      *Poll          `json:"poll,omitempty"`
+	User      *User          `json:"user,omitempty"`
+	Emoji     *string        `json:"emoji,omitempty"`
+	Cursor    *string        `json:"cursor,omitempty"`
 }
 
 type SpaceRequest struct {
@@ -58,10 +287,717 @@ type Subscription struct {
 }
 
 type User struct {
-	ID        string `json:"id"`
-	Email     string `json:"email"`
-	Name      string `json:"name"`
-	Password  string `json:"password"`
-	UpdatedAt string `json:"updatedAt"`
-	CreatedAt string `json:"createdAt"`
+	ID        string  `json:"id"`
+	Email     string  `json:"email"`
+	Name      string  `json:"name"`
+	Password  string  `json:"password"`
+	Bot       bool    `json:"bot"`
+	AvatarURL *string `json:"avatarURL,omitempty"`
+	UpdatedAt string  `json:"updatedAt"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+type UserEvent struct {
+	Type         UserEventType `json:"type"`
+	SavedMessage *SavedMessage `json:"savedMessage,omitempty"`
+	Message      *Message      `json:"message,omitempty"`
+}
+
+type UserSearchConnection struct {
+	Edges    []*UserSearchResult `json:"edges"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+}
+
+type UserSearchResult struct {
+	User             *User `json:"user"`
+	SharedSpaceCount int32 `json:"sharedSpaceCount"`
+}
+
+type Webhook struct {
+	ID        string             `json:"id"`
+	SpaceID   string             `json:"spaceID"`
+	URL       string             `json:"url"`
+	Events    []WebhookEventType `json:"events"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	WebhookID      string                `json:"webhookID"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"nextAttemptAt,omitempty"`
+	ResponseStatus *int32                `json:"responseStatus,omitempty"`
+	Error          *string               `json:"error,omitempty"`
+	DeliveredAt    *time.Time            `json:"deliveredAt,omitempty"`
+	RedeliveryOf   *string               `json:"redeliveryOf,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+type WebhookDeliveryConnection struct {
+	Edges    []*WebhookDelivery `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type WebhookRegistration struct {
+	Webhook *Webhook `json:"webhook"`
+	Secret  string   `json:"secret"`
+}
+
+type WebhookRequest struct {
+	URL    string             `json:"url"`
+	Events []WebhookEventType `json:"events"`
+}
+
+type AttachmentStatus string
+
+const (
+	AttachmentStatusPending AttachmentStatus = "PENDING"
+	AttachmentStatusReady   AttachmentStatus = "READY"
+	AttachmentStatusFailed  AttachmentStatus = "FAILED"
+)
+
+var AllAttachmentStatus = []AttachmentStatus{
+	AttachmentStatusPending,
+	AttachmentStatusReady,
+	AttachmentStatusFailed,
+}
+
+func (e AttachmentStatus) IsValid() bool {
+	switch e {
+	case AttachmentStatusPending, AttachmentStatusReady, AttachmentStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e AttachmentStatus) String() string {
+	return string(e)
+}
+
+func (e *AttachmentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AttachmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AttachmentStatus", str)
+	}
+	return nil
+}
+
+func (e AttachmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AttachmentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AttachmentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MessageBlockType string
+
+const (
+	MessageBlockTypeParagraph MessageBlockType = "PARAGRAPH"
+	MessageBlockTypeCodeBlock MessageBlockType = "CODE_BLOCK"
+	MessageBlockTypeQuote     MessageBlockType = "QUOTE"
+)
+
+var AllMessageBlockType = []MessageBlockType{
+	MessageBlockTypeParagraph,
+	MessageBlockTypeCodeBlock,
+	MessageBlockTypeQuote,
+}
+
+func (e MessageBlockType) IsValid() bool {
+	switch e {
+	case MessageBlockTypeParagraph, MessageBlockTypeCodeBlock, MessageBlockTypeQuote:
+		return true
+	}
+	return false
+}
+
+func (e MessageBlockType) String() string {
+	return string(e)
+}
+
+func (e *MessageBlockType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageBlockType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageBlockType", str)
+	}
+	return nil
+}
+
+func (e MessageBlockType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MessageBlockType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MessageBlockType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MessageFormat string
+
+const (
+	MessageFormatPlain    MessageFormat = "PLAIN"
+	MessageFormatMarkdown MessageFormat = "MARKDOWN"
+)
+
+var AllMessageFormat = []MessageFormat{
+	MessageFormatPlain,
+	MessageFormatMarkdown,
+}
+
+func (e MessageFormat) IsValid() bool {
+	switch e {
+	case MessageFormatPlain, MessageFormatMarkdown:
+		return true
+	}
+	return false
+}
+
+func (e MessageFormat) String() string {
+	return string(e)
+}
+
+func (e *MessageFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageFormat", str)
+	}
+	return nil
+}
+
+func (e MessageFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MessageFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MessageFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MessageInlineType string
+
+const (
+	MessageInlineTypeText    MessageInlineType = "TEXT"
+	MessageInlineTypeBold    MessageInlineType = "BOLD"
+	MessageInlineTypeItalic  MessageInlineType = "ITALIC"
+	MessageInlineTypeStrike  MessageInlineType = "STRIKE"
+	MessageInlineTypeCode    MessageInlineType = "CODE"
+	MessageInlineTypeLink    MessageInlineType = "LINK"
+	MessageInlineTypeMention MessageInlineType = "MENTION"
+	MessageInlineTypeEmoji   MessageInlineType = "EMOJI"
+)
+
+var AllMessageInlineType = []MessageInlineType{
+	MessageInlineTypeText,
+	MessageInlineTypeBold,
+	MessageInlineTypeItalic,
+	MessageInlineTypeStrike,
+	MessageInlineTypeCode,
+	MessageInlineTypeLink,
+	MessageInlineTypeMention,
+	MessageInlineTypeEmoji,
+}
+
+func (e MessageInlineType) IsValid() bool {
+	switch e {
+	case MessageInlineTypeText, MessageInlineTypeBold, MessageInlineTypeItalic, MessageInlineTypeStrike, MessageInlineTypeCode, MessageInlineTypeLink, MessageInlineTypeMention, MessageInlineTypeEmoji:
+		return true
+	}
+	return false
+}
+
+func (e MessageInlineType) String() string {
+	return string(e)
+}
+
+func (e *MessageInlineType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageInlineType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageInlineType", str)
+	}
+	return nil
+}
+
+func (e MessageInlineType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MessageInlineType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MessageInlineType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RetentionMode string
+
+const (
+	RetentionModeDefault  RetentionMode = "DEFAULT"
+	RetentionModeForever  RetentionMode = "FOREVER"
+	RetentionModeDays     RetentionMode = "DAYS"
+	RetentionModeMessages RetentionMode = "MESSAGES"
+)
+
+var AllRetentionMode = []RetentionMode{
+	RetentionModeDefault,
+	RetentionModeForever,
+	RetentionModeDays,
+	RetentionModeMessages,
+}
+
+func (e RetentionMode) IsValid() bool {
+	switch e {
+	case RetentionModeDefault, RetentionModeForever, RetentionModeDays, RetentionModeMessages:
+		return true
+	}
+	return false
+}
+
+func (e RetentionMode) String() string {
+	return string(e)
+}
+
+func (e *RetentionMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RetentionMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RetentionMode", str)
+	}
+	return nil
+}
+
+func (e RetentionMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RetentionMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RetentionMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ScheduledMessageStatus string
+
+const (
+	ScheduledMessageStatusPending  ScheduledMessageStatus = "PENDING"
+	ScheduledMessageStatusSent     ScheduledMessageStatus = "SENT"
+	ScheduledMessageStatusCanceled ScheduledMessageStatus = "CANCELED"
+	ScheduledMessageStatusFailed   ScheduledMessageStatus = "FAILED"
+)
+
+var AllScheduledMessageStatus = []ScheduledMessageStatus{
+	ScheduledMessageStatusPending,
+	ScheduledMessageStatusSent,
+	ScheduledMessageStatusCanceled,
+	ScheduledMessageStatusFailed,
+}
+
+func (e ScheduledMessageStatus) IsValid() bool {
+	switch e {
+	case ScheduledMessageStatusPending, ScheduledMessageStatusSent, ScheduledMessageStatusCanceled, ScheduledMessageStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ScheduledMessageStatus) String() string {
+	return string(e)
+}
+
+func (e *ScheduledMessageStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduledMessageStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduledMessageStatus", str)
+	}
+	return nil
+}
+
+func (e ScheduledMessageStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ScheduledMessageStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ScheduledMessageStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SpaceEventType string
+
+const (
+	SpaceEventTypeMessageCreated  SpaceEventType = "MESSAGE_CREATED"
+	SpaceEventTypeMessageUpdated  SpaceEventType = "MESSAGE_UPDATED"
+	SpaceEventTypeMessagePinned   SpaceEventType = "MESSAGE_PINNED"
+	SpaceEventTypeMessageUnpinned SpaceEventType = "MESSAGE_UNPINNED"
+	SpaceEventTypeMessageDeleted  SpaceEventType = "MESSAGE_DELETED"
+	SpaceEventTypePollUpdated     SpaceEventType = "POLL_UPDATED"
+	SpaceEventTypeMemberJoined    SpaceEventType = "MEMBER_JOINED"
+	SpaceEventTypeReactionAdded   SpaceEventType = "REACTION_ADDED"
+	SpaceEventTypeReactionRemoved SpaceEventType = "REACTION_REMOVED"
+)
+
+var AllSpaceEventType = []SpaceEventType{
+	SpaceEventTypeMessageCreated,
+	SpaceEventTypeMessageUpdated,
+	SpaceEventTypeMessagePinned,
+	SpaceEventTypeMessageUnpinned,
+	SpaceEventTypeMessageDeleted,
+	SpaceEventTypePollUpdated,
+	SpaceEventTypeMemberJoined,
+	SpaceEventTypeReactionAdded,
+	SpaceEventTypeReactionRemoved,
+}
+
+func (e SpaceEventType) IsValid() bool {
+	switch e {
+	case SpaceEventTypeMessageCreated, SpaceEventTypeMessageUpdated, SpaceEventTypeMessagePinned, SpaceEventTypeMessageUnpinned, SpaceEventTypeMessageDeleted, SpaceEventTypePollUpdated, SpaceEventTypeMemberJoined, SpaceEventTypeReactionAdded, SpaceEventTypeReactionRemoved:
+		return true
+	}
+	return false
+}
+
+func (e SpaceEventType) String() string {
+	return string(e)
+}
+
+func (e *SpaceEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SpaceEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SpaceEventType", str)
+	}
+	return nil
+}
+
+func (e SpaceEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SpaceEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SpaceEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SpaceSort string
+
+const (
+	SpaceSortMembers  SpaceSort = "MEMBERS"
+	SpaceSortActivity SpaceSort = "ACTIVITY"
+	SpaceSortCreated  SpaceSort = "CREATED"
+)
+
+var AllSpaceSort = []SpaceSort{
+	SpaceSortMembers,
+	SpaceSortActivity,
+	SpaceSortCreated,
+}
+
+func (e SpaceSort) IsValid() bool {
+	switch e {
+	case SpaceSortMembers, SpaceSortActivity, SpaceSortCreated:
+		return true
+	}
+	return false
+}
+
+func (e SpaceSort) String() string {
+	return string(e)
+}
+
+func (e *SpaceSort) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SpaceSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SpaceSort", str)
+	}
+	return nil
+}
+
+func (e SpaceSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SpaceSort) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SpaceSort) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UserEventType string
+
+const (
+	UserEventTypeSavedMessageReminder UserEventType = "SAVED_MESSAGE_REMINDER"
+	UserEventTypeEphemeralMessage     UserEventType = "EPHEMERAL_MESSAGE"
+	UserEventTypeMention              UserEventType = "MENTION"
+)
+
+var AllUserEventType = []UserEventType{
+	UserEventTypeSavedMessageReminder,
+	UserEventTypeEphemeralMessage,
+	UserEventTypeMention,
+}
+
+func (e UserEventType) IsValid() bool {
+	switch e {
+	case UserEventTypeSavedMessageReminder, UserEventTypeEphemeralMessage, UserEventTypeMention:
+		return true
+	}
+	return false
+}
+
+func (e UserEventType) String() string {
+	return string(e)
+}
+
+func (e *UserEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserEventType", str)
+	}
+	return nil
+}
+
+func (e UserEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UserEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UserEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeMessageCreated WebhookEventType = "MESSAGE_CREATED"
+	WebhookEventTypeMessageDeleted WebhookEventType = "MESSAGE_DELETED"
+	WebhookEventTypeMemberJoined   WebhookEventType = "MEMBER_JOINED"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeMessageCreated,
+	WebhookEventTypeMessageDeleted,
+	WebhookEventTypeMemberJoined,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypeMessageCreated, WebhookEventTypeMessageDeleted, WebhookEventTypeMemberJoined:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"strings"
 
+	"chatspace-server/constant"
+
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/golang-jwt/jwt/v5"
@@ -31,20 +33,31 @@ func ApplyAuthMiddleware(srv *handler.Server, secret string) {
 			return next(ctx)
 		}
 
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		claims := jwt.MapClaims{}
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(secret), nil
-		})
-
-		if err != nil || !token.Valid {
+		userID, ok := AccessTokenUserID(strings.TrimPrefix(authHeader, "Bearer "), secret)
+		if !ok {
 			return next(ctx)
 		}
 
-		userID, _ := claims["sub"].(string)
 		authUser := &AuthUser{UserID: userID}
 		ctx = context.WithValue(ctx, UserCtxKey, authUser)
 
 		return next(ctx)
 	})
 }
+
+// AccessTokenUserID returns the user an access token was issued to. Refresh
+// and bot tokens are rejected: they are only good for getting an access
+// token.
+func AccessTokenUserID(tokenString, secret string) (string, bool) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid || claims["typ"] != constant.TOKEN_TYPE_ACCESS {
+		return "", false
+	}
+
+	userID, ok := claims["sub"].(string)
+
+	return userID, ok && userID != ""
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestAccessTokenUserID(t *testing.T) {
+	const secret = "secret"
+
+	sign := func(t *testing.T, method jwt.SigningMethod, key any, claims jwt.MapClaims) string {
+		t.Helper()
+
+		claims["sub"] = "user-1"
+		if _, ok := claims["exp"]; !ok {
+			claims["exp"] = time.Now().Add(time.Hour).Unix()
+		}
+
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	tests := []struct {
+		name   string
+		method jwt.SigningMethod
+		key    any
+		claims jwt.MapClaims
+		want   bool
+	}{
+		{"access", jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"typ": "access"}, true},
+		{"refresh", jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"typ": "refresh"}, false},
+		{"bot", jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"typ": "bot", "ver": 0}, false},
+		{"untyped", jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{}, false},
+		{"expired", jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"typ": "access", "exp": time.Now().Add(-time.Minute).Unix()}, false},
+		{"wrong secret", jwt.SigningMethodHS256, []byte("other"), jwt.MapClaims{"typ": "access"}, false},
+		{"unsigned", jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, jwt.MapClaims{"typ": "access"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID, ok := AccessTokenUserID(sign(t, tt.method, tt.key, tt.claims), secret)
+			if ok != tt.want {
+				t.Fatalf("AccessTokenUserID() = %q, %v, want ok %v", userID, ok, tt.want)
+			}
+
+			if ok && userID != "user-1" {
+				t.Fatalf("AccessTokenUserID() = %q, want %q", userID, "user-1")
+			}
+		})
+	}
+}
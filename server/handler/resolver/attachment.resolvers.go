@@ -0,0 +1,27 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// UploadAttachment is the resolver for the uploadAttachment field.
+func (r *mutationResolver) UploadAttachment(ctx context.Context, spaceID string, file graphql.Upload) (*model.Attachment, error) {
+	return r.ucAttachment.UploadAttachment(ctx, spaceID, file)
+}
+
+// UpdateAttachmentPolicy is the resolver for the updateAttachmentPolicy field.
+func (r *mutationResolver) UpdateAttachmentPolicy(ctx context.Context, spaceID string, request model.AttachmentPolicyRequest) (*model.AttachmentPolicy, error) {
+	return r.ucAttachment.UpdateAttachmentPolicy(ctx, spaceID, request)
+}
+
+// AttachmentPolicy is the resolver for the attachmentPolicy field.
+func (r *queryResolver) AttachmentPolicy(ctx context.Context, spaceID string) (*model.AttachmentPolicy, error) {
+	return r.ucAttachment.AttachmentPolicy(ctx, spaceID)
+}
@@ -0,0 +1,25 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+)
+
+// CreateIncomingWebhook is the resolver for the createIncomingWebhook field.
+func (r *mutationResolver) CreateIncomingWebhook(ctx context.Context, spaceID string, name string, avatar *string) (*model.IncomingWebhookRegistration, error) {
+	return r.ucIncomingWebhook.CreateIncomingWebhook(ctx, spaceID, name, avatar)
+}
+
+// RevokeIncomingWebhook is the resolver for the revokeIncomingWebhook field.
+func (r *mutationResolver) RevokeIncomingWebhook(ctx context.Context, id string) (bool, error) {
+	return r.ucIncomingWebhook.RevokeIncomingWebhook(ctx, id)
+}
+
+// IncomingWebhooks is the resolver for the incomingWebhooks field.
+func (r *queryResolver) IncomingWebhooks(ctx context.Context, spaceID string) ([]*model.IncomingWebhook, error) {
+	return r.ucIncomingWebhook.IncomingWebhooks(ctx, spaceID)
+}
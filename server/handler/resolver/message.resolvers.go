@@ -11,18 +11,48 @@ import (
 )
 
 // SendMessage is the resolver for the sendMessage field.
-func (r *mutationResolver) SendMessage(ctx context.Context, spaceID string, content string) (*model.Message, error) {
-	return r.ucMessage.SendMessage(ctx, spaceID, content)
+func (r *mutationResolver) SendMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32, clientMessageID *string) (*model.Message, error) {
+	return r.ucMessage.SendMessage(ctx, spaceID, content, format, attachmentIDs, expiresIn, clientMessageID)
+}
+
+// PinMessage is the resolver for the pinMessage field.
+func (r *mutationResolver) PinMessage(ctx context.Context, messageID string) (*model.Message, error) {
+	return r.ucMessage.PinMessage(ctx, messageID)
+}
+
+// UnpinMessage is the resolver for the unpinMessage field.
+func (r *mutationResolver) UnpinMessage(ctx context.Context, messageID string) (bool, error) {
+	return r.ucMessage.UnpinMessage(ctx, messageID)
+}
+
+// AddReaction is the resolver for the addReaction field.
+func (r *mutationResolver) AddReaction(ctx context.Context, messageID string, emoji string) (bool, error) {
+	return r.ucMessage.AddReaction(ctx, messageID, emoji)
+}
+
+// RemoveReaction is the resolver for the removeReaction field.
+func (r *mutationResolver) RemoveReaction(ctx context.Context, messageID string, emoji string) (bool, error) {
+	return r.ucMessage.RemoveReaction(ctx, messageID, emoji)
 }
 
 // Messages is the resolver for the messages field.
-func (r *queryResolver) Messages(ctx context.Context, spaceID string) ([]*model.Message, error) {
-	return r.ucMessage.Messages(ctx, spaceID)
+func (r *queryResolver) Messages(ctx context.Context, spaceID string, first *int32, before *int, after *int) ([]*model.Message, error) {
+	return r.ucMessage.Messages(ctx, spaceID, first, before, after)
+}
+
+// SearchMessages is the resolver for the searchMessages field.
+func (r *queryResolver) SearchMessages(ctx context.Context, query string, filter *model.MessageSearchFilter, first *int32, after *string) (*model.MessageSearchConnection, error) {
+	return r.ucMessage.SearchMessages(ctx, query, filter, first, after)
 }
 
 // MessageSent is the resolver for the messageSent field.
-func (r *subscriptionResolver) MessageSent(ctx context.Context, spaceID string) (<-chan *model.Message, error) {
-	return r.ucMessage.MessageSent(ctx, spaceID)
+func (r *subscriptionResolver) MessageSent(ctx context.Context, spaceID string, since *string) (<-chan *model.Message, error) {
+	return r.ucMessage.MessageSent(ctx, spaceID, since)
+}
+
+// SpaceEvents is the resolver for the spaceEvents field.
+func (r *subscriptionResolver) SpaceEvents(ctx context.Context, spaceID string, since *string) (<-chan *model.SpaceEvent, error) {
+	return r.ucMessage.SpaceEvents(ctx, spaceID, since)
 }
 
 // Subscription returns generated.SubscriptionResolver implementation.
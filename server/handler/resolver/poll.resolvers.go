@@ -0,0 +1,25 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+)
+
+// CreatePoll is the resolver for the createPoll field.
+func (r *mutationResolver) CreatePoll(ctx context.Context, request model.PollRequest) (*model.Message, error) {
+	return r.ucPoll.CreatePoll(ctx, request)
+}
+
+// VotePoll is the resolver for the votePoll field.
+func (r *mutationResolver) VotePoll(ctx context.Context, pollID string, optionIDs []string) (*model.Poll, error) {
+	return r.ucPoll.VotePoll(ctx, pollID, optionIDs)
+}
+
+// ClosePoll is the resolver for the closePoll field.
+func (r *mutationResolver) ClosePoll(ctx context.Context, pollID string) (*model.Poll, error) {
+	return r.ucPoll.ClosePoll(ctx, pollID)
+}
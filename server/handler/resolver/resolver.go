@@ -3,6 +3,9 @@ package resolver
 import (
 	"context"
 	"chatspace-server/graph/model"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
 // This file will not be regenerated automatically.
@@ -14,6 +17,13 @@ type ucUserInterface interface {
 	Login(ctx context.Context, request model.LoginRequest) (*model.AuthResponse, error)
 	RefreshToken(ctx context.Context, request model.RefreshRequest) (*model.AuthResponse, error)
 	User(ctx context.Context) (*model.User, error)
+	BlockUser(ctx context.Context, userID string) (*model.User, error)
+	UnblockUser(ctx context.Context, userID string) (bool, error)
+	BlockedUsers(ctx context.Context) ([]*model.User, error)
+	SearchUsers(ctx context.Context, query string, first *int32, after *string) (*model.UserSearchConnection, error)
+	CreateBot(ctx context.Context, spaceID string, name string, avatar *string) (*model.BotRegistration, error)
+	RotateBotToken(ctx context.Context, spaceID, botID string) (*model.BotRegistration, error)
+	BotAccessToken(ctx context.Context, token string) (*model.AuthResponse, error)
 }
 
 type ucSpaceInterface interface {
@@ -21,28 +31,105 @@ type ucSpaceInterface interface {
 	JoinSpace(ctx context.Context, spaceID string) (*model.Space, error)
 	Spaces(ctx context.Context) ([]*model.Space, error)
 	Space(ctx context.Context, id string) (*model.Space, error)
+	MySpaces(ctx context.Context) ([]*model.Space, error)
+	SearchSpaces(ctx context.Context, query *string, first *int32, after *string, sort *model.SpaceSort) (*model.SpaceConnection, error)
+	UpdateSpaceMessageTTL(ctx context.Context, spaceID string, ttl *int32) (*model.Space, error)
+	UpdateRetentionPolicy(ctx context.Context, spaceID string, request model.RetentionPolicyRequest) (*model.Space, error)
+	MarkSpaceRead(ctx context.Context, spaceID string, seq int) (*model.Space, error)
 }
 
 type ucMessageInterface interface {
-	SendMessage(ctx context.Context, spaceID string, content string) (*model.Message, error)
-	Messages(ctx context.Context, spaceID string) ([]*model.Message, error)
-	MessageSent(ctx context.Context, spaceID string) (<-chan *model.Message, error)
+	SendMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32, clientMessageID *string) (*model.Message, error)
+	Messages(ctx context.Context, spaceID string, first *int32, before, after *int) ([]*model.Message, error)
+	SearchMessages(ctx context.Context, query string, filter *model.MessageSearchFilter, first *int32, after *string) (*model.MessageSearchConnection, error)
+	MessageSent(ctx context.Context, spaceID string, since *string) (<-chan *model.Message, error)
+	SpaceEvents(ctx context.Context, spaceID string, since *string) (<-chan *model.SpaceEvent, error)
+	PinMessage(ctx context.Context, messageID string) (*model.Message, error)
+	UnpinMessage(ctx context.Context, messageID string) (bool, error)
+	AddReaction(ctx context.Context, messageID, emoji string) (bool, error)
+	RemoveReaction(ctx context.Context, messageID, emoji string) (bool, error)
+	UserEvents(ctx context.Context) (<-chan *model.UserEvent, error)
+}
+
+type ucAttachmentInterface interface {
+	UploadAttachment(ctx context.Context, spaceID string, file graphql.Upload) (*model.Attachment, error)
+	AttachmentPolicy(ctx context.Context, spaceID string) (*model.AttachmentPolicy, error)
+	UpdateAttachmentPolicy(ctx context.Context, spaceID string, request model.AttachmentPolicyRequest) (*model.AttachmentPolicy, error)
+}
+
+type ucSavedMessageInterface interface {
+	SaveMessage(ctx context.Context, messageID string, note *string, remindAt *time.Time) (*model.SavedMessage, error)
+	UnsaveMessage(ctx context.Context, messageID string) (bool, error)
+	SavedMessages(ctx context.Context, first *int32, after *string) (*model.SavedMessageConnection, error)
+}
+
+type ucScheduledMessageInterface interface {
+	ScheduleMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, sendAt time.Time) (*model.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, id string) (bool, error)
+	ScheduledMessages(ctx context.Context, spaceID *string) ([]*model.ScheduledMessage, error)
+}
+
+type ucPollInterface interface {
+	CreatePoll(ctx context.Context, request model.PollRequest) (*model.Message, error)
+	VotePoll(ctx context.Context, pollID string, optionIDs []string) (*model.Poll, error)
+	ClosePoll(ctx context.Context, pollID string) (*model.Poll, error)
+}
+
+type ucSlashCommandInterface interface {
+	RegisterSlashCommand(ctx context.Context, spaceID string, request model.SlashCommandRequest) (*model.SlashCommandRegistration, error)
+	DeleteSlashCommand(ctx context.Context, id string) (bool, error)
+	SlashCommands(ctx context.Context, spaceID string) ([]*model.SlashCommand, error)
+}
+
+type ucWebhookInterface interface {
+	CreateWebhook(ctx context.Context, spaceID string, request model.WebhookRequest) (*model.WebhookRegistration, error)
+	DeleteWebhook(ctx context.Context, id string) (bool, error)
+	RedeliverWebhook(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+	Webhooks(ctx context.Context, spaceID string) ([]*model.Webhook, error)
+	WebhookDeliveries(ctx context.Context, webhookID string, first *int32, after *string) (*model.WebhookDeliveryConnection, error)
+}
+
+type ucIncomingWebhookInterface interface {
+	CreateIncomingWebhook(ctx context.Context, spaceID string, name string, avatar *string) (*model.IncomingWebhookRegistration, error)
+	RevokeIncomingWebhook(ctx context.Context, id string) (bool, error)
+	IncomingWebhooks(ctx context.Context, spaceID string) ([]*model.IncomingWebhook, error)
 }
 
 func NewResolver(
 	ucUser ucUserInterface,
 	ucSpace ucSpaceInterface,
 	ucMessage ucMessageInterface,
+	ucAttachment ucAttachmentInterface,
+	ucSavedMessage ucSavedMessageInterface,
+	ucScheduledMessage ucScheduledMessageInterface,
+	ucPoll ucPollInterface,
+	ucSlashCommand ucSlashCommandInterface,
+	ucWebhook ucWebhookInterface,
+	ucIncomingWebhook ucIncomingWebhookInterface,
 ) (*Resolver, error) {
 	return &Resolver{
-		ucUser:    ucUser,
-		ucSpace:   ucSpace,
-		ucMessage: ucMessage,
+		ucUser:             ucUser,
+		ucSpace:            ucSpace,
+		ucMessage:          ucMessage,
+		ucAttachment:       ucAttachment,
+		ucSavedMessage:     ucSavedMessage,
+		ucScheduledMessage: ucScheduledMessage,
+		ucPoll:             ucPoll,
+		ucSlashCommand:     ucSlashCommand,
+		ucWebhook:          ucWebhook,
+		ucIncomingWebhook:  ucIncomingWebhook,
 	}, nil
 }
 
 type Resolver struct {
-	ucUser    ucUserInterface
-	ucSpace   ucSpaceInterface
-	ucMessage ucMessageInterface
+	ucUser             ucUserInterface
+	ucSpace            ucSpaceInterface
+	ucMessage          ucMessageInterface
+	ucAttachment       ucAttachmentInterface
+	ucSavedMessage     ucSavedMessageInterface
+	ucScheduledMessage ucScheduledMessageInterface
+	ucPoll             ucPollInterface
+	ucSlashCommand     ucSlashCommandInterface
+	ucWebhook          ucWebhookInterface
+	ucIncomingWebhook  ucIncomingWebhookInterface
 }
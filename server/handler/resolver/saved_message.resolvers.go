@@ -0,0 +1,26 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+	"time"
+)
+
+// SaveMessage is the resolver for the saveMessage field.
+func (r *mutationResolver) SaveMessage(ctx context.Context, messageID string, note *string, remindAt *time.Time) (*model.SavedMessage, error) {
+	return r.ucSavedMessage.SaveMessage(ctx, messageID, note, remindAt)
+}
+
+// UnsaveMessage is the resolver for the unsaveMessage field.
+func (r *mutationResolver) UnsaveMessage(ctx context.Context, messageID string) (bool, error) {
+	return r.ucSavedMessage.UnsaveMessage(ctx, messageID)
+}
+
+// SavedMessages is the resolver for the savedMessages field.
+func (r *queryResolver) SavedMessages(ctx context.Context, first *int32, after *string) (*model.SavedMessageConnection, error) {
+	return r.ucSavedMessage.SavedMessages(ctx, first, after)
+}
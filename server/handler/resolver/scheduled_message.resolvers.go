@@ -0,0 +1,26 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+	"time"
+)
+
+// ScheduleMessage is the resolver for the scheduleMessage field.
+func (r *mutationResolver) ScheduleMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, sendAt time.Time) (*model.ScheduledMessage, error) {
+	return r.ucScheduledMessage.ScheduleMessage(ctx, spaceID, content, format, sendAt)
+}
+
+// CancelScheduledMessage is the resolver for the cancelScheduledMessage field.
+func (r *mutationResolver) CancelScheduledMessage(ctx context.Context, id string) (bool, error) {
+	return r.ucScheduledMessage.CancelScheduledMessage(ctx, id)
+}
+
+// ScheduledMessages is the resolver for the scheduledMessages field.
+func (r *queryResolver) ScheduledMessages(ctx context.Context, spaceID *string) ([]*model.ScheduledMessage, error) {
+	return r.ucScheduledMessage.ScheduledMessages(ctx, spaceID)
+}
@@ -0,0 +1,25 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+)
+
+// RegisterSlashCommand is the resolver for the registerSlashCommand field.
+func (r *mutationResolver) RegisterSlashCommand(ctx context.Context, spaceID string, request model.SlashCommandRequest) (*model.SlashCommandRegistration, error) {
+	return r.ucSlashCommand.RegisterSlashCommand(ctx, spaceID, request)
+}
+
+// DeleteSlashCommand is the resolver for the deleteSlashCommand field.
+func (r *mutationResolver) DeleteSlashCommand(ctx context.Context, id string) (bool, error) {
+	return r.ucSlashCommand.DeleteSlashCommand(ctx, id)
+}
+
+// SlashCommands is the resolver for the slashCommands field.
+func (r *queryResolver) SlashCommands(ctx context.Context, spaceID string) ([]*model.SlashCommand, error) {
+	return r.ucSlashCommand.SlashCommands(ctx, spaceID)
+}
@@ -19,6 +19,21 @@ func (r *mutationResolver) JoinSpace(ctx context.Context, spaceID string) (*mode
 	return r.ucSpace.JoinSpace(ctx, spaceID)
 }
 
+// UpdateSpaceMessageTTL is the resolver for the updateSpaceMessageTTL field.
+func (r *mutationResolver) UpdateSpaceMessageTTL(ctx context.Context, spaceID string, ttl *int32) (*model.Space, error) {
+	return r.ucSpace.UpdateSpaceMessageTTL(ctx, spaceID, ttl)
+}
+
+// UpdateRetentionPolicy is the resolver for the updateRetentionPolicy field.
+func (r *mutationResolver) UpdateRetentionPolicy(ctx context.Context, spaceID string, request model.RetentionPolicyRequest) (*model.Space, error) {
+	return r.ucSpace.UpdateRetentionPolicy(ctx, spaceID, request)
+}
+
+// MarkSpaceRead is the resolver for the markSpaceRead field.
+func (r *mutationResolver) MarkSpaceRead(ctx context.Context, spaceID string, seq int) (*model.Space, error) {
+	return r.ucSpace.MarkSpaceRead(ctx, spaceID, seq)
+}
+
 // Spaces is the resolver for the spaces field.
 func (r *queryResolver) Spaces(ctx context.Context) ([]*model.Space, error) {
 	return r.ucSpace.Spaces(ctx)
@@ -28,3 +43,13 @@ func (r *queryResolver) Spaces(ctx context.Context) ([]*model.Space, error) {
 func (r *queryResolver) Space(ctx context.Context, id string) (*model.Space, error) {
 	return r.ucSpace.Space(ctx, id)
 }
+
+// MySpaces is the resolver for the mySpaces field.
+func (r *queryResolver) MySpaces(ctx context.Context) ([]*model.Space, error) {
+	return r.ucSpace.MySpaces(ctx)
+}
+
+// SearchSpaces is the resolver for the searchSpaces field.
+func (r *queryResolver) SearchSpaces(ctx context.Context, query *string, first *int32, after *string, sort *model.SpaceSort) (*model.SpaceConnection, error) {
+	return r.ucSpace.SearchSpaces(ctx, query, first, after, sort)
+}
@@ -25,11 +25,51 @@ func (r *mutationResolver) RefreshToken(ctx context.Context, request model.Refre
 	return r.ucUser.RefreshToken(ctx, request)
 }
 
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, userID string) (*model.User, error) {
+	return r.ucUser.BlockUser(ctx, userID)
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, userID string) (bool, error) {
+	return r.ucUser.UnblockUser(ctx, userID)
+}
+
+// CreateBot is the resolver for the createBot field.
+func (r *mutationResolver) CreateBot(ctx context.Context, spaceID string, name string, avatar *string) (*model.BotRegistration, error) {
+	return r.ucUser.CreateBot(ctx, spaceID, name, avatar)
+}
+
+// RotateBotToken is the resolver for the rotateBotToken field.
+func (r *mutationResolver) RotateBotToken(ctx context.Context, spaceID string, botID string) (*model.BotRegistration, error) {
+	return r.ucUser.RotateBotToken(ctx, spaceID, botID)
+}
+
+// BotAccessToken is the resolver for the botAccessToken field.
+func (r *mutationResolver) BotAccessToken(ctx context.Context, token string) (*model.AuthResponse, error) {
+	return r.ucUser.BotAccessToken(ctx, token)
+}
+
 // User is the resolver for the user field.
 func (r *queryResolver) User(ctx context.Context) (*model.User, error) {
 	return r.ucUser.User(ctx)
 }
 
+// BlockedUsers is the resolver for the blockedUsers field.
+func (r *queryResolver) BlockedUsers(ctx context.Context) ([]*model.User, error) {
+	return r.ucUser.BlockedUsers(ctx)
+}
+
+// SearchUsers is the resolver for the searchUsers field.
+func (r *queryResolver) SearchUsers(ctx context.Context, query string, first *int32, after *string) (*model.UserSearchConnection, error) {
+	return r.ucUser.SearchUsers(ctx, query, first, after)
+}
+
+// UserEvents is the resolver for the userEvents field.
+func (r *subscriptionResolver) UserEvents(ctx context.Context) (<-chan *model.UserEvent, error) {
+	return r.ucMessage.UserEvents(ctx)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
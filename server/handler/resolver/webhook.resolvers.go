@@ -0,0 +1,35 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"chatspace-server/graph/model"
+	"context"
+)
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, spaceID string, request model.WebhookRequest) (*model.WebhookRegistration, error) {
+	return r.ucWebhook.CreateWebhook(ctx, spaceID, request)
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	return r.ucWebhook.DeleteWebhook(ctx, id)
+}
+
+// RedeliverWebhook is the resolver for the redeliverWebhook field.
+func (r *mutationResolver) RedeliverWebhook(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	return r.ucWebhook.RedeliverWebhook(ctx, deliveryID)
+}
+
+// Webhooks is the resolver for the webhooks field.
+func (r *queryResolver) Webhooks(ctx context.Context, spaceID string) ([]*model.Webhook, error) {
+	return r.ucWebhook.Webhooks(ctx, spaceID)
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, webhookID string, first *int32, after *string) (*model.WebhookDeliveryConnection, error) {
+	return r.ucWebhook.WebhookDeliveries(ctx, webhookID, first, after)
+}
@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"chatspace-server/constant"
+	modelDB "chatspace-server/model"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+type ucAttachmentInterface interface {
+	OpenAttachment(ctx context.Context, id, variant, userID string, expires int64, signature string) (*modelDB.AttachmentDB, io.ReadCloser, error)
+}
+
+type AttachmentHandler struct {
+	ucAttachment ucAttachmentInterface
+	zlog         zerolog.Logger
+}
+
+func NewAttachmentHandler(ucAttachment ucAttachmentInterface, zlog zerolog.Logger) *AttachmentHandler {
+	return &AttachmentHandler{
+		ucAttachment: ucAttachment,
+		zlog:         zlog,
+	}
+}
+
+func (h *AttachmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/attachments/")
+	query := r.URL.Query()
+
+	expires, err := strconv.ParseInt(query.Get("exp"), 10, 64)
+	if err != nil {
+		http.Error(w, constant.ErrInvalidSignature.Error(), http.StatusForbidden)
+		return
+	}
+
+	attachment, body, err := h.ucAttachment.OpenAttachment(r.Context(), id, query.Get("thumb"), query.Get("uid"), expires, query.Get("sig"))
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrInvalidSignature), errors.Is(err, constant.ErrNotSpaceMember):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		case errors.Is(err, constant.ErrAttachmentNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			h.zlog.Error().Err(err).Str("attachment", id).Msg("failed to open attachment")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+	defer body.Close()
+
+	disposition := "attachment"
+	if strings.HasPrefix(attachment.ContentType, "image/") {
+		disposition = "inline"
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=300")
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	if _, err := io.Copy(w, body); err != nil {
+		h.zlog.Error().Err(err).Str("attachment", id).Msg("failed to stream attachment")
+	}
+}
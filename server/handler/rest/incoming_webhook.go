@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+type ucIncomingWebhookInterface interface {
+	PostMessage(ctx context.Context, id, token string, payload *modelDB.IncomingWebhookPayload) (*model.Message, error)
+}
+
+type IncomingWebhookHandler struct {
+	ucIncomingWebhook ucIncomingWebhookInterface
+	zlog              zerolog.Logger
+}
+
+func NewIncomingWebhookHandler(ucIncomingWebhook ucIncomingWebhookInterface, zlog zerolog.Logger) *IncomingWebhookHandler {
+	return &IncomingWebhookHandler{
+		ucIncomingWebhook: ucIncomingWebhook,
+		zlog:              zlog,
+	}
+}
+
+// ServeHTTP accepts POST /hooks/{id}/{token} with a JSON body and posts it
+// to the webhook's space as the webhook's bot.
+func (h *IncomingWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, token, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, constant.INCOMING_WEBHOOK_PATH), "/")
+	if !ok || id == "" || token == "" {
+		http.Error(w, constant.ErrIncomingWebhookNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	var payload modelDB.IncomingWebhookPayload
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, constant.INCOMING_WEBHOOK_MAX_BODY)).Decode(&payload)
+	if err != nil {
+		http.Error(w, "invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	message, err := h.ucIncomingWebhook.PostMessage(r.Context(), id, token, &payload)
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrIncomingWebhookNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, constant.ErrRateLimited):
+			w.Header().Set("Retry-After", strconv.Itoa(int(constant.INCOMING_WEBHOOK_RATE_WINDOW.Seconds())))
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+		case errors.Is(err, constant.ErrNotSpaceMember):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		case errors.Is(err, constant.ErrEmptyMessage), errors.Is(err, constant.ErrTooManyAttachments),
+			errors.Is(err, constant.ErrAttachmentTooLarge), errors.Is(err, constant.ErrAttachmentTypeNotAllowed),
+			errors.Is(err, constant.ErrAttachmentNotFound), errors.Is(err, constant.ErrAttachmentFailed):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			h.zlog.Error().Err(err).Str("incoming_webhook", id).Msg("failed to post incoming webhook message")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"id": message.ID})
+}
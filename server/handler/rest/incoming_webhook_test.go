@@ -0,0 +1,79 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+
+	"github.com/rs/zerolog"
+)
+
+type fakeIncomingWebhooks struct {
+	err     error
+	payload *modelDB.IncomingWebhookPayload
+}
+
+func (f *fakeIncomingWebhooks) PostMessage(ctx context.Context, id, token string, payload *modelDB.IncomingWebhookPayload) (*model.Message, error) {
+	f.payload = payload
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &model.Message{ID: "message-id"}, nil
+}
+
+func TestIncomingWebhookHandler(t *testing.T) {
+	image := []byte("\x89PNG\r\n\x1a\nnot really a png")
+	body, err := json.Marshal(map[string]any{
+		"text": "deploy finished",
+		"attachments": []map[string]string{
+			{"filename": "graph.png", "data": base64.StdEncoding.EncodeToString(image)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"posted", nil, http.StatusOK},
+		{"unknown webhook", constant.ErrIncomingWebhookNotFound, http.StatusNotFound},
+		{"rate limited", constant.ErrRateLimited, http.StatusTooManyRequests},
+		{"bot removed from space", constant.ErrNotSpaceMember, http.StatusForbidden},
+		{"too large", constant.ErrAttachmentTooLarge, http.StatusBadRequest},
+		{"type not allowed", constant.ErrAttachmentTypeNotAllowed, http.StatusBadRequest},
+		{"attachment gone", constant.ErrAttachmentNotFound, http.StatusBadRequest},
+		{"attachment failed", constant.ErrAttachmentFailed, http.StatusBadRequest},
+		{"unexpected", context.DeadlineExceeded, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &fakeIncomingWebhooks{err: tt.err}
+			h := NewIncomingWebhookHandler(uc, zerolog.Nop())
+
+			req := httptest.NewRequest(http.MethodPost, constant.INCOMING_WEBHOOK_PATH+"id/token", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			if uc.payload == nil || len(uc.payload.Attachments) != 1 || !bytes.Equal(uc.payload.Attachments[0].Data, image) {
+				t.Fatalf("PostMessage() got payload %+v, want the decoded image", uc.payload)
+			}
+		})
+	}
+}
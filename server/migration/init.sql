@@ -16,7 +16,11 @@ CREATE TABLE IF NOT EXISTS "spaces" (
   updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
-CREATE TYPE space_member_role AS ENUM ('admin', 'member');
+DO $$ BEGIN
+  CREATE TYPE space_member_role AS ENUM ('admin', 'member');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
 
 CREATE TABLE IF NOT EXISTS "space_members" (
   id UUID PRIMARY KEY,
@@ -37,4 +41,372 @@ CREATE TABLE IF NOT EXISTS "messages" (
   created_at TIMESTAMPTZ DEFAULT NOW(),
   FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
   FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+CREATE TABLE IF NOT EXISTS "user_blocks" (
+  id UUID PRIMARY KEY,
+  blocker_id UUID NOT NULL,
+  blocked_id UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (blocker_id, blocked_id),
+  FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE "messages"
+  ADD COLUMN IF NOT EXISTS content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED;
+
+CREATE INDEX IF NOT EXISTS messages_content_tsv_idx ON "messages" USING GIN (content_tsv);
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS spaces_name_trgm_idx ON "spaces" USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS spaces_description_trgm_idx ON "spaces" USING GIN (description gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS messages_space_id_created_at_idx ON "messages" (space_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS users_name_lower_idx ON "users" (lower(name) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS users_email_lower_idx ON "users" (lower(email));
+
+CREATE TABLE IF NOT EXISTS "attachments" (
+  id UUID PRIMARY KEY,
+  space_id UUID NOT NULL,
+  user_id UUID NOT NULL,
+  message_id UUID,
+  storage_key VARCHAR(512) NOT NULL,
+  filename VARCHAR(255) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  size BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS attachments_message_id_idx ON "attachments" (message_id);
+
+ALTER TABLE "spaces"
+  ADD COLUMN IF NOT EXISTS attachment_max_size BIGINT,
+  ADD COLUMN IF NOT EXISTS attachment_mime_types TEXT[];
+
+DO $$ BEGIN
+  CREATE TYPE attachment_status AS ENUM ('pending', 'ready', 'failed');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
+
+ALTER TABLE "attachments"
+  ADD COLUMN IF NOT EXISTS status attachment_status NOT NULL DEFAULT 'ready',
+  ADD COLUMN IF NOT EXISTS width INT,
+  ADD COLUMN IF NOT EXISTS height INT,
+  ADD COLUMN IF NOT EXISTS blurhash VARCHAR(64);
+
+CREATE INDEX IF NOT EXISTS attachments_pending_idx ON "attachments" (created_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS "attachment_thumbnails" (
+  id UUID PRIMARY KEY,
+  attachment_id UUID NOT NULL,
+  width INT NOT NULL,
+  height INT NOT NULL,
+  storage_key VARCHAR(512) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  size BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (attachment_id) REFERENCES attachments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS attachment_thumbnails_attachment_id_idx ON "attachment_thumbnails" (attachment_id);
+
+DO $$ BEGIN
+  CREATE TYPE link_preview_status AS ENUM ('ok', 'failed');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS "link_previews" (
+  url TEXT PRIMARY KEY,
+  status link_preview_status NOT NULL,
+  title TEXT,
+  description TEXT,
+  image_url TEXT,
+  site_name TEXT,
+  fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS "message_link_previews" (
+  message_id UUID NOT NULL,
+  url TEXT NOT NULL,
+  position INT NOT NULL,
+  PRIMARY KEY (message_id, url),
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+  FOREIGN KEY (url) REFERENCES link_previews(url) ON DELETE CASCADE
+);
+
+DO $$ BEGIN
+  CREATE TYPE message_format AS ENUM ('plain', 'markdown');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
+
+ALTER TABLE "messages"
+  ADD COLUMN IF NOT EXISTS format message_format NOT NULL DEFAULT 'plain',
+  ADD COLUMN IF NOT EXISTS blocks JSONB NOT NULL DEFAULT '[]',
+  ADD COLUMN IF NOT EXISTS html TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS "pinned_messages" (
+  message_id UUID PRIMARY KEY,
+  space_id UUID NOT NULL,
+  pinned_by UUID NOT NULL,
+  pinned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (pinned_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS pinned_messages_space_id_idx ON "pinned_messages" (space_id, pinned_at DESC);
+
+CREATE TABLE IF NOT EXISTS "saved_messages" (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL,
+  message_id UUID NOT NULL,
+  note TEXT,
+  remind_at TIMESTAMPTZ,
+  reminded_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (user_id, message_id),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS saved_messages_user_id_idx ON "saved_messages" (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS saved_messages_due_idx ON "saved_messages" (remind_at) WHERE reminded_at IS NULL;
+
+DO $$ BEGIN
+  CREATE TYPE scheduled_message_status AS ENUM ('pending', 'sent', 'canceled', 'failed');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS "scheduled_messages" (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL,
+  space_id UUID NOT NULL,
+  content TEXT NOT NULL,
+  format message_format NOT NULL DEFAULT 'plain',
+  send_at TIMESTAMPTZ NOT NULL,
+  status scheduled_message_status NOT NULL DEFAULT 'pending',
+  message_id UUID,
+  last_error TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS scheduled_messages_due_idx ON "scheduled_messages" (send_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS scheduled_messages_user_id_idx ON "scheduled_messages" (user_id, send_at);
+
+ALTER TABLE "messages"
+  ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS messages_expires_at_idx ON "messages" (expires_at) WHERE expires_at IS NOT NULL;
+
+ALTER TABLE "spaces"
+  ADD COLUMN IF NOT EXISTS message_ttl INT;
+
+DO $$ BEGIN
+  CREATE TYPE retention_mode AS ENUM ('default', 'forever', 'days', 'messages');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
+
+ALTER TABLE "spaces"
+  ADD COLUMN IF NOT EXISTS retention_mode retention_mode NOT NULL DEFAULT 'default',
+  ADD COLUMN IF NOT EXISTS retention_value INT;
+
+CREATE TABLE IF NOT EXISTS "polls" (
+  id UUID PRIMARY KEY,
+  message_id UUID NOT NULL UNIQUE,
+  space_id UUID NOT NULL,
+  question TEXT NOT NULL,
+  multi_choice BOOLEAN NOT NULL DEFAULT FALSE,
+  anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+  closes_at TIMESTAMPTZ,
+  closed_at TIMESTAMPTZ,
+  created_by UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "poll_options" (
+  id UUID PRIMARY KEY,
+  poll_id UUID NOT NULL,
+  position INT NOT NULL,
+  text TEXT NOT NULL,
+  FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS poll_options_poll_id_idx ON "poll_options" (poll_id, position);
+
+CREATE TABLE IF NOT EXISTS "poll_votes" (
+  poll_id UUID NOT NULL,
+  option_id UUID NOT NULL,
+  user_id UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (option_id, user_id),
+  FOREIGN KEY (poll_id) REFERENCES polls(id) ON DELETE CASCADE,
+  FOREIGN KEY (option_id) REFERENCES poll_options(id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS poll_votes_poll_user_idx ON "poll_votes" (poll_id, user_id);
+
+CREATE TABLE IF NOT EXISTS "slash_commands" (
+  id UUID PRIMARY KEY,
+  space_id UUID NOT NULL,
+  name VARCHAR(32) NOT NULL,
+  url TEXT NOT NULL,
+  secret TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  created_by UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (space_id, name),
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE "scheduled_messages"
+  ADD COLUMN IF NOT EXISTS ephemeral BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS "webhooks" (
+  id UUID PRIMARY KEY,
+  space_id UUID NOT NULL,
+  url TEXT NOT NULL,
+  secret TEXT NOT NULL,
+  events TEXT[] NOT NULL,
+  created_by UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_space_id_idx ON "webhooks" (space_id);
+
+DO $$ BEGIN
+  CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'succeeded', 'failed');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+  id UUID PRIMARY KEY,
+  webhook_id UUID NOT NULL,
+  event_type TEXT NOT NULL,
+  payload JSONB NOT NULL,
+  status webhook_delivery_status NOT NULL DEFAULT 'pending',
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  response_status INT,
+  last_error TEXT,
+  delivered_at TIMESTAMPTZ,
+  redelivery_of UUID,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE,
+  FOREIGN KEY (redelivery_of) REFERENCES webhook_deliveries(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON "webhook_deliveries" (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON "webhook_deliveries" (webhook_id, created_at DESC);
+
+ALTER TABLE "users"
+  ADD COLUMN IF NOT EXISTS bot BOOLEAN NOT NULL DEFAULT FALSE,
+  ADD COLUMN IF NOT EXISTS avatar_url TEXT;
+
+CREATE TABLE IF NOT EXISTS "incoming_webhooks" (
+  id UUID PRIMARY KEY,
+  space_id UUID NOT NULL,
+  bot_user_id UUID NOT NULL,
+  token_hash TEXT NOT NULL,
+  created_by UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  revoked_at TIMESTAMPTZ,
+  FOREIGN KEY (space_id) REFERENCES spaces(id) ON DELETE CASCADE,
+  FOREIGN KEY (bot_user_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS incoming_webhooks_space_id_idx ON "incoming_webhooks" (space_id);
+
+CREATE TABLE IF NOT EXISTS "broker_messages" (
+  id BIGSERIAL PRIMARY KEY,
+  channel TEXT NOT NULL,
+  dedup_id TEXT,
+  payload BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS broker_messages_channel_id_idx ON "broker_messages" (channel, id);
+CREATE INDEX IF NOT EXISTS broker_messages_created_at_idx ON "broker_messages" (created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS broker_messages_dedup_idx ON "broker_messages" (channel, dedup_id) WHERE dedup_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS "outbox" (
+  id BIGSERIAL PRIMARY KEY,
+  dedup_id UUID NOT NULL UNIQUE,
+  channel TEXT NOT NULL,
+  payload BYTEA NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "messages"
+  ADD COLUMN IF NOT EXISTS client_message_id TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS messages_client_message_id_idx ON "messages" (user_id, space_id, client_message_id) WHERE client_message_id IS NOT NULL;
+
+ALTER TABLE "spaces"
+  ADD COLUMN IF NOT EXISTS last_message_seq BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "messages"
+  ADD COLUMN IF NOT EXISTS seq BIGINT;
+
+UPDATE "messages" m
+SET seq = n.seq
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY space_id ORDER BY created_at, id) AS seq FROM "messages") n
+WHERE m.id = n.id AND m.seq IS NULL;
+
+UPDATE "spaces" s
+SET last_message_seq = m.seq
+FROM (SELECT space_id, MAX(seq) AS seq FROM "messages" GROUP BY space_id) m
+WHERE s.id = m.space_id AND s.last_message_seq < m.seq;
+
+ALTER TABLE "messages"
+  ALTER COLUMN seq SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS messages_space_id_seq_idx ON "messages" (space_id, seq);
+
+ALTER TABLE "space_members"
+  ADD COLUMN IF NOT EXISTS last_read_seq BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "message_reactions" (
+  message_id UUID NOT NULL,
+  user_id UUID NOT NULL,
+  emoji TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (message_id, user_id, emoji),
+  FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+UPDATE "webhooks"
+SET events = array_remove(array_remove(events, 'MESSAGE_EDITED'), 'MEMBER_LEFT')
+WHERE events && ARRAY['MESSAGE_EDITED', 'MEMBER_LEFT'];
+
+ALTER TABLE "users"
+  ADD COLUMN IF NOT EXISTS token_version INT NOT NULL DEFAULT 0;
+
+ALTER TABLE "outbox"
+  ADD COLUMN IF NOT EXISTS attempted_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS parked_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS outbox_retrying_idx ON "outbox" (channel, attempted_at) WHERE parked_at IS NULL AND attempted_at IS NOT NULL;
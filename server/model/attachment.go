@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type AttachmentDB struct {
+	ID          uuid.UUID  `db:"id"`
+	SpaceID     uuid.UUID  `db:"space_id"`
+	UserID      uuid.UUID  `db:"user_id"`
+	MessageID   *uuid.UUID `db:"message_id"`
+	StorageKey  string     `db:"storage_key"`
+	Filename    string     `db:"filename"`
+	ContentType string     `db:"content_type"`
+	Size        int64      `db:"size"`
+	Status      string     `db:"status"`
+	Width       *int       `db:"width"`
+	Height      *int       `db:"height"`
+	BlurHash    *string    `db:"blurhash"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+type AttachmentThumbnailDB struct {
+	ID           uuid.UUID `db:"id"`
+	AttachmentID uuid.UUID `db:"attachment_id"`
+	Width        int       `db:"width"`
+	Height       int       `db:"height"`
+	StorageKey   string    `db:"storage_key"`
+	ContentType  string    `db:"content_type"`
+	Size         int64     `db:"size"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type AttachmentPolicyDB struct {
+	MaxSize   *int64         `db:"attachment_max_size"`
+	MimeTypes pq.StringArray `db:"attachment_mime_types"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IncomingWebhookDB struct {
+	ID        uuid.UUID  `db:"id"`
+	SpaceID   uuid.UUID  `db:"space_id"`
+	BotUserID uuid.UUID  `db:"bot_user_id"`
+	TokenHash string     `db:"token_hash"`
+	CreatedBy uuid.UUID  `db:"created_by"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	Name      string     `db:"name"`
+	AvatarURL *string    `db:"avatar_url"`
+}
+
+// IncomingWebhookPayload is the JSON body external systems POST to an
+// incoming webhook URL. Attachment data is base64 encoded.
+type IncomingWebhookPayload struct {
+	Text        string                       `json:"text"`
+	Markdown    bool                         `json:"markdown"`
+	Attachments []*IncomingWebhookAttachment `json:"attachments"`
+}
+
+type IncomingWebhookAttachment struct {
+	Filename string `json:"filename"`
+	Data     []byte `json:"data"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+)
+
+type LinkPreviewDB struct {
+	URL         string    `db:"url"`
+	Status      string    `db:"status"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	ImageURL    string    `db:"image_url"`
+	SiteName    string    `db:"site_name"`
+	FetchedAt   time.Time `db:"fetched_at"`
+}
@@ -4,12 +4,38 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type MessageDB struct {
-	ID        uuid.UUID `db:"id"`
-	Content   string    `db:"content"`
-	UserID    uuid.UUID `db:"user_id"`
-	SpaceID   uuid.UUID `db:"space_id"`
-	CreatedAt time.Time `db:"created_at"`
+	ID              uuid.UUID  `db:"id"`
+	Content         string     `db:"content"`
+	Format          string     `db:"format"`
+	Blocks          []byte     `db:"blocks"`
+	HTML            string     `db:"html"`
+	UserID          uuid.UUID  `db:"user_id"`
+	SpaceID         uuid.UUID  `db:"space_id"`
+	CreatedAt       time.Time  `db:"created_at"`
+	ExpiresAt       *time.Time `db:"expires_at"`
+	ClientMessageID *string    `db:"client_message_id"`
+	Seq             int64      `db:"seq"`
+}
+
+// DeletedMessageDB is a hard-deleted message. StorageKeys lists the blobs
+// of its attachments and their thumbnails, which outlive the rows.
+type DeletedMessageDB struct {
+	ID          uuid.UUID      `db:"id"`
+	SpaceID     uuid.UUID      `db:"space_id"`
+	UserID      uuid.UUID      `db:"user_id"`
+	StorageKeys pq.StringArray `db:"storage_keys"`
+}
+
+// MessageListParams selects a page of a space's messages by seq. With
+// After set the page starts right after it; otherwise it ends right before
+// Before, or at the newest message.
+type MessageListParams struct {
+	SpaceID uuid.UUID
+	Before  *int64
+	After   *int64
+	Limit   int
 }
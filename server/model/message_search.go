@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageSearchParams struct {
+	UserID        uuid.UUID
+	Query         string
+	SpaceID       *uuid.UUID
+	FromUserID    *uuid.UUID
+	Before        *time.Time
+	After         *time.Time
+	HasAttachment *bool
+	Limit         int
+	Offset        int
+}
+
+type MessageSearchDB struct {
+	MessageDB
+	Snippet string  `db:"snippet"`
+	Rank    float64 `db:"rank"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxDB is a broker publish recorded in the transaction of the write
+// it announces. DedupID lets the broker drop a repeated publish. A row
+// that keeps failing is parked: kept for inspection but no longer relayed.
+type OutboxDB struct {
+	ID          int64      `db:"id"`
+	DedupID     uuid.UUID  `db:"dedup_id"`
+	Channel     string     `db:"channel"`
+	Payload     []byte     `db:"payload"`
+	Attempts    int        `db:"attempts"`
+	LastError   *string    `db:"last_error"`
+	AttemptedAt *time.Time `db:"attempted_at"`
+	ParkedAt    *time.Time `db:"parked_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PinnedMessageDB struct {
+	SpaceID   uuid.UUID `db:"space_id"`
+	MessageID uuid.UUID `db:"message_id"`
+	PinnedBy  uuid.UUID `db:"pinned_by"`
+	PinnedAt  time.Time `db:"pinned_at"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PollDB struct {
+	ID          uuid.UUID  `db:"id"`
+	MessageID   uuid.UUID  `db:"message_id"`
+	SpaceID     uuid.UUID  `db:"space_id"`
+	Question    string     `db:"question"`
+	MultiChoice bool       `db:"multi_choice"`
+	Anonymous   bool       `db:"anonymous"`
+	ClosesAt    *time.Time `db:"closes_at"`
+	ClosedAt    *time.Time `db:"closed_at"`
+	CreatedBy   uuid.UUID  `db:"created_by"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+type PollOptionDB struct {
+	ID        uuid.UUID `db:"id"`
+	PollID    uuid.UUID `db:"poll_id"`
+	Position  int       `db:"position"`
+	Text      string    `db:"text"`
+	VoteCount int       `db:"vote_count"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReactionDB struct {
+	MessageID uuid.UUID `db:"message_id"`
+	UserID    uuid.UUID `db:"user_id"`
+	Emoji     string    `db:"emoji"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ReactionCountDB is one emoji's reactions on a message. Reacted tells
+// whether the viewing user is among them.
+type ReactionCountDB struct {
+	Emoji   string `db:"emoji"`
+	Count   int    `db:"count"`
+	Reacted bool   `db:"reacted"`
+}
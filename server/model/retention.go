@@ -0,0 +1,9 @@
+package model
+
+import "github.com/google/uuid"
+
+type RetentionPolicyDB struct {
+	SpaceID uuid.UUID `db:"id"`
+	Mode    string    `db:"retention_mode"`
+	Value   *int      `db:"retention_value"`
+}
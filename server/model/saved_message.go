@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SavedMessageDB struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	MessageID  uuid.UUID  `db:"message_id"`
+	Note       *string    `db:"note"`
+	RemindAt   *time.Time `db:"remind_at"`
+	RemindedAt *time.Time `db:"reminded_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	Message    MessageDB  `db:"message"`
+}
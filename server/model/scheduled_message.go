@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduledMessageDB struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	SpaceID   uuid.UUID  `db:"space_id"`
+	Content   string     `db:"content"`
+	Format    string     `db:"format"`
+	Ephemeral bool       `db:"ephemeral"`
+	SendAt    time.Time  `db:"send_at"`
+	Status    string     `db:"status"`
+	MessageID *uuid.UUID `db:"message_id"`
+	LastError *string    `db:"last_error"`
+	CreatedAt time.Time  `db:"created_at"`
+}
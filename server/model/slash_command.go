@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SlashCommandDB struct {
+	ID          uuid.UUID `db:"id"`
+	SpaceID     uuid.UUID `db:"space_id"`
+	Name        string    `db:"name"`
+	URL         string    `db:"url"`
+	Secret      string    `db:"secret"`
+	Description string    `db:"description"`
+	CreatedBy   uuid.UUID `db:"created_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
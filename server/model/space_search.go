@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SpaceStatsDB struct {
+	MemberCount    int        `db:"member_count"`
+	LastActivityAt *time.Time `db:"last_activity_at"`
+	IsMember       bool       `db:"is_member"`
+	LastReadSeq    int64      `db:"last_read_seq"`
+	UnreadCount    int64      `db:"unread_count"`
+}
+
+type SpaceSearchParams struct {
+	UserID uuid.UUID
+	Query  string
+	Sort   string
+	Limit  int
+	Offset int
+}
+
+type SpaceSearchDB struct {
+	SpaceDB
+	SpaceStatsDB
+	Score float64 `db:"score"`
+}
@@ -11,6 +11,10 @@ type UserDB struct {
 	Email     string    `db:"email"`
 	Name      string    `db:"name"`
 	Password  string    `db:"password"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	Bot       bool      `db:"bot"`
+	AvatarURL *string   `db:"avatar_url"`
+	// TokenVersion is embedded in bot tokens; bumping it revokes them.
+	TokenVersion int       `db:"token_version"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
 }
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserBlockDB struct {
+	ID        uuid.UUID `db:"id"`
+	BlockerID uuid.UUID `db:"blocker_id"`
+	BlockedID uuid.UUID `db:"blocked_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
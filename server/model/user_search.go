@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type UserSearchParams struct {
+	UserID uuid.UUID
+	Query  string
+	Limit  int
+	Offset int
+}
+
+type UserSearchDB struct {
+	UserDB
+	EmailMatch       bool `db:"email_match"`
+	SharedSpaceCount int  `db:"shared_space_count"`
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type WebhookDB struct {
+	ID        uuid.UUID      `db:"id"`
+	SpaceID   uuid.UUID      `db:"space_id"`
+	URL       string         `db:"url"`
+	Secret    string         `db:"secret"`
+	Events    pq.StringArray `db:"events"`
+	CreatedBy uuid.UUID      `db:"created_by"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+type WebhookDeliveryDB struct {
+	ID             uuid.UUID  `db:"id"`
+	WebhookID      uuid.UUID  `db:"webhook_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	ResponseStatus *int       `db:"response_status"`
+	LastError      *string    `db:"last_error"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+	RedeliveryOf   *uuid.UUID `db:"redelivery_of"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// WebhookJobDB is a claimed delivery together with where to send it.
+type WebhookJobDB struct {
+	WebhookDeliveryDB
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
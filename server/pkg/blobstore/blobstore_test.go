@@ -0,0 +1,138 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// testStore runs the behaviour every BlobStore must share against s. Keys
+// are prefixed with prefix so runs against a shared bucket do not collide.
+func testStore(t *testing.T, s BlobStore, prefix string) {
+	ctx := context.Background()
+
+	put := func(t *testing.T, key, data string) {
+		t.Helper()
+
+		err := s.Put(ctx, key, bytes.NewReader([]byte(data)), int64(len(data)), "text/plain")
+		if err != nil {
+			t.Fatalf("Put(%q) error = %v", key, err)
+		}
+	}
+
+	get := func(t *testing.T, key string) (string, error) {
+		t.Helper()
+
+		r, err := s.Get(ctx, key)
+		if err != nil {
+			return "", err
+		}
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("reading %q: %v", key, err)
+		}
+
+		return string(data), nil
+	}
+
+	t.Run("put and get", func(t *testing.T) {
+		key := prefix + "/attachments/a/original"
+		put(t, key, "hello")
+
+		got, err := get(t, key)
+		if err != nil || got != "hello" {
+			t.Fatalf("Get(%q) = %q, %v, want %q", key, got, err, "hello")
+		}
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		key := prefix + "/attachments/b/original"
+		put(t, key, "first")
+		put(t, key, "second")
+
+		got, err := get(t, key)
+		if err != nil || got != "second" {
+			t.Fatalf("Get(%q) = %q, %v, want %q", key, got, err, "second")
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := get(t, prefix+"/attachments/missing/original")
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Get() of a missing key error = %v, want %v", err, ErrNotFound)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		key := prefix + "/attachments/c/original"
+		put(t, key, "bye")
+
+		err := s.Delete(ctx, key)
+		if err != nil {
+			t.Fatalf("Delete(%q) error = %v", key, err)
+		}
+
+		_, err = get(t, key)
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Get() after Delete() error = %v, want %v", err, ErrNotFound)
+		}
+
+		// Purges may remove a blob twice, so a missing key is not an error.
+		err = s.Delete(ctx, key)
+		if err != nil {
+			t.Fatalf("Delete() of a missing key error = %v, want nil", err)
+		}
+	})
+}
+
+func TestLocalStore(t *testing.T) {
+	s, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testStore(t, s, "test")
+
+	for _, key := range []string{"", "/", "../escape", "a/../../escape"} {
+		err := s.Put(context.Background(), key, bytes.NewReader(nil), 0, "text/plain")
+		if err == nil {
+			t.Errorf("Put(%q) succeeded, want an invalid key error", key)
+		}
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	cfg := S3Config{
+		Endpoint:  getenv(t, "CHATSPACE_TEST_S3_ENDPOINT"),
+		AccessKey: getenv(t, "CHATSPACE_TEST_S3_ACCESS_KEY"),
+		SecretKey: getenv(t, "CHATSPACE_TEST_S3_SECRET_KEY"),
+		Bucket:    getenv(t, "CHATSPACE_TEST_S3_BUCKET"),
+	}
+
+	s, err := NewS3Store(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testStore(t, s, "test-"+strconv.FormatInt(time.Now().UnixNano(), 36))
+}
+
+// getenv returns the environment variable name, skipping the test when it
+// is not set. The S3 test runs against MinIO or any S3-compatible service.
+func getenv(t *testing.T, name string) string {
+	t.Helper()
+
+	value := os.Getenv(name)
+	if value == "" {
+		t.Skip(name + " is not set")
+	}
+
+	return value
+}
@@ -0,0 +1,392 @@
+// Package botsdk is a small client for writing ChatSpace bots. A bot signs
+// in with the token returned by the createBot mutation, listens to space
+// events over the GraphQL websocket and answers through the regular API.
+package botsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	FormatPlain    = "PLAIN"
+	FormatMarkdown = "MARKDOWN"
+
+	EventMessageCreated  = "MESSAGE_CREATED"
+	EventMessageDeleted  = "MESSAGE_DELETED"
+	EventMemberJoined    = "MEMBER_JOINED"
+	EventReactionAdded   = "REACTION_ADDED"
+	EventReactionRemoved = "REACTION_REMOVED"
+)
+
+var ErrForbidden = errors.New("forbidden access")
+
+const messageFields = `
+	id
+	content
+	format
+	ephemeral
+	createdAt
+	seq
+	user { id name bot }
+	space { id }
+`
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Bot  bool   `json:"bot"`
+}
+
+type Message struct {
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	Format    string    `json:"format"`
+	Ephemeral bool      `json:"ephemeral"`
+	CreatedAt time.Time `json:"createdAt"`
+	Seq       int64     `json:"seq"`
+	User      User      `json:"user"`
+	Space     struct {
+		ID string `json:"id"`
+	} `json:"space"`
+}
+
+// SpaceID returns the space the message was posted in.
+func (m *Message) SpaceID() string {
+	return m.Space.ID
+}
+
+type Event struct {
+	Type      string   `json:"type"`
+	SpaceID   string   `json:"spaceID"`
+	MessageID *string  `json:"messageID"`
+	Message   *Message `json:"message"`
+	User      *User    `json:"user"`
+	// Emoji is set on reaction events.
+	Emoji string `json:"emoji"`
+	// Cursor identifies the event in the space stream; Run resumes after
+	// it when reconnecting.
+	Cursor string `json:"cursor"`
+}
+
+type MessageHandler func(ctx context.Context, bot *Bot, msg *Message)
+
+type EventHandler func(ctx context.Context, bot *Bot, event *Event)
+
+type Options struct {
+	// Endpoint is the GraphQL URL, e.g. https://chat.example.com/query. The
+	// websocket URL is derived from it.
+	Endpoint string
+	// Token is the bot token returned by createBot.
+	Token      string
+	HTTPClient *http.Client
+	// MinBackoff and MaxBackoff bound the delay between reconnect attempts.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// OnError receives errors that do not stop the bot, such as a dropped
+	// connection or a failing handler call.
+	OnError func(error)
+}
+
+type Bot struct {
+	opts Options
+	http *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	me          *User
+
+	onMessage []MessageHandler
+	onEvent   []EventHandler
+}
+
+func New(opts Options) (*Bot, error) {
+	if opts.Endpoint == "" || opts.Token == "" {
+		return nil, errors.New("botsdk: endpoint and token are required")
+	}
+
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = time.Second
+	}
+
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = 30 * time.Second
+	}
+
+	if opts.OnError == nil {
+		opts.OnError = func(error) {}
+	}
+
+	return &Bot{
+		opts: opts,
+		http: opts.HTTPClient,
+	}, nil
+}
+
+// OnMessage registers a handler for new messages posted by anyone but the
+// bot itself. Register handlers before calling Run.
+func (b *Bot) OnMessage(h MessageHandler) {
+	b.onMessage = append(b.onMessage, h)
+}
+
+// OnEvent registers a handler for every space event.
+func (b *Bot) OnEvent(h EventHandler) {
+	b.onEvent = append(b.onEvent, h)
+}
+
+// Me returns the bot's own user.
+func (b *Bot) Me(ctx context.Context) (*User, error) {
+	b.mu.Lock()
+	me := b.me
+	b.mu.Unlock()
+
+	if me != nil {
+		return me, nil
+	}
+
+	var data struct {
+		User User `json:"user"`
+	}
+
+	err := b.Do(ctx, `query { user { id name } }`, nil, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	data.User.Bot = true
+
+	b.mu.Lock()
+	b.me = &data.User
+	b.mu.Unlock()
+
+	return &data.User, nil
+}
+
+// SendMessage posts content to a space. format is FormatPlain or
+// FormatMarkdown; an empty format means plain text.
+func (b *Bot) SendMessage(ctx context.Context, spaceID, content, format string) (*Message, error) {
+	if format == "" {
+		format = FormatPlain
+	}
+
+	var data struct {
+		SendMessage Message `json:"sendMessage"`
+	}
+
+	query := `mutation ($spaceID: ID!, $content: String!, $format: MessageFormat) {
+		sendMessage(spaceID: $spaceID, content: $content, format: $format) {` + messageFields + `}
+	}`
+
+	err := b.Do(ctx, query, map[string]any{
+		"spaceID": spaceID,
+		"content": content,
+		"format":  format,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.SendMessage, nil
+}
+
+// Reply posts text to the space msg was sent in. Content that starts with
+// a slash is escaped so a reply never runs a command by accident.
+func (b *Bot) Reply(ctx context.Context, msg *Message, text string) (*Message, error) {
+	if strings.HasPrefix(text, "/") {
+		text = "/" + text
+	}
+
+	return b.SendMessage(ctx, msg.SpaceID(), text, FormatPlain)
+}
+
+// RunCommand runs a slash command such as "/topic Release day" in a space.
+// Ephemeral command output comes back as the returned message only.
+func (b *Bot) RunCommand(ctx context.Context, spaceID, command string) (*Message, error) {
+	if !strings.HasPrefix(command, "/") {
+		command = "/" + command
+	}
+
+	return b.SendMessage(ctx, spaceID, command, FormatPlain)
+}
+
+// React adds emoji to a message as the bot. emoji is an emoji or a
+// :shortcode:; reacting twice with the same emoji is a no-op.
+func (b *Bot) React(ctx context.Context, messageID, emoji string) error {
+	query := `mutation ($messageID: ID!, $emoji: String!) {
+		addReaction(messageID: $messageID, emoji: $emoji)
+	}`
+
+	return b.Do(ctx, query, map[string]any{"messageID": messageID, "emoji": emoji}, nil)
+}
+
+// Unreact removes a reaction the bot added with React.
+func (b *Bot) Unreact(ctx context.Context, messageID, emoji string) error {
+	query := `mutation ($messageID: ID!, $emoji: String!) {
+		removeReaction(messageID: $messageID, emoji: $emoji)
+	}`
+
+	return b.Do(ctx, query, map[string]any{"messageID": messageID, "emoji": emoji}, nil)
+}
+
+// Messages returns the newest messages of a space, newest first.
+func (b *Bot) Messages(ctx context.Context, spaceID string) ([]*Message, error) {
+	return b.messages(ctx, map[string]any{"spaceID": spaceID})
+}
+
+// MessagesAfter returns up to limit messages of a space that follow the
+// one numbered seq, newest first.
+func (b *Bot) MessagesAfter(ctx context.Context, spaceID string, seq int64, limit int) ([]*Message, error) {
+	return b.messages(ctx, map[string]any{"spaceID": spaceID, "after": seq, "first": limit})
+}
+
+func (b *Bot) messages(ctx context.Context, variables map[string]any) ([]*Message, error) {
+	var data struct {
+		Messages []*Message `json:"messages"`
+	}
+
+	query := `query ($spaceID: ID!, $first: Int, $after: Int64) {
+		messages(spaceID: $spaceID, first: $first, after: $after) {` + messageFields + `}
+	}`
+
+	err := b.Do(ctx, query, variables, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Messages, nil
+}
+
+type gqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type gqlError struct {
+	Message string `json:"message"`
+}
+
+type gqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []gqlError      `json:"errors"`
+}
+
+func (r *gqlResponse) err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+
+	for _, e := range r.Errors {
+		if e.Message == ErrForbidden.Error() {
+			return ErrForbidden
+		}
+	}
+
+	return fmt.Errorf("botsdk: %s", r.Errors[0].Message)
+}
+
+// Do runs a GraphQL operation as the bot and decodes its data into out.
+// A forbidden error is retried once with a freshly exchanged access token.
+func (b *Bot) Do(ctx context.Context, query string, variables map[string]any, out any) error {
+	token, err := b.token(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	err = b.post(ctx, token, query, variables, out)
+	if !errors.Is(err, ErrForbidden) {
+		return err
+	}
+
+	token, err = b.token(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	return b.post(ctx, token, query, variables, out)
+}
+
+// token returns the current access token, exchanging the bot token for a
+// new one through botAccessToken when there is none yet or refresh is set.
+func (b *Bot) token(ctx context.Context, refresh bool) (string, error) {
+	b.mu.Lock()
+	token := b.accessToken
+	b.mu.Unlock()
+
+	if token != "" && !refresh {
+		return token, nil
+	}
+
+	var data struct {
+		BotAccessToken struct {
+			Token string `json:"token"`
+		} `json:"botAccessToken"`
+	}
+
+	query := `mutation ($token: String!) { botAccessToken(token: $token) { token } }`
+
+	err := b.post(ctx, "", query, map[string]any{"token": b.opts.Token}, &data)
+	if err != nil {
+		return "", fmt.Errorf("botsdk: exchanging bot token: %w", err)
+	}
+
+	b.mu.Lock()
+	b.accessToken = data.BotAccessToken.Token
+	b.mu.Unlock()
+
+	return data.BotAccessToken.Token, nil
+}
+
+func (b *Bot) post(ctx context.Context, token, query string, variables map[string]any, out any) error {
+	body, err := json.Marshal(gqlRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := b.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return err
+	}
+
+	var result gqlResponse
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return fmt.Errorf("botsdk: unexpected response with status %d", resp.StatusCode)
+	}
+
+	if err := result.err(); err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(result.Data, out)
+}
@@ -0,0 +1,726 @@
+package botsdk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"chatspace-server/constant"
+	"chatspace-server/graph/generated"
+	"chatspace-server/graph/model"
+	"chatspace-server/handler/middleware"
+	"chatspace-server/handler/resolver"
+	"chatspace-server/pkg/authctx"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testBotToken = "bot-token"
+	testSecret   = "test-secret"
+	testSpaceID  = "space-1"
+	testTimeout  = 5 * time.Second
+)
+
+var errUnexpected = errors.New("unexpected operation")
+
+var (
+	testBotUser   = &model.User{ID: "bot-1", Name: "Echo", Bot: true}
+	testOtherUser = &model.User{ID: "user-1", Name: "Ada"}
+)
+
+// testAPI serves the ChatSpace GraphQL API in process: the server's schema,
+// resolvers, transports and auth middleware, with fake use cases behind
+// them, so the SDK's queries are checked against the real schema.
+type testAPI struct {
+	srv   *httptest.Server
+	conns *trackingListener
+
+	users    *fakeUsers
+	messages *fakeMessages
+
+	mu      sync.Mutex
+	revoked map[string]bool
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	t.Helper()
+
+	api := &testAPI{
+		users: &fakeUsers{},
+		messages: &fakeMessages{
+			calls:   map[string][]map[string]any{},
+			expired: map[string]bool{},
+			subs:    make(chan *subscription, 4),
+		},
+		revoked: map[string]bool{},
+	}
+
+	rsvl, err := resolver.NewResolver(api.users, nil, api.messages, nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: rsvl}))
+	srv.AddTransport(transport.Websocket{KeepAlivePingInterval: 10 * time.Second})
+	srv.AddTransport(transport.POST{})
+	middleware.ApplyAuthMiddleware(srv, testSecret)
+
+	api.srv = httptest.NewUnstartedServer(api.checkRevoked(srv))
+	api.conns = &trackingListener{Listener: api.srv.Listener}
+	api.srv.Listener = api.conns
+	api.srv.Start()
+	t.Cleanup(api.srv.Close)
+
+	return api
+}
+
+// checkRevoked drops revoked access tokens, which leaves the request
+// unauthenticated like an expired token would.
+func (api *testAPI) checkRevoked(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		revoked := api.revoked[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		api.mu.Unlock()
+
+		if revoked {
+			r.Header.Del("Authorization")
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// expireToken makes the server reject the bot's current access token.
+func (api *testAPI) expireToken() {
+	issued := api.users.tokens()
+
+	api.mu.Lock()
+	api.revoked[issued[len(issued)-1]] = true
+	api.mu.Unlock()
+}
+
+// dropConnections closes every connection the server accepted, websockets
+// included.
+func (api *testAPI) dropConnections() {
+	api.conns.closeAll()
+}
+
+// subscribed waits for the bot's next spaceEvents subscription.
+func (api *testAPI) subscribed(t *testing.T) *subscription {
+	t.Helper()
+
+	return receive(t, api.messages.subs)
+}
+
+type trackingListener struct {
+	net.Listener
+
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	l.conns = append(l.conns, conn)
+	l.mu.Unlock()
+
+	return conn, nil
+}
+
+func (l *trackingListener) closeAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, conn := range l.conns {
+		_ = conn.Close()
+	}
+	l.conns = nil
+}
+
+// fakeUsers issues access tokens to the bot and answers the user query for
+// whoever the request is authenticated as.
+type fakeUsers struct {
+	mu     sync.Mutex
+	issued []string
+}
+
+func (f *fakeUsers) BotAccessToken(ctx context.Context, token string) (*model.AuthResponse, error) {
+	if token != testBotToken {
+		return nil, constant.ErrInvalidBotToken
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": testBotUser.ID,
+		"typ": constant.TOKEN_TYPE_ACCESS,
+		"jti": strconv.Itoa(len(f.issued)),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	f.issued = append(f.issued, signed)
+
+	return &model.AuthResponse{Token: signed}, nil
+}
+
+func (f *fakeUsers) User(ctx context.Context) (*model.User, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.User{ID: userID, Name: testBotUser.Name, Bot: true}, nil
+}
+
+func (f *fakeUsers) tokens() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.issued...)
+}
+
+func (f *fakeUsers) Register(ctx context.Context, request model.RegisterRequest) (*model.AuthResponse, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) Login(ctx context.Context, request model.LoginRequest) (*model.AuthResponse, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) RefreshToken(ctx context.Context, request model.RefreshRequest) (*model.AuthResponse, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) BlockUser(ctx context.Context, userID string) (*model.User, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) UnblockUser(ctx context.Context, userID string) (bool, error) {
+	return false, errUnexpected
+}
+
+func (f *fakeUsers) BlockedUsers(ctx context.Context) ([]*model.User, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) SearchUsers(ctx context.Context, query string, first *int32, after *string) (*model.UserSearchConnection, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) CreateBot(ctx context.Context, spaceID string, name string, avatar *string) (*model.BotRegistration, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeUsers) RotateBotToken(ctx context.Context, spaceID, botID string) (*model.BotRegistration, error) {
+	return nil, errUnexpected
+}
+
+// fakeMessages records the calls the bot makes and hands its spaceEvents
+// subscriptions to the test.
+type fakeMessages struct {
+	mu    sync.Mutex
+	calls map[string][]map[string]any
+	// history answers the messages query with messages newest first.
+	history func(after *int) []*model.Message
+	// expired holds the cursors the event stream no longer retains.
+	expired map[string]bool
+
+	subs chan *subscription
+}
+
+type subscription struct {
+	spaceID string
+	since   string
+	events  chan *model.SpaceEvent
+}
+
+func (f *fakeMessages) record(ctx context.Context, operation string, args map[string]any) error {
+	_, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.calls[operation] = append(f.calls[operation], args)
+	f.mu.Unlock()
+
+	return nil
+}
+
+// called returns the arguments of each call of the named operation.
+func (f *fakeMessages) called(operation string) []map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[operation]
+}
+
+func (f *fakeMessages) setHistory(history func(after *int) []*model.Message) {
+	f.mu.Lock()
+	f.history = history
+	f.mu.Unlock()
+}
+
+func (f *fakeMessages) expire(cursor string) {
+	f.mu.Lock()
+	f.expired[cursor] = true
+	f.mu.Unlock()
+}
+
+func (f *fakeMessages) Messages(ctx context.Context, spaceID string, first *int32, before, after *int) ([]*model.Message, error) {
+	err := f.record(ctx, "messages", map[string]any{"spaceID": spaceID, "after": after})
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	history := f.history
+	f.mu.Unlock()
+
+	if history == nil {
+		return []*model.Message{}, nil
+	}
+
+	return history(after), nil
+}
+
+func (f *fakeMessages) SendMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32, clientMessageID *string) (*model.Message, error) {
+	err := f.record(ctx, "sendMessage", map[string]any{"spaceID": spaceID, "content": content})
+	if err != nil {
+		return nil, err
+	}
+
+	return newMessage("sent", testBotUser, content, 0), nil
+}
+
+func (f *fakeMessages) AddReaction(ctx context.Context, messageID, emoji string) (bool, error) {
+	return f.react(ctx, "addReaction", messageID, emoji)
+}
+
+func (f *fakeMessages) RemoveReaction(ctx context.Context, messageID, emoji string) (bool, error) {
+	return f.react(ctx, "removeReaction", messageID, emoji)
+}
+
+func (f *fakeMessages) react(ctx context.Context, operation, messageID, emoji string) (bool, error) {
+	err := f.record(ctx, operation, map[string]any{"messageID": messageID, "emoji": emoji})
+	if err != nil {
+		return false, err
+	}
+
+	if emoji == "" {
+		return false, constant.ErrMissingField("emoji")
+	}
+
+	return true, nil
+}
+
+func (f *fakeMessages) SpaceEvents(ctx context.Context, spaceID string, since *string) (<-chan *model.SpaceEvent, error) {
+	_, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &subscription{spaceID: spaceID, events: make(chan *model.SpaceEvent, 16)}
+	if since != nil {
+		sub.since = *since
+	}
+	f.subs <- sub
+
+	f.mu.Lock()
+	expired := f.expired[sub.since]
+	f.mu.Unlock()
+
+	if expired {
+		return nil, constant.ErrCursorExpired
+	}
+
+	return sub.events, nil
+}
+
+func (f *fakeMessages) SearchMessages(ctx context.Context, query string, filter *model.MessageSearchFilter, first *int32, after *string) (*model.MessageSearchConnection, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeMessages) MessageSent(ctx context.Context, spaceID string, since *string) (<-chan *model.Message, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeMessages) PinMessage(ctx context.Context, messageID string) (*model.Message, error) {
+	return nil, errUnexpected
+}
+
+func (f *fakeMessages) UnpinMessage(ctx context.Context, messageID string) (bool, error) {
+	return false, errUnexpected
+}
+
+func (f *fakeMessages) UserEvents(ctx context.Context) (<-chan *model.UserEvent, error) {
+	return nil, errUnexpected
+}
+
+func (s *subscription) send(t *testing.T, event *model.SpaceEvent) {
+	t.Helper()
+
+	select {
+	case s.events <- event:
+	case <-time.After(testTimeout):
+		t.Fatal("subscription is not being read")
+	}
+}
+
+func newMessage(id string, user *model.User, content string, seq int) *model.Message {
+	msg := &model.Message{
+		ID:        id,
+		Content:   content,
+		Format:    model.MessageFormatPlain,
+		User:      user,
+		Space:     &model.Space{ID: testSpaceID},
+		CreatedAt: time.Now(),
+	}
+	if seq > 0 {
+		msg.Seq = &seq
+	}
+
+	return msg
+}
+
+func messageEvent(msg *model.Message, cursor string) *model.SpaceEvent {
+	return &model.SpaceEvent{
+		Type:    model.SpaceEventTypeMessageCreated,
+		SpaceID: testSpaceID,
+		Message: msg,
+		Cursor:  &cursor,
+	}
+}
+
+func newTestBot(t *testing.T, api *testAPI, onError func(error)) *Bot {
+	t.Helper()
+
+	bot, err := New(Options{
+		Endpoint:   api.srv.URL + "/query",
+		Token:      testBotToken,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		OnError:    onError,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bot
+}
+
+// runBot starts bot.Run and returns a function that stops it and checks
+// that it returned because of the cancellation.
+func runBot(t *testing.T, bot *Bot) func() {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- bot.Run(ctx, testSpaceID)
+	}()
+
+	return func() {
+		t.Helper()
+
+		cancel()
+		select {
+		case err := <-done:
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("Run() error = %v, want %v", err, context.Canceled)
+			}
+		case <-time.After(testTimeout):
+			t.Fatal("Run did not return after cancellation")
+		}
+	}
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for the bot")
+		var zero T
+		return zero
+	}
+}
+
+func TestRunDispatchesEvents(t *testing.T) {
+	api := newTestAPI(t)
+	bot := newTestBot(t, api, nil)
+
+	messages := make(chan *Message, 8)
+	bot.OnMessage(func(ctx context.Context, bot *Bot, msg *Message) {
+		err := bot.React(ctx, msg.ID, ":thumbsup:")
+		if err != nil {
+			t.Errorf("React() error = %v", err)
+		}
+
+		_, err = bot.Reply(ctx, msg, "/echo "+msg.Content)
+		if err != nil {
+			t.Errorf("Reply() error = %v", err)
+		}
+
+		messages <- msg
+	})
+
+	events := make(chan *Event, 8)
+	bot.OnEvent(func(ctx context.Context, bot *Bot, event *Event) {
+		events <- event
+	})
+
+	stop := runBot(t, bot)
+
+	sub := api.subscribed(t)
+	if sub.spaceID != testSpaceID || sub.since != "" {
+		t.Fatalf("subscribed to space %q since %q, want space %q without since", sub.spaceID, sub.since, testSpaceID)
+	}
+
+	own := newMessage("m1", testBotUser, "from the bot", 1)
+	hello := newMessage("m2", testOtherUser, "hello", 2)
+	ephemeral := newMessage("m3", testOtherUser, "only for you", 0)
+	ephemeral.Ephemeral = true
+	messageID := hello.ID
+	emoji := "🎉"
+	cursor := "c4"
+
+	sub.send(t, messageEvent(own, "c1"))
+	sub.send(t, messageEvent(hello, "c2"))
+	sub.send(t, messageEvent(hello, "c2"))
+	sub.send(t, messageEvent(ephemeral, "c3"))
+	sub.send(t, &model.SpaceEvent{
+		Type:      model.SpaceEventTypeReactionAdded,
+		SpaceID:   testSpaceID,
+		MessageID: &messageID,
+		User:      testOtherUser,
+		Emoji:     &emoji,
+		Cursor:    &cursor,
+	})
+
+	if got := receive(t, messages); got.ID != hello.ID {
+		t.Fatalf("OnMessage got %q, want %q", got.ID, hello.ID)
+	}
+
+	var got []*Event
+	for range 4 {
+		got = append(got, receive(t, events))
+	}
+
+	var types []string
+	for _, event := range got {
+		types = append(types, event.Type)
+	}
+
+	want := []string{EventMessageCreated, EventMessageCreated, EventMessageCreated, EventReactionAdded}
+	if strings.Join(types, ",") != strings.Join(want, ",") {
+		t.Fatalf("OnEvent got %v, want %v", types, want)
+	}
+
+	reaction := got[3]
+	if reaction.MessageID == nil || *reaction.MessageID != hello.ID || reaction.Emoji != emoji || reaction.User == nil || reaction.User.ID != testOtherUser.ID {
+		t.Fatalf("reaction event = %+v, want %s by %q on %q", reaction, emoji, testOtherUser.ID, hello.ID)
+	}
+
+	stop()
+
+	select {
+	case msg := <-messages:
+		t.Fatalf("OnMessage got unexpected message %q", msg.ID)
+	case event := <-events:
+		t.Fatalf("OnEvent got unexpected %s event", event.Type)
+	default:
+	}
+
+	reactions := api.messages.called("addReaction")
+	if len(reactions) != 1 || reactions[0]["messageID"] != hello.ID || reactions[0]["emoji"] != ":thumbsup:" {
+		t.Fatalf("addReaction calls = %v, want one :thumbsup: on %q", reactions, hello.ID)
+	}
+
+	sent := api.messages.called("sendMessage")
+	if len(sent) != 1 || sent[0]["content"] != "//echo hello" || sent[0]["spaceID"] != testSpaceID {
+		t.Fatalf("sendMessage calls = %v, want one escaped reply", sent)
+	}
+}
+
+func TestRunReconnectsAndReplays(t *testing.T) {
+	api := newTestAPI(t)
+
+	var mu sync.Mutex
+	var errs []error
+	bot := newTestBot(t, api, func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	})
+
+	messages := make(chan *Message, 8)
+	bot.OnMessage(func(ctx context.Context, bot *Bot, msg *Message) {
+		messages <- msg
+	})
+
+	one := newMessage("m1", testOtherUser, "one", 1)
+	two := newMessage("m2", testOtherUser, "two", 2)
+	three := newMessage("m3", testOtherUser, "three", 3)
+	four := newMessage("m4", testOtherUser, "four", 4)
+
+	stop := runBot(t, bot)
+
+	sub := api.subscribed(t)
+	sub.send(t, messageEvent(one, "c1"))
+
+	if got := receive(t, messages); got.ID != one.ID {
+		t.Fatalf("OnMessage got %q, want %q", got.ID, one.ID)
+	}
+
+	// After reconnecting the bot resumes from the last cursor. The server
+	// no longer retains it, so the bot must fall back to the history.
+	api.messages.expire("c1")
+	api.messages.setHistory(func(after *int) []*model.Message {
+		if after == nil || *after != *one.Seq {
+			t.Errorf("messages after %v, want after %d", after, *one.Seq)
+			return nil
+		}
+
+		return []*model.Message{three, two}
+	})
+
+	api.dropConnections()
+
+	sub = api.subscribed(t)
+	if sub.since != "c1" {
+		t.Fatalf("resubscribed with since %q, want %q", sub.since, "c1")
+	}
+
+	sub = api.subscribed(t)
+	if sub.since != "" {
+		t.Fatalf("fallback subscription has since %q, want none", sub.since)
+	}
+
+	sub.send(t, messageEvent(three, "c3"))
+	sub.send(t, messageEvent(four, "c4"))
+
+	var got []string
+	for range 3 {
+		got = append(got, receive(t, messages).Content)
+	}
+
+	if want := "two,three,four"; strings.Join(got, ",") != want {
+		t.Fatalf("OnMessage got %v, want %s", got, want)
+	}
+
+	stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) == 0 || !strings.Contains(errs[0].Error(), "connection lost") {
+		t.Fatalf("OnError got %v, want a connection lost error", errs)
+	}
+}
+
+func TestDoRefreshesForbiddenToken(t *testing.T) {
+	api := newTestAPI(t)
+	bot := newTestBot(t, api, nil)
+	ctx := context.Background()
+
+	err := bot.React(ctx, "m1", "👍")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api.expireToken()
+
+	err = bot.Unreact(ctx, "m1", "👍")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(api.users.tokens()); n != 2 {
+		t.Fatalf("token exchanged %d times, want 2", n)
+	}
+
+	if n := len(api.messages.called("removeReaction")); n != 1 {
+		t.Fatalf("removeReaction called %d times, want 1", n)
+	}
+}
+
+func TestBadBotToken(t *testing.T) {
+	api := newTestAPI(t)
+
+	bot, err := New(Options{Endpoint: api.srv.URL + "/query", Token: "not-the-token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = bot.Me(context.Background())
+	if err == nil || !strings.Contains(err.Error(), constant.ErrInvalidBotToken.Error()) {
+		t.Fatalf("Me() error = %v, want %v", err, constant.ErrInvalidBotToken)
+	}
+}
+
+func TestReactError(t *testing.T) {
+	api := newTestAPI(t)
+	bot := newTestBot(t, api, nil)
+
+	err := bot.React(context.Background(), "m1", "")
+	if err == nil || !strings.Contains(err.Error(), "emoji is required") {
+		t.Fatalf("React() error = %v, want the server's error", err)
+	}
+}
+
+func TestRunRequiresSpaces(t *testing.T) {
+	api := newTestAPI(t)
+	bot := newTestBot(t, api, nil)
+
+	err := bot.Run(context.Background())
+	if err == nil {
+		t.Fatal("Run() error = nil, want an error without spaces")
+	}
+}
+
+func TestWebsocketURL(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{"http://localhost:8080/query", "ws://localhost:8080/query", false},
+		{"https://chat.example.com/query", "wss://chat.example.com/query", false},
+		{"wss://chat.example.com/query", "wss://chat.example.com/query", false},
+		{"ftp://chat.example.com/query", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			got, err := websocketURL(tt.endpoint)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("websocketURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Fatalf("websocketURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package botsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	protocol = "graphql-transport-ws"
+
+	// seenLimit bounds the message IDs remembered per space for
+	// de-duplicating events replayed after a reconnect.
+	seenLimit = 512
+
+	// cursorExpired prefixes the error the server returns when a resume
+	// cursor is older than the retained stream.
+	cursorExpired = "cursor expired"
+
+	// replayPageSize is the number of messages fetched per request when
+	// replaying history.
+	replayPageSize = 100
+)
+
+const spaceEventsQuery = `subscription ($spaceID: ID!, $since: Cursor) {
+	spaceEvents(spaceID: $spaceID, since: $since) {
+		type
+		spaceID
+		cursor
+		messageID
+		emoji
+		message {` + messageFields + `}
+		user { id name bot }
+	}
+}`
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// cursor tracks the position in a space's event stream, the seq of the
+// newest message delivered and the IDs recently delivered, so a replay
+// after reconnecting skips what the handlers already saw.
+type cursor struct {
+	stream string
+	seq    int64
+	seen   map[string]struct{}
+	ids    []string
+}
+
+func (c *cursor) mark(msg *Message) bool {
+	if _, ok := c.seen[msg.ID]; ok {
+		return false
+	}
+
+	c.seen[msg.ID] = struct{}{}
+	c.ids = append(c.ids, msg.ID)
+	if len(c.ids) > seenLimit {
+		delete(c.seen, c.ids[0])
+		c.ids = c.ids[1:]
+	}
+
+	if msg.Seq > c.seq {
+		c.seq = msg.Seq
+	}
+
+	return true
+}
+
+// Run subscribes to the events of the given spaces and dispatches them to
+// the registered handlers until ctx is cancelled. Dropped connections are
+// re-established with exponential backoff and resume after the last event
+// received; if the server no longer retains that far back, messages posted
+// while the bot was disconnected are replayed from the message history.
+func (b *Bot) Run(ctx context.Context, spaceIDs ...string) error {
+	if len(spaceIDs) == 0 {
+		return errors.New("botsdk: no spaces to subscribe to")
+	}
+
+	me, err := b.Me(ctx)
+	if err != nil {
+		return err
+	}
+
+	cursors := map[string]*cursor{}
+	for _, id := range spaceIDs {
+		latest, err := b.Messages(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		c := &cursor{seen: map[string]struct{}{}}
+		if len(latest) > 0 {
+			c.seq = latest[0].Seq
+		}
+		cursors[id] = c
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := b.session(ctx, me, spaceIDs, cursors, func() {
+			attempt = 0
+		})
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		b.opts.OnError(fmt.Errorf("botsdk: connection lost: %w", err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(b.backoff(attempt)):
+		}
+	}
+}
+
+// session holds one websocket connection open until it fails. ready is
+// called once every subscription has been sent. Handlers run on the
+// connection goroutine, so cursors need no lock.
+func (b *Bot) session(ctx context.Context, me *User, spaceIDs []string, cursors map[string]*cursor, ready func()) error {
+	token, err := b.token(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := websocketURL(b.opts.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	dialer := websocket.Dialer{
+		Subprotocols:     []string{protocol},
+		HandshakeTimeout: 10 * time.Second,
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+token)
+
+	conn, _, err := dialer.DialContext(ctx, endpoint, header)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	err = conn.WriteJSON(wsMessage{Type: "connection_init"})
+	if err != nil {
+		return err
+	}
+
+	var ack wsMessage
+	err = conn.ReadJSON(&ack)
+	if err != nil {
+		return err
+	}
+
+	if ack.Type != "connection_ack" {
+		return fmt.Errorf("unexpected %q before connection_ack", ack.Type)
+	}
+
+	subscribe := func(id, spaceID, since string) error {
+		variables := map[string]any{"spaceID": spaceID}
+		if since != "" {
+			variables["since"] = since
+		}
+
+		payload, err := json.Marshal(gqlRequest{Query: spaceEventsQuery, Variables: variables})
+		if err != nil {
+			return err
+		}
+
+		return conn.WriteJSON(wsMessage{ID: id, Type: "subscribe", Payload: payload})
+	}
+
+	// subs maps live subscription IDs to their space. A subscription that
+	// fails to resume is replaced under a new ID, since the server still
+	// completes the old one.
+	subs := map[string]string{}
+	next := 0
+	for _, spaceID := range spaceIDs {
+		id := strconv.Itoa(next)
+		next++
+		subs[id] = spaceID
+
+		err = subscribe(id, spaceID, cursors[spaceID].stream)
+		if err != nil {
+			return err
+		}
+	}
+
+	ready()
+
+	for {
+		var msg wsMessage
+		err := conn.ReadJSON(&msg)
+		if err != nil {
+			return err
+		}
+
+		switch msg.Type {
+		case "ping":
+			err = conn.WriteJSON(wsMessage{Type: "pong"})
+			if err != nil {
+				return err
+			}
+		case "next":
+			var result struct {
+				Data struct {
+					SpaceEvents *Event `json:"spaceEvents"`
+				} `json:"data"`
+				Errors []gqlError `json:"errors"`
+			}
+
+			err = json.Unmarshal(msg.Payload, &result)
+			if err != nil {
+				b.opts.OnError(err)
+				continue
+			}
+
+			spaceID, ok := subs[msg.ID]
+			if ok && len(result.Errors) > 0 && strings.HasPrefix(result.Errors[0].Message, cursorExpired) {
+				delete(subs, msg.ID)
+				cursors[spaceID].stream = ""
+
+				id := strconv.Itoa(next)
+				next++
+				subs[id] = spaceID
+
+				err = subscribe(id, spaceID, "")
+				if err != nil {
+					return err
+				}
+
+				b.replay(ctx, me, cursors, spaceID)
+				continue
+			}
+
+			if len(result.Errors) > 0 {
+				return fmt.Errorf("subscription %s: %s", msg.ID, result.Errors[0].Message)
+			}
+
+			if result.Data.SpaceEvents != nil {
+				b.dispatch(ctx, me, cursors, result.Data.SpaceEvents)
+			}
+		case "error":
+			var errs []gqlError
+			_ = json.Unmarshal(msg.Payload, &errs)
+
+			if len(errs) > 0 {
+				return fmt.Errorf("subscription %s: %s", msg.ID, errs[0].Message)
+			}
+
+			return fmt.Errorf("subscription %s failed", msg.ID)
+		case "complete":
+			if _, ok := subs[msg.ID]; !ok {
+				continue
+			}
+
+			return fmt.Errorf("subscription %s completed by server", msg.ID)
+		}
+	}
+}
+
+// replay delivers messages of a space that follow the last one the bot
+// saw, oldest first. It is the fallback for when the event stream can no
+// longer be resumed, so other event types missed meanwhile are lost.
+func (b *Bot) replay(ctx context.Context, me *User, cursors map[string]*cursor, spaceID string) {
+	after := cursors[spaceID].seq
+	for {
+		messages, err := b.MessagesAfter(ctx, spaceID, after, replayPageSize)
+		if err != nil {
+			b.opts.OnError(fmt.Errorf("botsdk: replaying space %s: %w", spaceID, err))
+			return
+		}
+
+		for i := len(messages) - 1; i >= 0; i-- {
+			b.dispatch(ctx, me, cursors, &Event{
+				Type:    EventMessageCreated,
+				SpaceID: spaceID,
+				Message: messages[i],
+			})
+		}
+
+		if len(messages) < replayPageSize {
+			return
+		}
+
+		after = messages[0].Seq
+	}
+}
+
+func (b *Bot) dispatch(ctx context.Context, me *User, cursors map[string]*cursor, event *Event) {
+	c := cursors[event.SpaceID]
+	if c == nil {
+		return
+	}
+
+	if event.Cursor != "" {
+		c.stream = event.Cursor
+	}
+
+	msg := event.Message
+	if event.Type == EventMessageCreated && msg != nil && !c.mark(msg) {
+		return
+	}
+
+	for _, h := range b.onEvent {
+		h(ctx, b, event)
+	}
+
+	if event.Type != EventMessageCreated || msg == nil || msg.Ephemeral || msg.User.ID == me.ID {
+		return
+	}
+
+	for _, h := range b.onMessage {
+		h(ctx, b, msg)
+	}
+}
+
+func (b *Bot) backoff(attempt int) time.Duration {
+	d := b.opts.MinBackoff << min(attempt, 16)
+	if d <= 0 || d > b.opts.MaxBackoff {
+		d = b.opts.MaxBackoff
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+func websocketURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("botsdk: unsupported endpoint scheme %q", u.Scheme)
+	}
+
+	return u.String(), nil
+}
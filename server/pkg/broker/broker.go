@@ -0,0 +1,111 @@
+// Package broker fans payloads out to subscribers of named channels.
+// Payloads are appended to a per-channel log that keeps recent entries, so
+// a subscriber can resume after the ID of the last entry it saw. The
+// memory log serves tests and single-node deployments; the Redis Streams
+// and Postgres logs deliver across replicas.
+package broker
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrClosed        = errors.New("broker is closed")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrCursorExpired means entries after the cursor may already have
+	// been trimmed from the log, so resuming could silently skip some.
+	ErrCursorExpired = errors.New("cursor expired")
+)
+
+// Message is one log entry. IDs increase within a channel.
+type Message struct {
+	ID      string
+	Channel string
+	Payload []byte
+}
+
+type Broker interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+	// PublishOnce publishes payload unless an entry with the same dedupID
+	// is still retained in the channel, so a publisher can safely retry
+	// after not knowing whether an earlier attempt went through.
+	PublishOnce(ctx context.Context, channel, dedupID string, payload []byte) error
+	// Subscribe returns once the subscription is active. With an empty
+	// since it delivers entries published from now on; otherwise it first
+	// replays every retained entry after since. The channel is closed
+	// after the close func is called, ctx is done or the broker shuts
+	// down.
+	Subscribe(ctx context.Context, channel, since string) (<-chan Message, func() error, error)
+	Close() error
+}
+
+// Log stores the entries of every channel.
+type Log interface {
+	// Append adds an entry and returns its ID. With a non-empty dedupID
+	// that matches a retained entry of the channel it appends nothing and
+	// returns the ID of that entry instead.
+	Append(ctx context.Context, channel, dedupID string, payload []byte) (string, error)
+	// Read returns up to count entries after the given ID, oldest first;
+	// an empty after reads from the start. With a positive block it waits
+	// up to that long for entries when there are none yet.
+	Read(ctx context.Context, channel, after string, count int, block time.Duration) ([]Message, error)
+	// Bounds returns the IDs of the oldest and newest retained entries,
+	// both empty when the channel has none.
+	Bounds(ctx context.Context, channel string) (string, string, error)
+	Close() error
+}
+
+// watcher is implemented by logs that must prepare a channel before
+// blocking reads on it can wake up promptly.
+type watcher interface {
+	Watch(channel string) error
+	Unwatch(channel string) error
+}
+
+// compareID orders entry IDs, which are either a number or two numbers
+// joined by a dash as in Redis stream IDs. The empty ID sorts first.
+func compareID(a, b string) int {
+	aHi, aLo, _ := parseID(a)
+	bHi, bLo, _ := parseID(b)
+
+	if aHi != bHi {
+		return cmp.Compare(aHi, bHi)
+	}
+
+	return cmp.Compare(aLo, bLo)
+}
+
+// ValidID reports whether id can be used as a cursor.
+func ValidID(id string) bool {
+	_, _, ok := parseID(id)
+	return ok && id != ""
+}
+
+func parseID(id string) (uint64, uint64, bool) {
+	if id == "" {
+		return 0, 0, true
+	}
+
+	hiStr, loStr, dashed := strings.Cut(id, "-")
+
+	hi, err := strconv.ParseUint(hiStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	if !dashed {
+		return hi, 0, true
+	}
+
+	lo, err := strconv.ParseUint(loStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return hi, lo, true
+}
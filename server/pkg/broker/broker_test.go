@@ -0,0 +1,50 @@
+package broker
+
+import "testing"
+
+func TestCompareID(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "1", -1},
+		{"2", "10", -1},
+		{"10", "2", 1},
+		{"5", "5", 0},
+		{"1700000000000-0", "1700000000000-1", -1},
+		{"1700000000001-0", "1700000000000-9", 1},
+		{"7-0", "7", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := compareID(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareID(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"", false},
+		{"1", true},
+		{"1700000000000-3", true},
+		{"-1", false},
+		{"1-", false},
+		{"abc", false},
+		{"1-2-3", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := ValidID(tt.id); got != tt.want {
+				t.Errorf("ValidID(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package broker
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// ringSize is how many recent entries of a channel are kept in process
+	// for subscribers that keep up.
+	ringSize = 256
+
+	// subscriberBuffer bounds the entries queued for one subscriber. A
+	// subscriber that stops reading holds back only its own delivery.
+	subscriberBuffer = 16
+
+	readBatch = 100
+	readBlock = 5 * time.Second
+	retryWait = time.Second
+)
+
+// Hub implements Broker on top of a Log. Each process runs one reader per
+// subscribed channel that tails the log into a ring of recent entries.
+// Subscribers are served from the ring; one that falls behind it catches
+// up from the log, so slow subscribers apply backpressure to themselves
+// instead of losing entries.
+type Hub struct {
+	log    Log
+	zlog   zerolog.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	channels map[string]*channelState
+	closed   bool
+}
+
+type channelState struct {
+	refs   int
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	// base is the newest ID not held in entries; the ring is complete for
+	// every ID after it.
+	base    string
+	entries []Message
+	wake    chan struct{}
+}
+
+func NewHub(log Log, zlog zerolog.Logger) *Hub {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Hub{
+		log:      log,
+		zlog:     zlog,
+		ctx:      ctx,
+		cancel:   cancel,
+		channels: map[string]*channelState{},
+	}
+}
+
+func (h *Hub) Publish(ctx context.Context, channel string, payload []byte) error {
+	_, err := h.log.Append(ctx, channel, "", payload)
+	return err
+}
+
+func (h *Hub) PublishOnce(ctx context.Context, channel, dedupID string, payload []byte) error {
+	_, err := h.log.Append(ctx, channel, dedupID, payload)
+	return err
+}
+
+func (h *Hub) Subscribe(ctx context.Context, channel, since string) (<-chan Message, func() error, error) {
+	if since != "" {
+		if !ValidID(since) {
+			return nil, nil, ErrInvalidCursor
+		}
+
+		oldest, _, err := h.log.Bounds(ctx, channel)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if oldest != "" && compareID(since, oldest) < 0 {
+			return nil, nil, ErrCursorExpired
+		}
+	}
+
+	st, err := h.acquire(ctx, channel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start := since
+	if start == "" {
+		start = st.head()
+	}
+
+	out := make(chan Message, subscriberBuffer)
+	done := make(chan struct{})
+	var closeOnce, releaseOnce sync.Once
+
+	go func() {
+		defer func() {
+			releaseOnce.Do(func() {
+				h.release(channel, st)
+			})
+			close(out)
+		}()
+
+		h.serve(ctx, channel, st, start, out, done)
+	}()
+
+	return out, func() error {
+		closeOnce.Do(func() {
+			close(done)
+		})
+		return nil
+	}, nil
+}
+
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	h.closed = true
+	h.mu.Unlock()
+
+	h.cancel()
+
+	return h.log.Close()
+}
+
+// serve delivers entries after pos to out until the subscriber goes away.
+func (h *Hub) serve(ctx context.Context, channel string, st *channelState, pos string, out chan<- Message, done <-chan struct{}) {
+	for {
+		entries, wake, covered := st.after(pos)
+
+		if !covered {
+			// An empty pos means the channel had no entries when the
+			// subscriber joined, so its first entry is the oldest wanted.
+			oldest, _, err := h.log.Bounds(ctx, channel)
+			if err == nil && pos != "" && oldest != "" && compareID(pos, oldest) < 0 {
+				err = ErrCursorExpired
+			}
+
+			if err == nil {
+				entries, err = h.log.Read(ctx, channel, pos, readBatch, 0)
+			}
+
+			if err != nil {
+				if ctx.Err() == nil {
+					h.zlog.Warn().Err(err).Str("channel", channel).Msg("Subscriber fell behind the broker log")
+				}
+				return
+			}
+		}
+
+		if len(entries) == 0 {
+			select {
+			case <-wake:
+				continue
+			case <-ctx.Done():
+				return
+			case <-h.ctx.Done():
+				return
+			case <-done:
+				return
+			}
+		}
+
+		for _, entry := range entries {
+			select {
+			case out <- entry:
+				pos = entry.ID
+			case <-ctx.Done():
+				return
+			case <-h.ctx.Done():
+				return
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+// acquire returns the state of channel, starting its reader for the
+// first subscriber.
+func (h *Hub) acquire(ctx context.Context, channel string) (*channelState, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrClosed
+	}
+
+	if st, ok := h.channels[channel]; ok {
+		st.refs++
+		return st, nil
+	}
+
+	if w, ok := h.log.(watcher); ok {
+		err := w.Watch(channel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, newest, err := h.log.Bounds(ctx, channel)
+	if err != nil {
+		if w, ok := h.log.(watcher); ok {
+			_ = w.Unwatch(channel)
+		}
+		return nil, err
+	}
+
+	readCtx, cancel := context.WithCancel(h.ctx)
+	st := &channelState{
+		refs:   1,
+		cancel: cancel,
+		base:   newest,
+		wake:   make(chan struct{}),
+	}
+	h.channels[channel] = st
+
+	go h.tail(readCtx, channel, st)
+
+	return st, nil
+}
+
+func (h *Hub) release(channel string, st *channelState) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st.refs--
+	if st.refs > 0 {
+		return
+	}
+
+	st.cancel()
+	delete(h.channels, channel)
+
+	if w, ok := h.log.(watcher); ok {
+		_ = w.Unwatch(channel)
+	}
+}
+
+// tail copies new log entries of channel into its ring.
+func (h *Hub) tail(ctx context.Context, channel string, st *channelState) {
+	last := st.head()
+
+	for ctx.Err() == nil {
+		entries, err := h.log.Read(ctx, channel, last, readBatch, readBlock)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			h.zlog.Error().Err(err).Str("channel", channel).Msg("Failed read broker log")
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryWait):
+			}
+			continue
+		}
+
+		if len(entries) > 0 {
+			st.push(entries)
+			last = entries[len(entries)-1].ID
+		}
+	}
+}
+
+func (st *channelState) head() string {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if len(st.entries) > 0 {
+		return st.entries[len(st.entries)-1].ID
+	}
+
+	return st.base
+}
+
+func (st *channelState) push(entries []Message) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.entries = append(st.entries, entries...)
+	if over := len(st.entries) - ringSize; over > 0 {
+		st.base = st.entries[over-1].ID
+		st.entries = append([]Message(nil), st.entries[over:]...)
+	}
+
+	close(st.wake)
+	st.wake = make(chan struct{})
+}
+
+// after returns the ring entries after pos and a channel closed on the
+// next push. covered is false when pos is older than the ring.
+func (st *channelState) after(pos string) ([]Message, <-chan struct{}, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if compareID(pos, st.base) < 0 {
+		return nil, st.wake, false
+	}
+
+	i := sort.Search(len(st.entries), func(i int) bool {
+		return compareID(st.entries[i].ID, pos) > 0
+	})
+
+	return append([]Message(nil), st.entries[i:]...), st.wake, true
+}
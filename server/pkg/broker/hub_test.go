@@ -0,0 +1,205 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func newTestHub(t *testing.T, maxLen int) *Hub {
+	t.Helper()
+
+	hub := NewHub(NewMemoryLog(maxLen), zerolog.Nop())
+	t.Cleanup(func() {
+		hub.Close()
+	})
+
+	return hub
+}
+
+func receive(t *testing.T, ch <-chan Message) Message {
+	t.Helper()
+
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			t.Fatal("subscription closed")
+		}
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a message")
+		return Message{}
+	}
+}
+
+func TestHubDeliversNewEntries(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t, 100)
+
+	err := hub.Publish(ctx, "ch", []byte("before"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch, closeSub, err := hub.Subscribe(ctx, "ch", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeSub()
+
+	for _, p := range []string{"a", "b"} {
+		err = hub.Publish(ctx, "ch", []byte(p))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, want := range []string{"a", "b"} {
+		if got := string(receive(t, ch).Payload); got != want {
+			t.Fatalf("received %q, want %q", got, want)
+		}
+	}
+}
+
+func TestHubResumesAfterCursor(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t, 100)
+
+	for i := 1; i <= 3; i++ {
+		err := hub.Publish(ctx, "ch", []byte(fmt.Sprint(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ch, closeSub, err := hub.Subscribe(ctx, "ch", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeSub()
+
+	for _, want := range []string{"2", "3"} {
+		if got := string(receive(t, ch).Payload); got != want {
+			t.Fatalf("received %q, want %q", got, want)
+		}
+	}
+}
+
+func TestHubSubscribeErrors(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t, 2)
+
+	for i := 0; i < 5; i++ {
+		err := hub.Publish(ctx, "ch", []byte("x"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		since string
+		want  error
+	}{
+		{"invalid", "not-a-cursor", ErrInvalidCursor},
+		{"trimmed", "1", ErrCursorExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := hub.Subscribe(ctx, "ch", tt.since)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Subscribe() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHubPublishOnce(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t, 100)
+
+	ch, closeSub, err := hub.Subscribe(ctx, "ch", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeSub()
+
+	for _, p := range []string{"first", "retry"} {
+		err = hub.PublishOnce(ctx, "ch", "event-1", []byte(p))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = hub.Publish(ctx, "ch", []byte("next"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"first", "next"} {
+		if got := string(receive(t, ch).Payload); got != want {
+			t.Fatalf("received %q, want %q", got, want)
+		}
+	}
+}
+
+func TestHubSlowSubscriberCatchesUp(t *testing.T) {
+	ctx := context.Background()
+	hub := newTestHub(t, 10*ringSize)
+
+	ch, closeSub, err := hub.Subscribe(ctx, "ch", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeSub()
+
+	// More entries than the ring and the subscriber buffer hold, published
+	// before the subscriber reads any of them.
+	total := 2 * ringSize
+	for i := 0; i < total; i++ {
+		err = hub.Publish(ctx, "ch", []byte(fmt.Sprint(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < total; i++ {
+		if got, want := string(receive(t, ch).Payload), fmt.Sprint(i); got != want {
+			t.Fatalf("received %q, want %q", got, want)
+		}
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	ctx := context.Background()
+	hub := NewHub(NewMemoryLog(100), zerolog.Nop())
+
+	ch, _, err := hub.Subscribe(ctx, "ch", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = hub.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("received a message after Close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription stayed open after Close")
+	}
+
+	_, _, err = hub.Subscribe(ctx, "ch", "")
+	if !errors.Is(err, ErrClosed) {
+		t.Fatalf("Subscribe() after Close error = %v, want %v", err, ErrClosed)
+	}
+}
@@ -0,0 +1,213 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testLog checks the behavior every Log implementation shares. Channel
+// names are unique per run, so logs backed by a shared server can be
+// tested without cleaning up.
+func testLog(t *testing.T, log Log) {
+	ctx := context.Background()
+	prefix := fmt.Sprintf("test-%d-", time.Now().UnixNano())
+
+	appendAll := func(t *testing.T, channel string, payloads ...string) []string {
+		t.Helper()
+
+		var ids []string
+		for _, p := range payloads {
+			id, err := log.Append(ctx, channel, "", []byte(p))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, id)
+		}
+
+		return ids
+	}
+
+	payloads := func(entries []Message) string {
+		var out []string
+		for _, e := range entries {
+			out = append(out, string(e.Payload))
+		}
+
+		return strings.Join(out, ",")
+	}
+
+	t.Run("read", func(t *testing.T) {
+		channel := prefix + "read"
+		ids := appendAll(t, channel, "a", "b", "c", "d")
+
+		for i := 1; i < len(ids); i++ {
+			if compareID(ids[i-1], ids[i]) >= 0 {
+				t.Fatalf("IDs %v do not increase", ids)
+			}
+		}
+
+		tests := []struct {
+			name  string
+			after string
+			count int
+			want  string
+		}{
+			{"from start", "", 10, "a,b,c,d"},
+			{"after first", ids[0], 10, "b,c,d"},
+			{"count", "", 2, "a,b"},
+			{"after last", ids[3], 10, ""},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				entries, err := log.Read(ctx, channel, tt.after, tt.count, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got := payloads(entries); got != tt.want {
+					t.Fatalf("Read() = %q, want %q", got, tt.want)
+				}
+
+				for _, e := range entries {
+					if e.Channel != channel {
+						t.Fatalf("entry channel = %q, want %q", e.Channel, channel)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		channel := prefix + "bounds"
+
+		oldest, newest, err := log.Bounds(ctx, channel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if oldest != "" || newest != "" {
+			t.Fatalf("Bounds() of an empty channel = %q, %q, want empty", oldest, newest)
+		}
+
+		ids := appendAll(t, channel, "a", "b", "c")
+
+		oldest, newest, err = log.Bounds(ctx, channel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if oldest != ids[0] || newest != ids[2] {
+			t.Fatalf("Bounds() = %q, %q, want %q, %q", oldest, newest, ids[0], ids[2])
+		}
+	})
+
+	t.Run("dedup", func(t *testing.T) {
+		channel := prefix + "dedup"
+
+		first, err := log.Append(ctx, channel, "event-1", []byte("a"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		again, err := log.Append(ctx, channel, "event-1", []byte("a"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		other, err := log.Append(ctx, prefix+"dedup-other", "event-1", []byte("a"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if again != first {
+			t.Fatalf("duplicate Append() = %q, want the first ID %q", again, first)
+		}
+
+		entries, err := log.Read(ctx, channel, "", 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 {
+			t.Fatalf("channel has %d entries, want 1", len(entries))
+		}
+
+		if other == "" {
+			t.Fatal("dedup ID of another channel suppressed the append")
+		}
+	})
+
+	t.Run("channels are separate", func(t *testing.T) {
+		appendAll(t, prefix+"left", "l")
+		appendAll(t, prefix+"right", "r")
+
+		entries, err := log.Read(ctx, prefix+"left", "", 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := payloads(entries); got != "l" {
+			t.Fatalf("Read() = %q, want %q", got, "l")
+		}
+	})
+
+	t.Run("blocking read", func(t *testing.T) {
+		channel := prefix + "blocking"
+
+		if w, ok := log.(watcher); ok {
+			err := w.Watch(channel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer w.Unwatch(channel)
+		}
+
+		entries, err := log.Read(ctx, channel, "", 10, 50*time.Millisecond)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 0 {
+			t.Fatalf("Read() of an empty channel = %d entries, want none", len(entries))
+		}
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_, _ = log.Append(ctx, channel, "", []byte("late"))
+		}()
+
+		start := time.Now()
+		entries, err = log.Read(ctx, channel, "", 10, 5*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := payloads(entries); got != "late" {
+			t.Fatalf("Read() = %q, want %q", got, "late")
+		}
+
+		if waited := time.Since(start); waited > 4*time.Second {
+			t.Fatalf("blocking Read() woke after %s, want it to wake on append", waited)
+		}
+	})
+
+	t.Run("blocking read cancelled", func(t *testing.T) {
+		channel := prefix + "cancelled"
+
+		if w, ok := log.(watcher); ok {
+			err := w.Watch(channel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer w.Unwatch(channel)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := log.Read(ctx, channel, "", 10, 5*time.Second)
+		if err == nil {
+			t.Fatal("Read() error = nil, want the context error")
+		}
+	})
+}
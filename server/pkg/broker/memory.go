@@ -0,0 +1,133 @@
+package broker
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MemoryLog keeps entries in process only.
+type MemoryLog struct {
+	maxLen int
+
+	mu       sync.Mutex
+	channels map[string]*memoryChannel
+}
+
+type memoryChannel struct {
+	seq     uint64
+	entries []Message
+	// dedupIDs holds the dedup ID of each entry, "" when it has none.
+	dedupIDs []string
+	dedup    map[string]string
+	wake     chan struct{}
+}
+
+// NewMemoryLog keeps up to maxLen entries per channel.
+func NewMemoryLog(maxLen int) *MemoryLog {
+	if maxLen <= 0 {
+		maxLen = 1000
+	}
+
+	return &MemoryLog{
+		maxLen:   maxLen,
+		channels: map[string]*memoryChannel{},
+	}
+}
+
+func (l *MemoryLog) Append(ctx context.Context, channel, dedupID string, payload []byte) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := l.channel(channel)
+	if id, ok := c.dedup[dedupID]; ok && dedupID != "" {
+		return id, nil
+	}
+
+	c.seq++
+
+	id := strconv.FormatUint(c.seq, 10)
+	c.entries = append(c.entries, Message{ID: id, Channel: channel, Payload: payload})
+	c.dedupIDs = append(c.dedupIDs, dedupID)
+	if dedupID != "" {
+		c.dedup[dedupID] = id
+	}
+
+	if over := len(c.entries) - l.maxLen; over > 0 {
+		for _, key := range c.dedupIDs[:over] {
+			delete(c.dedup, key)
+		}
+		c.entries = append([]Message(nil), c.entries[over:]...)
+		c.dedupIDs = append([]string(nil), c.dedupIDs[over:]...)
+	}
+
+	close(c.wake)
+	c.wake = make(chan struct{})
+
+	return id, nil
+}
+
+func (l *MemoryLog) Read(ctx context.Context, channel, after string, count int, block time.Duration) ([]Message, error) {
+	entries, wake := l.read(channel, after, count)
+	if len(entries) > 0 || block <= 0 {
+		return entries, nil
+	}
+
+	timer := time.NewTimer(block)
+	defer timer.Stop()
+
+	select {
+	case <-wake:
+	case <-timer.C:
+		return nil, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	entries, _ = l.read(channel, after, count)
+
+	return entries, nil
+}
+
+func (l *MemoryLog) Bounds(ctx context.Context, channel string) (string, string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := l.channel(channel)
+	if len(c.entries) == 0 {
+		return "", "", nil
+	}
+
+	return c.entries[0].ID, c.entries[len(c.entries)-1].ID, nil
+}
+
+func (l *MemoryLog) Close() error {
+	return nil
+}
+
+func (l *MemoryLog) read(channel, after string, count int) ([]Message, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := l.channel(channel)
+	i := sort.Search(len(c.entries), func(i int) bool {
+		return compareID(c.entries[i].ID, after) > 0
+	})
+
+	end := min(len(c.entries), i+count)
+
+	return append([]Message(nil), c.entries[i:end]...), c.wake
+}
+
+// channel must be called with l.mu held.
+func (l *MemoryLog) channel(name string) *memoryChannel {
+	c, ok := l.channels[name]
+	if !ok {
+		c = &memoryChannel{dedup: map[string]string{}, wake: make(chan struct{})}
+		l.channels[name] = c
+	}
+
+	return c
+}
@@ -0,0 +1,47 @@
+package broker
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryLog(t *testing.T) {
+	testLog(t, NewMemoryLog(100))
+}
+
+func TestMemoryLogTrims(t *testing.T) {
+	ctx := context.Background()
+	log := NewMemoryLog(2)
+
+	for _, dedupID := range []string{"a", "b", "c"} {
+		_, err := log.Append(ctx, "ch", dedupID, []byte(dedupID))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldest, newest, err := log.Bounds(ctx, "ch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oldest != "2" || newest != "3" {
+		t.Fatalf("Bounds() = %q, %q, want %q, %q", oldest, newest, "2", "3")
+	}
+
+	// The dedup ID of a trimmed entry is forgotten with it.
+	id, err := log.Append(ctx, "ch", "a", []byte("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "4" {
+		t.Fatalf("Append() after trimming = %q, want a new entry %q", id, "4")
+	}
+
+	id, err = log.Append(ctx, "ch", "c", []byte("c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "3" {
+		t.Fatalf("Append() of a retained dedup ID = %q, want %q", id, "3")
+	}
+}
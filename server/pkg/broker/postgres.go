@@ -0,0 +1,255 @@
+package broker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+// pruneInterval is how often a process deletes expired entries.
+const pruneInterval = time.Minute
+
+// PostgresLog stores entries in the broker_messages table and uses
+// LISTEN/NOTIFY only to wake readers, so deployments already running
+// Postgres need no extra infrastructure. Entries are kept for maxAge.
+type PostgresLog struct {
+	db        *sqlx.DB
+	listener  *pq.Listener
+	maxAge    time.Duration
+	zlog      zerolog.Logger
+	lastPrune atomic.Int64
+
+	mu    sync.Mutex
+	wakes map[string]chan struct{}
+}
+
+func NewPostgresLog(db *sqlx.DB, connStr string, maxAge time.Duration, zlog zerolog.Logger) (*PostgresLog, error) {
+	listener := pq.NewListener(connStr, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			zlog.Warn().Err(err).Int("event", int(event)).Msg("Postgres broker listener event")
+		}
+	})
+
+	err := listener.Ping()
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	l := &PostgresLog{
+		db:       db,
+		listener: listener,
+		maxAge:   maxAge,
+		zlog:     zlog,
+		wakes:    map[string]chan struct{}{},
+	}
+
+	go l.dispatch()
+
+	return l, nil
+}
+
+// Append inserts the entry under a per-channel advisory lock, so entries
+// of one channel commit in ID order and a reader never sees a later ID
+// before an earlier one. The lock also serializes the dedup check.
+func (l *PostgresLog) Append(ctx context.Context, channel, dedupID string, payload []byte) (string, error) {
+	l.prune(ctx)
+
+	tx, err := l.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, channel)
+	if err != nil {
+		return "", err
+	}
+
+	var id int64
+	if dedupID != "" {
+		err = tx.QueryRowxContext(ctx, `SELECT id FROM broker_messages WHERE channel = $1 AND dedup_id = $2`,
+			channel, dedupID).Scan(&id)
+		if err == nil {
+			return strconv.FormatInt(id, 10), nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+	}
+
+	err = tx.QueryRowxContext(ctx, `INSERT INTO broker_messages (channel, dedup_id, payload, created_at) VALUES ($1, $2, $3, $4) RETURNING id`,
+		channel, sql.NullString{String: dedupID, Valid: dedupID != ""}, payload, time.Now()).Scan(&id)
+	if err != nil {
+		return "", err
+	}
+
+	// NOTIFY inside a transaction is delivered on commit, once the row is
+	// visible to readers.
+	_, err = tx.ExecContext(ctx, `SELECT pg_notify($1, '')`, channel)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(id, 10), nil
+}
+
+func (l *PostgresLog) Read(ctx context.Context, channel, after string, count int, block time.Duration) ([]Message, error) {
+	l.mu.Lock()
+	wake := l.wakes[channel]
+	l.mu.Unlock()
+
+	entries, err := l.read(ctx, channel, after, count)
+	if err != nil || len(entries) > 0 || block <= 0 || wake == nil {
+		return entries, err
+	}
+
+	timer := time.NewTimer(block)
+	defer timer.Stop()
+
+	select {
+	case <-wake:
+	case <-timer.C:
+		return nil, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return l.read(ctx, channel, after, count)
+}
+
+func (l *PostgresLog) Bounds(ctx context.Context, channel string) (string, string, error) {
+	const query = `
+		SELECT COALESCE(MIN(id)::TEXT, ''), COALESCE(MAX(id)::TEXT, '')
+		FROM broker_messages
+		WHERE channel = $1 AND created_at > $2
+	`
+
+	var oldest, newest string
+	err := l.db.QueryRowxContext(ctx, query, channel, time.Now().Add(-l.maxAge)).Scan(&oldest, &newest)
+	if err != nil {
+		return "", "", err
+	}
+
+	return oldest, newest, nil
+}
+
+func (l *PostgresLog) Close() error {
+	return l.listener.Close()
+}
+
+// Watch listens for notifications on channel so blocking reads wake as
+// soon as an entry is appended.
+func (l *PostgresLog) Watch(channel string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.listener.Listen(channel)
+	if err != nil && err != pq.ErrChannelAlreadyOpen {
+		return err
+	}
+
+	if l.wakes[channel] == nil {
+		l.wakes[channel] = make(chan struct{})
+	}
+
+	return nil
+}
+
+func (l *PostgresLog) Unwatch(channel string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.wakes, channel)
+
+	err := l.listener.Unlisten(channel)
+	if err != nil && err != pq.ErrChannelNotOpen {
+		return err
+	}
+
+	return nil
+}
+
+func (l *PostgresLog) read(ctx context.Context, channel, after string, count int) ([]Message, error) {
+	afterID := int64(0)
+	if after != "" {
+		id, err := strconv.ParseInt(after, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		afterID = id
+	}
+
+	const query = `
+		SELECT id, payload
+		FROM broker_messages
+		WHERE channel = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`
+
+	rows, err := l.db.QueryxContext(ctx, query, channel, afterID, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []Message
+	for rows.Next() {
+		var id int64
+		var payload []byte
+		err := rows.Scan(&id, &payload)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, Message{ID: strconv.FormatInt(id, 10), Channel: channel, Payload: payload})
+	}
+
+	return entries, rows.Err()
+}
+
+// dispatch wakes the readers of notified channels. A nil notification
+// follows a reconnect, after which every reader re-checks the table.
+func (l *PostgresLog) dispatch() {
+	for n := range l.listener.Notify {
+		l.mu.Lock()
+		for channel, wake := range l.wakes {
+			if n == nil || n.Channel == channel {
+				close(wake)
+				l.wakes[channel] = make(chan struct{})
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// prune deletes expired entries at most once per pruneInterval per
+// process.
+func (l *PostgresLog) prune(ctx context.Context) {
+	now := time.Now()
+	last := l.lastPrune.Load()
+	if now.UnixNano()-last < int64(pruneInterval) || !l.lastPrune.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	_, err := l.db.ExecContext(ctx, `DELETE FROM broker_messages WHERE created_at < $1`, now.Add(-l.maxAge))
+	if err != nil {
+		l.zlog.Error().Err(err).Msg("Failed prune broker messages")
+	}
+}
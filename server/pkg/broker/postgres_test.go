@@ -0,0 +1,44 @@
+package broker
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+// TestPostgresLog runs against the database named by
+// CHATSPACE_TEST_POSTGRES_DSN and is skipped without one. It applies
+// migration/init.sql, which is safe to rerun.
+func TestPostgresLog(t *testing.T) {
+	dsn := os.Getenv("CHATSPACE_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("CHATSPACE_TEST_POSTGRES_DSN is not set")
+	}
+
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migration, err := os.ReadFile("../../migration/init.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec(string(migration))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log, err := NewPostgresLog(db, dsn, time.Hour, zerolog.Nop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer log.Close()
+
+	testLog(t, log)
+}
@@ -0,0 +1,316 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	streamKeyPrefix = "stream:"
+	dedupKeyPrefix  = "stream-dedup:"
+	wakeKeyPrefix   = "stream-wake:"
+	payloadField    = "p"
+
+	// pollBlock bounds the XREAD that waits on every watched stream, and
+	// pollBatch the entries it returns per stream, which only serve to
+	// find where each stream ends.
+	pollBlock = 5 * time.Second
+	pollBatch = 100
+)
+
+// appendScript adds an entry unless its dedup key exists, in which case it
+// returns the ID stored under that key. Running it as a script keeps the
+// check and the XADD atomic.
+//
+// KEYS: stream, dedup key ("" for none). ARGV: payload, max length, max
+// age in seconds.
+var appendScript = redis.NewScript(`
+if KEYS[2] ~= "" then
+	local id = redis.call("GET", KEYS[2])
+	if id then
+		return id
+	end
+end
+
+local id = redis.call("XADD", KEYS[1], "MAXLEN", "~", ARGV[2], "*", "p", ARGV[1])
+redis.call("EXPIRE", KEYS[1], ARGV[3])
+
+if KEYS[2] ~= "" then
+	redis.call("SET", KEYS[2], id, "EX", ARGV[3])
+end
+
+return id
+`)
+
+// RedisLog stores each channel in a Redis stream, trimmed to about maxLen
+// entries. Streams of channels that stay quiet for maxAge expire.
+//
+// A blocking XREAD holds a pooled connection until it returns, so rather
+// than one per channel, a process runs a single XREAD over every watched
+// stream and wakes the readers of those that grew. Its own wake stream is
+// part of that XREAD, so a newly watched channel is picked up at once.
+type RedisLog struct {
+	rdb     *redis.Client
+	maxLen  int64
+	maxAge  time.Duration
+	wakeKey string
+	cancel  context.CancelFunc
+
+	mu      sync.Mutex
+	watches map[string]*redisWatch
+}
+
+type redisWatch struct {
+	// last is the newest ID the poller has seen in the stream.
+	last string
+	wake chan struct{}
+}
+
+func NewRedisLog(rdb *redis.Client, maxLen int64, maxAge time.Duration) *RedisLog {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	l := &RedisLog{
+		rdb:     rdb,
+		maxLen:  maxLen,
+		maxAge:  maxAge,
+		wakeKey: wakeKeyPrefix + uuid.NewString(),
+		cancel:  cancel,
+		watches: map[string]*redisWatch{},
+	}
+
+	go l.poll(ctx)
+
+	return l
+}
+
+// Append keeps a dedup ID for maxAge, about as long as the stream retains
+// the entry it refers to.
+func (l *RedisLog) Append(ctx context.Context, channel, dedupID string, payload []byte) (string, error) {
+	dedupKey := ""
+	if dedupID != "" {
+		dedupKey = dedupKeyPrefix + channel + ":" + dedupID
+	}
+
+	maxAge := max(int64(l.maxAge/time.Second), 1)
+
+	return appendScript.Run(ctx, l.rdb, []string{streamKeyPrefix + channel, dedupKey}, payload, l.maxLen, maxAge).Text()
+}
+
+// Read waits on the poller when the channel is watched. Blocking reads of
+// other channels hold a connection of their own.
+func (l *RedisLog) Read(ctx context.Context, channel, after string, count int, block time.Duration) ([]Message, error) {
+	l.mu.Lock()
+	var wake chan struct{}
+	if w := l.watches[channel]; w != nil {
+		wake = w.wake
+	}
+	l.mu.Unlock()
+
+	if wake == nil || block <= 0 {
+		return l.read(ctx, channel, after, count, block)
+	}
+
+	entries, err := l.read(ctx, channel, after, count, 0)
+	if err != nil || len(entries) > 0 {
+		return entries, err
+	}
+
+	timer := time.NewTimer(block)
+	defer timer.Stop()
+
+	select {
+	case <-wake:
+	case <-timer.C:
+		return nil, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return l.read(ctx, channel, after, count, 0)
+}
+
+func (l *RedisLog) read(ctx context.Context, channel, after string, count int, block time.Duration) ([]Message, error) {
+	if after == "" {
+		after = "0-0"
+	}
+
+	if block <= 0 {
+		block = -1
+	}
+
+	streams, err := l.rdb.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{streamKeyPrefix + channel, after},
+		Count:   int64(count),
+		Block:   block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Message
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			entries = append(entries, toMessage(channel, msg))
+		}
+	}
+
+	return entries, nil
+}
+
+func (l *RedisLog) Bounds(ctx context.Context, channel string) (string, string, error) {
+	key := streamKeyPrefix + channel
+
+	oldest, err := l.rdb.XRangeN(ctx, key, "-", "+", 1).Result()
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(oldest) == 0 {
+		return "", "", nil
+	}
+
+	newest, err := l.rdb.XRevRangeN(ctx, key, "+", "-", 1).Result()
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(newest) == 0 {
+		return oldest[0].ID, oldest[0].ID, nil
+	}
+
+	return oldest[0].ID, newest[0].ID, nil
+}
+
+// Close stops the poller. The Redis client is shared and closed by its
+// owner.
+func (l *RedisLog) Close() error {
+	l.cancel()
+	return nil
+}
+
+// Watch adds channel to the streams the poller waits on, starting after
+// its newest entry.
+func (l *RedisLog) Watch(channel string) error {
+	ctx := context.Background()
+
+	newest, err := l.rdb.XRevRangeN(ctx, streamKeyPrefix+channel, "+", "-", 1).Result()
+	if err != nil {
+		return err
+	}
+
+	last := "0-0"
+	if len(newest) > 0 {
+		last = newest[0].ID
+	}
+
+	l.mu.Lock()
+	if l.watches[channel] == nil {
+		l.watches[channel] = &redisWatch{last: last, wake: make(chan struct{})}
+	}
+	l.mu.Unlock()
+
+	return l.wakePoller(ctx)
+}
+
+// Unwatch leaves the running XREAD alone; the channel is dropped from the
+// next one.
+func (l *RedisLog) Unwatch(channel string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.watches, channel)
+
+	return nil
+}
+
+// wakePoller appends to the poller's wake stream so it restarts its XREAD
+// with the current set of watched streams.
+func (l *RedisLog) wakePoller(ctx context.Context) error {
+	_, err := l.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+		p.XAdd(ctx, &redis.XAddArgs{
+			Stream: l.wakeKey,
+			MaxLen: 1,
+			Values: map[string]interface{}{payloadField: ""},
+		})
+		p.Expire(ctx, l.wakeKey, pollBlock*2)
+		return nil
+	})
+
+	return err
+}
+
+// poll waits on the wake stream and every watched stream with one
+// blocking XREAD and wakes the readers of the streams that grew, until
+// ctx is done.
+func (l *RedisLog) poll(ctx context.Context) {
+	wakeLast := "0-0"
+
+	for ctx.Err() == nil {
+		l.mu.Lock()
+		keys := []string{l.wakeKey}
+		ids := []string{wakeLast}
+		for channel, w := range l.watches {
+			keys = append(keys, streamKeyPrefix+channel)
+			ids = append(ids, w.last)
+		}
+		l.mu.Unlock()
+
+		streams, err := l.rdb.XRead(ctx, &redis.XReadArgs{
+			Streams: append(keys, ids...),
+			Count:   pollBatch,
+			Block:   pollBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryWait):
+			}
+			continue
+		}
+
+		l.mu.Lock()
+		for _, stream := range streams {
+			if len(stream.Messages) == 0 {
+				continue
+			}
+
+			last := stream.Messages[len(stream.Messages)-1].ID
+			if stream.Stream == l.wakeKey {
+				wakeLast = last
+				continue
+			}
+
+			w := l.watches[strings.TrimPrefix(stream.Stream, streamKeyPrefix)]
+			if w == nil || compareID(last, w.last) <= 0 {
+				continue
+			}
+
+			w.last = last
+			close(w.wake)
+			w.wake = make(chan struct{})
+		}
+		l.mu.Unlock()
+	}
+}
+
+func toMessage(channel string, msg redis.XMessage) Message {
+	payload, _ := msg.Values[payloadField].(string)
+
+	return Message{
+		ID:      msg.ID,
+		Channel: channel,
+		Payload: []byte(payload),
+	}
+}
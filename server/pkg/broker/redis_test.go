@@ -0,0 +1,86 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/zerolog"
+)
+
+// testRedis connects to the server at CHATSPACE_TEST_REDIS_ADDR and skips
+// the test without one.
+func testRedis(t *testing.T, poolSize int) *redis.Client {
+	t.Helper()
+
+	addr := os.Getenv("CHATSPACE_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("CHATSPACE_TEST_REDIS_ADDR is not set")
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: addr, PoolSize: poolSize})
+	t.Cleanup(func() {
+		rdb.Close()
+	})
+
+	err := rdb.Ping(context.Background()).Err()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rdb
+}
+
+func TestRedisLog(t *testing.T) {
+	log := NewRedisLog(testRedis(t, 0), 1000, time.Hour)
+	defer log.Close()
+
+	testLog(t, log)
+}
+
+// TestRedisHubManyChannels tails more channels than the client has
+// connections. Blocking reads must not hold one connection each, or
+// subscribes and publishes wait for the pool.
+func TestRedisHubManyChannels(t *testing.T) {
+	const poolSize = 2
+	const channels = 4 * poolSize
+
+	hub := NewHub(NewRedisLog(testRedis(t, poolSize), 1000, time.Hour), zerolog.Nop())
+	defer hub.Close()
+
+	ctx := context.Background()
+	prefix := fmt.Sprintf("test-%d-many-", time.Now().UnixNano())
+
+	var subs []<-chan Message
+	for i := range channels {
+		ch, closeSub, err := hub.Subscribe(ctx, prefix+strconv.Itoa(i), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer closeSub()
+
+		subs = append(subs, ch)
+	}
+
+	start := time.Now()
+	for i := range channels {
+		err := hub.Publish(ctx, prefix+strconv.Itoa(i), []byte(strconv.Itoa(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, ch := range subs {
+		if got := string(receive(t, ch).Payload); got != strconv.Itoa(i) {
+			t.Fatalf("channel %d got %q, want %q", i, got, strconv.Itoa(i))
+		}
+	}
+
+	if waited := time.Since(start); waited > time.Second {
+		t.Fatalf("delivery to %d channels took %s, want it not to wait for blocking reads", channels, waited)
+	}
+}
@@ -0,0 +1,412 @@
+// Package chatclient is a typed Go client for the ChatSpace GraphQL API.
+// The operation functions in generated.go are produced by genqlient from
+// graph/schema and the documents in operations; Client adds authentication,
+// token refresh, retries and websocket subscriptions on top of them.
+package chatclient
+
+//go:generate go tool genqlient ../../genqlient.yaml
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// forbiddenMessage is the error the API returns for a missing or expired
+// access token.
+const forbiddenMessage = "forbidden access"
+
+// refreshLeeway is how long before expiry an access token is refreshed
+// proactively.
+const refreshLeeway = 30 * time.Second
+
+var ErrNoRefreshToken = errors.New("chatclient: no refresh token is set")
+
+type Options struct {
+	// Endpoint is the GraphQL URL, e.g. https://chat.example.com/query.
+	Endpoint string
+	// Token and RefreshToken are the credentials returned by login,
+	// register or createBot. Either may be empty.
+	Token        string
+	RefreshToken string
+	HTTPClient   *http.Client
+	// MaxRetries is how many times a request failing with a transient
+	// error is retried. Mutations are only retried when the server cannot
+	// have processed them.
+	MaxRetries int
+	// RetryBackoff is the base delay between retries; it doubles on every
+	// attempt and is jittered.
+	RetryBackoff time.Duration
+	// OnTokens is called after the client refreshed its credentials so
+	// callers can persist them.
+	OnTokens func(token, refreshToken string)
+}
+
+// Client implements graphql.Client; pass it to any generated operation.
+type Client struct {
+	opts Options
+	gql  graphql.Client
+	anon graphql.Client
+	http *http.Client
+
+	mu           sync.Mutex
+	token        string
+	refreshToken string
+}
+
+func New(opts Options) *Client {
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	}
+
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = 200 * time.Millisecond
+	}
+
+	c := &Client{
+		opts:         opts,
+		http:         opts.HTTPClient,
+		token:        opts.Token,
+		refreshToken: opts.RefreshToken,
+	}
+	c.gql = graphql.NewClient(opts.Endpoint, authDoer{c})
+	c.anon = graphql.NewClient(opts.Endpoint, opts.HTTPClient)
+
+	return c
+}
+
+// SetTokens replaces the credentials, e.g. after a Login call.
+func (c *Client) SetTokens(token, refreshToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = token
+	if refreshToken != "" {
+		c.refreshToken = refreshToken
+	}
+}
+
+// Tokens returns the current access and refresh tokens.
+func (c *Client) Tokens() (string, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.token, c.refreshToken
+}
+
+// MakeRequest runs an operation, refreshing the access token when it is
+// about to expire or gets rejected, and retrying transient failures.
+func (c *Client) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if v, ok := req.Variables.(*__UploadAttachmentInput); ok {
+		return c.upload(ctx, req, resp, v.File)
+	}
+
+	err := c.refreshIfExpiring(ctx)
+	if err != nil {
+		return err
+	}
+
+	mutation := strings.HasPrefix(strings.TrimSpace(req.Query), "mutation")
+	refreshed := false
+	// A "data": null response clears resp.Data, so keep the typed target
+	// for the next attempt.
+	data := resp.Data
+
+	for attempt := 0; ; attempt++ {
+		token, _ := c.Tokens()
+		*resp = graphql.Response{Data: data}
+
+		err = c.gql.MakeRequest(ctx, req, resp)
+		if err == nil {
+			return nil
+		}
+
+		if isForbidden(err) && !refreshed && c.canRefresh() {
+			refreshed = true
+			if err := c.refresh(ctx, token); err != nil {
+				return err
+			}
+			attempt--
+			continue
+		}
+
+		if attempt >= c.opts.MaxRetries || !retryable(err, mutation) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.backoff(attempt)):
+		}
+	}
+}
+
+func (c *Client) canRefresh() bool {
+	_, refreshToken := c.Tokens()
+	return refreshToken != ""
+}
+
+// Refresh exchanges the refresh token for new credentials.
+func (c *Client) Refresh(ctx context.Context) error {
+	token, _ := c.Tokens()
+	return c.refresh(ctx, token)
+}
+
+// refresh renews the credentials unless another request already replaced
+// the rejected token in the meantime.
+func (c *Client) refresh(ctx context.Context, rejected string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != rejected {
+		return nil
+	}
+
+	if c.refreshToken == "" {
+		return ErrNoRefreshToken
+	}
+
+	data, err := RefreshToken(ctx, c.anon, &RefreshRequest{RefreshToken: c.refreshToken})
+	if err != nil {
+		return fmt.Errorf("chatclient: refreshing token: %w", err)
+	}
+
+	c.token = data.RefreshToken.Token
+	if data.RefreshToken.RefreshToken != nil {
+		c.refreshToken = *data.RefreshToken.RefreshToken
+	}
+
+	if c.opts.OnTokens != nil {
+		c.opts.OnTokens(c.token, c.refreshToken)
+	}
+
+	return nil
+}
+
+func (c *Client) refreshIfExpiring(ctx context.Context) error {
+	token, refreshToken := c.Tokens()
+	if token == "" || refreshToken == "" {
+		return nil
+	}
+
+	claims := jwt.RegisteredClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, &claims)
+	if err != nil || claims.ExpiresAt == nil || time.Until(claims.ExpiresAt.Time) > refreshLeeway {
+		return nil
+	}
+
+	return c.refresh(ctx, token)
+}
+
+// WebSocket returns a subscription client authenticated with the current
+// access token. Call Start on it before running generated subscription
+// functions, and Close when done; reconnecting means creating a new one.
+func (c *Client) WebSocket(ctx context.Context) (graphql.WebSocketClient, error) {
+	err := c.refreshIfExpiring(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := websocketURL(c.opts.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Sec-WebSocket-Protocol", "graphql-transport-ws")
+	if token, _ := c.Tokens(); token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+
+	dialer := wsDialer{&websocket.Dialer{HandshakeTimeout: 10 * time.Second}}
+
+	return graphql.NewClientUsingWebSocket(endpoint, dialer, graphql.WithWebsocketHeader(header)), nil
+}
+
+// upload sends UploadAttachment as a multipart request. The body can only
+// be read once, so uploads are never retried.
+func (c *Client) upload(ctx context.Context, req *graphql.Request, resp *graphql.Response, file Upload) error {
+	err := c.refreshIfExpiring(ctx)
+	if err != nil {
+		return err
+	}
+
+	operations, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	err = w.WriteField("operations", string(operations))
+	if err != nil {
+		return err
+	}
+
+	err = w.WriteField("map", `{"0":["variables.file"]}`)
+	if err != nil {
+		return err
+	}
+
+	part, err := w.CreateFormFile("0", file.Filename)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, file.Body)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.opts.Endpoint, &body)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", w.FormDataContentType())
+
+	httpResp, err := authDoer{c}.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(httpResp.Body, 64<<10))
+		return fmt.Errorf("chatclient: upload returned status %d: %s", httpResp.StatusCode, data)
+	}
+
+	err = json.NewDecoder(httpResp.Body).Decode(resp)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+
+	return nil
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.opts.RetryBackoff << min(attempt, 10)
+	return d/2 + rand.N(d/2+1)
+}
+
+func isForbidden(err error) bool {
+	var httpErr *graphql.HTTPError
+	if errors.As(err, &httpErr) {
+		err = httpErr.Response.Errors
+	}
+
+	var errs gqlerror.List
+	if !errors.As(err, &errs) {
+		return false
+	}
+
+	for _, e := range errs {
+		if e != nil && e.Message == forbiddenMessage {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryable reports whether err is transient. Mutations are retried only
+// when the request never reached the server or was explicitly refused.
+func retryable(err error, mutation bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var httpErr *graphql.HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			return true
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			return !mutation
+		}
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var netErr net.Error
+	return !mutation && errors.As(err, &netErr)
+}
+
+// authDoer adds the current access token to every request.
+type authDoer struct {
+	c *Client
+}
+
+func (d authDoer) Do(req *http.Request) (*http.Response, error) {
+	if token, _ := d.c.Tokens(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return d.c.http.Do(req)
+}
+
+type wsDialer struct {
+	*websocket.Dialer
+}
+
+func (d wsDialer) DialContext(ctx context.Context, urlStr string, header http.Header) (graphql.WSConn, error) {
+	conn, _, err := d.Dialer.DialContext(ctx, urlStr, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func websocketURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("chatclient: unsupported endpoint scheme %q", u.Scheme)
+	}
+
+	return u.String(), nil
+}
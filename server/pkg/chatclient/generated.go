@@ -0,0 +1,10994 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package chatclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// AttachmentFields includes the GraphQL fields of Attachment requested by the fragment AttachmentFields.
+type AttachmentFields struct {
+	Id          string                                           `json:"id"`
+	Filename    string                                           `json:"filename"`
+	ContentType string                                           `json:"contentType"`
+	Size        int64                                            `json:"size"`
+	Url         *string                                          `json:"url"`
+	Status      AttachmentStatus                                 `json:"status"`
+	Width       *int                                             `json:"width"`
+	Height      *int                                             `json:"height"`
+	Blurhash    *string                                          `json:"blurhash"`
+	Thumbnails  []*AttachmentFieldsThumbnailsAttachmentThumbnail `json:"thumbnails"`
+	CreatedAt   time.Time                                        `json:"createdAt"`
+}
+
+// GetId returns AttachmentFields.Id, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetId() string { return v.Id }
+
+// GetFilename returns AttachmentFields.Filename, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetFilename() string { return v.Filename }
+
+// GetContentType returns AttachmentFields.ContentType, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetContentType() string { return v.ContentType }
+
+// GetSize returns AttachmentFields.Size, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetSize() int64 { return v.Size }
+
+// GetUrl returns AttachmentFields.Url, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetUrl() *string { return v.Url }
+
+// GetStatus returns AttachmentFields.Status, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetStatus() AttachmentStatus { return v.Status }
+
+// GetWidth returns AttachmentFields.Width, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetWidth() *int { return v.Width }
+
+// GetHeight returns AttachmentFields.Height, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetHeight() *int { return v.Height }
+
+// GetBlurhash returns AttachmentFields.Blurhash, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetBlurhash() *string { return v.Blurhash }
+
+// GetThumbnails returns AttachmentFields.Thumbnails, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetThumbnails() []*AttachmentFieldsThumbnailsAttachmentThumbnail {
+	return v.Thumbnails
+}
+
+// GetCreatedAt returns AttachmentFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *AttachmentFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// AttachmentFieldsThumbnailsAttachmentThumbnail includes the requested fields of the GraphQL type AttachmentThumbnail.
+type AttachmentFieldsThumbnailsAttachmentThumbnail struct {
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	ContentType string  `json:"contentType"`
+	Url         *string `json:"url"`
+}
+
+// GetWidth returns AttachmentFieldsThumbnailsAttachmentThumbnail.Width, and is useful for accessing the field via an interface.
+func (v *AttachmentFieldsThumbnailsAttachmentThumbnail) GetWidth() int { return v.Width }
+
+// GetHeight returns AttachmentFieldsThumbnailsAttachmentThumbnail.Height, and is useful for accessing the field via an interface.
+func (v *AttachmentFieldsThumbnailsAttachmentThumbnail) GetHeight() int { return v.Height }
+
+// GetContentType returns AttachmentFieldsThumbnailsAttachmentThumbnail.ContentType, and is useful for accessing the field via an interface.
+func (v *AttachmentFieldsThumbnailsAttachmentThumbnail) GetContentType() string { return v.ContentType }
+
+// GetUrl returns AttachmentFieldsThumbnailsAttachmentThumbnail.Url, and is useful for accessing the field via an interface.
+func (v *AttachmentFieldsThumbnailsAttachmentThumbnail) GetUrl() *string { return v.Url }
+
+// AttachmentPolicyAttachmentPolicy includes the requested fields of the GraphQL type AttachmentPolicy.
+type AttachmentPolicyAttachmentPolicy struct {
+	MaxSize   int64    `json:"maxSize"`
+	MimeTypes []string `json:"mimeTypes"`
+}
+
+// GetMaxSize returns AttachmentPolicyAttachmentPolicy.MaxSize, and is useful for accessing the field via an interface.
+func (v *AttachmentPolicyAttachmentPolicy) GetMaxSize() int64 { return v.MaxSize }
+
+// GetMimeTypes returns AttachmentPolicyAttachmentPolicy.MimeTypes, and is useful for accessing the field via an interface.
+func (v *AttachmentPolicyAttachmentPolicy) GetMimeTypes() []string { return v.MimeTypes }
+
+type AttachmentPolicyRequest struct {
+	MaxSize   *int64   `json:"maxSize"`
+	MimeTypes []string `json:"mimeTypes"`
+}
+
+// GetMaxSize returns AttachmentPolicyRequest.MaxSize, and is useful for accessing the field via an interface.
+func (v *AttachmentPolicyRequest) GetMaxSize() *int64 { return v.MaxSize }
+
+// GetMimeTypes returns AttachmentPolicyRequest.MimeTypes, and is useful for accessing the field via an interface.
+func (v *AttachmentPolicyRequest) GetMimeTypes() []string { return v.MimeTypes }
+
+// AttachmentPolicyResponse is returned by AttachmentPolicy on success.
+type AttachmentPolicyResponse struct {
+	AttachmentPolicy *AttachmentPolicyAttachmentPolicy `json:"attachmentPolicy"`
+}
+
+// GetAttachmentPolicy returns AttachmentPolicyResponse.AttachmentPolicy, and is useful for accessing the field via an interface.
+func (v *AttachmentPolicyResponse) GetAttachmentPolicy() *AttachmentPolicyAttachmentPolicy {
+	return v.AttachmentPolicy
+}
+
+type AttachmentStatus string
+
+const (
+	AttachmentStatusPending AttachmentStatus = "PENDING"
+	AttachmentStatusReady   AttachmentStatus = "READY"
+	AttachmentStatusFailed  AttachmentStatus = "FAILED"
+)
+
+var AllAttachmentStatus = []AttachmentStatus{
+	AttachmentStatusPending,
+	AttachmentStatusReady,
+	AttachmentStatusFailed,
+}
+
+// BlockUserBlockUser includes the requested fields of the GraphQL type User.
+type BlockUserBlockUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns BlockUserBlockUser.Id, and is useful for accessing the field via an interface.
+func (v *BlockUserBlockUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns BlockUserBlockUser.Email, and is useful for accessing the field via an interface.
+func (v *BlockUserBlockUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns BlockUserBlockUser.Name, and is useful for accessing the field via an interface.
+func (v *BlockUserBlockUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns BlockUserBlockUser.Bot, and is useful for accessing the field via an interface.
+func (v *BlockUserBlockUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns BlockUserBlockUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *BlockUserBlockUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *BlockUserBlockUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*BlockUserBlockUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.BlockUserBlockUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalBlockUserBlockUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *BlockUserBlockUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *BlockUserBlockUser) __premarshalJSON() (*__premarshalBlockUserBlockUser, error) {
+	var retval __premarshalBlockUserBlockUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// BlockUserResponse is returned by BlockUser on success.
+type BlockUserResponse struct {
+	BlockUser *BlockUserBlockUser `json:"blockUser"`
+}
+
+// GetBlockUser returns BlockUserResponse.BlockUser, and is useful for accessing the field via an interface.
+func (v *BlockUserResponse) GetBlockUser() *BlockUserBlockUser { return v.BlockUser }
+
+// BlockedUsersBlockedUsersUser includes the requested fields of the GraphQL type User.
+type BlockedUsersBlockedUsersUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns BlockedUsersBlockedUsersUser.Id, and is useful for accessing the field via an interface.
+func (v *BlockedUsersBlockedUsersUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns BlockedUsersBlockedUsersUser.Email, and is useful for accessing the field via an interface.
+func (v *BlockedUsersBlockedUsersUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns BlockedUsersBlockedUsersUser.Name, and is useful for accessing the field via an interface.
+func (v *BlockedUsersBlockedUsersUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns BlockedUsersBlockedUsersUser.Bot, and is useful for accessing the field via an interface.
+func (v *BlockedUsersBlockedUsersUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns BlockedUsersBlockedUsersUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *BlockedUsersBlockedUsersUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *BlockedUsersBlockedUsersUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*BlockedUsersBlockedUsersUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.BlockedUsersBlockedUsersUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalBlockedUsersBlockedUsersUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *BlockedUsersBlockedUsersUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *BlockedUsersBlockedUsersUser) __premarshalJSON() (*__premarshalBlockedUsersBlockedUsersUser, error) {
+	var retval __premarshalBlockedUsersBlockedUsersUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// BlockedUsersResponse is returned by BlockedUsers on success.
+type BlockedUsersResponse struct {
+	BlockedUsers []*BlockedUsersBlockedUsersUser `json:"blockedUsers"`
+}
+
+// GetBlockedUsers returns BlockedUsersResponse.BlockedUsers, and is useful for accessing the field via an interface.
+func (v *BlockedUsersResponse) GetBlockedUsers() []*BlockedUsersBlockedUsersUser {
+	return v.BlockedUsers
+}
+
+// BotAccessTokenBotAccessTokenAuthResponse includes the requested fields of the GraphQL type AuthResponse.
+type BotAccessTokenBotAccessTokenAuthResponse struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns BotAccessTokenBotAccessTokenAuthResponse.Token, and is useful for accessing the field via an interface.
+func (v *BotAccessTokenBotAccessTokenAuthResponse) GetToken() string { return v.Token }
+
+// BotAccessTokenResponse is returned by BotAccessToken on success.
+type BotAccessTokenResponse struct {
+	BotAccessToken *BotAccessTokenBotAccessTokenAuthResponse `json:"botAccessToken"`
+}
+
+// GetBotAccessToken returns BotAccessTokenResponse.BotAccessToken, and is useful for accessing the field via an interface.
+func (v *BotAccessTokenResponse) GetBotAccessToken() *BotAccessTokenBotAccessTokenAuthResponse {
+	return v.BotAccessToken
+}
+
+// CancelScheduledMessageResponse is returned by CancelScheduledMessage on success.
+type CancelScheduledMessageResponse struct {
+	CancelScheduledMessage bool `json:"cancelScheduledMessage"`
+}
+
+// GetCancelScheduledMessage returns CancelScheduledMessageResponse.CancelScheduledMessage, and is useful for accessing the field via an interface.
+func (v *CancelScheduledMessageResponse) GetCancelScheduledMessage() bool {
+	return v.CancelScheduledMessage
+}
+
+// ClosePollClosePoll includes the requested fields of the GraphQL type Poll.
+type ClosePollClosePoll struct {
+	PollFields `json:"-"`
+}
+
+// GetId returns ClosePollClosePoll.Id, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetId() string { return v.PollFields.Id }
+
+// GetQuestion returns ClosePollClosePoll.Question, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetQuestion() string { return v.PollFields.Question }
+
+// GetOptions returns ClosePollClosePoll.Options, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetOptions() []*PollFieldsOptionsPollOption { return v.PollFields.Options }
+
+// GetMultiChoice returns ClosePollClosePoll.MultiChoice, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetMultiChoice() bool { return v.PollFields.MultiChoice }
+
+// GetAnonymous returns ClosePollClosePoll.Anonymous, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetAnonymous() bool { return v.PollFields.Anonymous }
+
+// GetClosesAt returns ClosePollClosePoll.ClosesAt, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetClosesAt() *time.Time { return v.PollFields.ClosesAt }
+
+// GetClosed returns ClosePollClosePoll.Closed, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetClosed() bool { return v.PollFields.Closed }
+
+// GetTotalVoters returns ClosePollClosePoll.TotalVoters, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetTotalVoters() int { return v.PollFields.TotalVoters }
+
+// GetMyVotes returns ClosePollClosePoll.MyVotes, and is useful for accessing the field via an interface.
+func (v *ClosePollClosePoll) GetMyVotes() []string { return v.PollFields.MyVotes }
+
+func (v *ClosePollClosePoll) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ClosePollClosePoll
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ClosePollClosePoll = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PollFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalClosePollClosePoll struct {
+	Id string `json:"id"`
+
+	Question string `json:"question"`
+
+	Options []*PollFieldsOptionsPollOption `json:"options"`
+
+	MultiChoice bool `json:"multiChoice"`
+
+	Anonymous bool `json:"anonymous"`
+
+	ClosesAt *time.Time `json:"closesAt"`
+
+	Closed bool `json:"closed"`
+
+	TotalVoters int `json:"totalVoters"`
+
+	MyVotes []string `json:"myVotes"`
+}
+
+func (v *ClosePollClosePoll) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ClosePollClosePoll) __premarshalJSON() (*__premarshalClosePollClosePoll, error) {
+	var retval __premarshalClosePollClosePoll
+
+	retval.Id = v.PollFields.Id
+	retval.Question = v.PollFields.Question
+	retval.Options = v.PollFields.Options
+	retval.MultiChoice = v.PollFields.MultiChoice
+	retval.Anonymous = v.PollFields.Anonymous
+	retval.ClosesAt = v.PollFields.ClosesAt
+	retval.Closed = v.PollFields.Closed
+	retval.TotalVoters = v.PollFields.TotalVoters
+	retval.MyVotes = v.PollFields.MyVotes
+	return &retval, nil
+}
+
+// ClosePollResponse is returned by ClosePoll on success.
+type ClosePollResponse struct {
+	ClosePoll *ClosePollClosePoll `json:"closePoll"`
+}
+
+// GetClosePoll returns ClosePollResponse.ClosePoll, and is useful for accessing the field via an interface.
+func (v *ClosePollResponse) GetClosePoll() *ClosePollClosePoll { return v.ClosePoll }
+
+// CreateBotCreateBotBotRegistration includes the requested fields of the GraphQL type BotRegistration.
+type CreateBotCreateBotBotRegistration struct {
+	Bot   *CreateBotCreateBotBotRegistrationBotUser `json:"bot"`
+	Token string                                    `json:"token"`
+}
+
+// GetBot returns CreateBotCreateBotBotRegistration.Bot, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistration) GetBot() *CreateBotCreateBotBotRegistrationBotUser {
+	return v.Bot
+}
+
+// GetToken returns CreateBotCreateBotBotRegistration.Token, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistration) GetToken() string { return v.Token }
+
+// CreateBotCreateBotBotRegistrationBotUser includes the requested fields of the GraphQL type User.
+type CreateBotCreateBotBotRegistrationBotUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns CreateBotCreateBotBotRegistrationBotUser.Id, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistrationBotUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns CreateBotCreateBotBotRegistrationBotUser.Email, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistrationBotUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns CreateBotCreateBotBotRegistrationBotUser.Name, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistrationBotUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns CreateBotCreateBotBotRegistrationBotUser.Bot, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistrationBotUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns CreateBotCreateBotBotRegistrationBotUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *CreateBotCreateBotBotRegistrationBotUser) GetAvatarURL() *string {
+	return v.UserFields.AvatarURL
+}
+
+func (v *CreateBotCreateBotBotRegistrationBotUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateBotCreateBotBotRegistrationBotUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateBotCreateBotBotRegistrationBotUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateBotCreateBotBotRegistrationBotUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *CreateBotCreateBotBotRegistrationBotUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateBotCreateBotBotRegistrationBotUser) __premarshalJSON() (*__premarshalCreateBotCreateBotBotRegistrationBotUser, error) {
+	var retval __premarshalCreateBotCreateBotBotRegistrationBotUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// CreateBotResponse is returned by CreateBot on success.
+type CreateBotResponse struct {
+	CreateBot *CreateBotCreateBotBotRegistration `json:"createBot"`
+}
+
+// GetCreateBot returns CreateBotResponse.CreateBot, and is useful for accessing the field via an interface.
+func (v *CreateBotResponse) GetCreateBot() *CreateBotCreateBotBotRegistration { return v.CreateBot }
+
+// CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration includes the requested fields of the GraphQL type IncomingWebhookRegistration.
+type CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration struct {
+	Webhook *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook `json:"webhook"`
+	Url     string                                                                                       `json:"url"`
+}
+
+// GetWebhook returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration.Webhook, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration) GetWebhook() *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook {
+	return v.Webhook
+}
+
+// GetUrl returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration.Url, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration) GetUrl() string {
+	return v.Url
+}
+
+// CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook includes the requested fields of the GraphQL type IncomingWebhook.
+type CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook struct {
+	IncomingWebhookFields `json:"-"`
+}
+
+// GetId returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.Id, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetId() string {
+	return v.IncomingWebhookFields.Id
+}
+
+// GetSpaceID returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.SpaceID, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetSpaceID() string {
+	return v.IncomingWebhookFields.SpaceID
+}
+
+// GetName returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.Name, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetName() string {
+	return v.IncomingWebhookFields.Name
+}
+
+// GetAvatarURL returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.AvatarURL, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetAvatarURL() *string {
+	return v.IncomingWebhookFields.AvatarURL
+}
+
+// GetBot returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.Bot, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetBot() *IncomingWebhookFieldsBotUser {
+	return v.IncomingWebhookFields.Bot
+}
+
+// GetCreatedAt returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetCreatedAt() time.Time {
+	return v.IncomingWebhookFields.CreatedAt
+}
+
+// GetRevokedAt returns CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook.RevokedAt, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) GetRevokedAt() *time.Time {
+	return v.IncomingWebhookFields.RevokedAt
+}
+
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IncomingWebhookFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Name string `json:"name"`
+
+	AvatarURL *string `json:"avatarURL"`
+
+	Bot *IncomingWebhookFieldsBotUser `json:"bot"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	RevokedAt *time.Time `json:"revokedAt"`
+}
+
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook) __premarshalJSON() (*__premarshalCreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook, error) {
+	var retval __premarshalCreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistrationWebhookIncomingWebhook
+
+	retval.Id = v.IncomingWebhookFields.Id
+	retval.SpaceID = v.IncomingWebhookFields.SpaceID
+	retval.Name = v.IncomingWebhookFields.Name
+	retval.AvatarURL = v.IncomingWebhookFields.AvatarURL
+	retval.Bot = v.IncomingWebhookFields.Bot
+	retval.CreatedAt = v.IncomingWebhookFields.CreatedAt
+	retval.RevokedAt = v.IncomingWebhookFields.RevokedAt
+	return &retval, nil
+}
+
+// CreateIncomingWebhookResponse is returned by CreateIncomingWebhook on success.
+type CreateIncomingWebhookResponse struct {
+	CreateIncomingWebhook *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration `json:"createIncomingWebhook"`
+}
+
+// GetCreateIncomingWebhook returns CreateIncomingWebhookResponse.CreateIncomingWebhook, and is useful for accessing the field via an interface.
+func (v *CreateIncomingWebhookResponse) GetCreateIncomingWebhook() *CreateIncomingWebhookCreateIncomingWebhookIncomingWebhookRegistration {
+	return v.CreateIncomingWebhook
+}
+
+// CreatePollCreatePollMessage includes the requested fields of the GraphQL type Message.
+type CreatePollCreatePollMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns CreatePollCreatePollMessage.Id, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns CreatePollCreatePollMessage.Content, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns CreatePollCreatePollMessage.Format, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns CreatePollCreatePollMessage.Html, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns CreatePollCreatePollMessage.User, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns CreatePollCreatePollMessage.Space, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetSpace() *MessageFieldsSpace { return v.MessageFields.Space }
+
+// GetCreatedAt returns CreatePollCreatePollMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns CreatePollCreatePollMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns CreatePollCreatePollMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns CreatePollCreatePollMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetFromBlockedUser() bool {
+	return v.MessageFields.FromBlockedUser
+}
+
+// GetClientMessageID returns CreatePollCreatePollMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns CreatePollCreatePollMessage.Seq, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns CreatePollCreatePollMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns CreatePollCreatePollMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns CreatePollCreatePollMessage.Poll, and is useful for accessing the field via an interface.
+func (v *CreatePollCreatePollMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *CreatePollCreatePollMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreatePollCreatePollMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreatePollCreatePollMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreatePollCreatePollMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *CreatePollCreatePollMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreatePollCreatePollMessage) __premarshalJSON() (*__premarshalCreatePollCreatePollMessage, error) {
+	var retval __premarshalCreatePollCreatePollMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// CreatePollResponse is returned by CreatePoll on success.
+type CreatePollResponse struct {
+	CreatePoll *CreatePollCreatePollMessage `json:"createPoll"`
+}
+
+// GetCreatePoll returns CreatePollResponse.CreatePoll, and is useful for accessing the field via an interface.
+func (v *CreatePollResponse) GetCreatePoll() *CreatePollCreatePollMessage { return v.CreatePoll }
+
+// CreateSpaceCreateSpace includes the requested fields of the GraphQL type Space.
+type CreateSpaceCreateSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns CreateSpaceCreateSpace.Id, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns CreateSpaceCreateSpace.Name, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns CreateSpaceCreateSpace.Description, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetDescription() *string { return v.SpaceFields.Description }
+
+// GetMemberCount returns CreateSpaceCreateSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetMemberCount() int { return v.SpaceFields.MemberCount }
+
+// GetLastActivityAt returns CreateSpaceCreateSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetLastActivityAt() *time.Time { return v.SpaceFields.LastActivityAt }
+
+// GetIsMember returns CreateSpaceCreateSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetIsMember() bool { return v.SpaceFields.IsMember }
+
+// GetLastReadSeq returns CreateSpaceCreateSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetLastReadSeq() int64 { return v.SpaceFields.LastReadSeq }
+
+// GetUnreadCount returns CreateSpaceCreateSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetUnreadCount() int { return v.SpaceFields.UnreadCount }
+
+// GetMessageTTL returns CreateSpaceCreateSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetMessageTTL() *int { return v.SpaceFields.MessageTTL }
+
+// GetRetentionPolicy returns CreateSpaceCreateSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *CreateSpaceCreateSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *CreateSpaceCreateSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateSpaceCreateSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateSpaceCreateSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateSpaceCreateSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *CreateSpaceCreateSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateSpaceCreateSpace) __premarshalJSON() (*__premarshalCreateSpaceCreateSpace, error) {
+	var retval __premarshalCreateSpaceCreateSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// CreateSpaceResponse is returned by CreateSpace on success.
+type CreateSpaceResponse struct {
+	CreateSpace *CreateSpaceCreateSpace `json:"createSpace"`
+}
+
+// GetCreateSpace returns CreateSpaceResponse.CreateSpace, and is useful for accessing the field via an interface.
+func (v *CreateSpaceResponse) GetCreateSpace() *CreateSpaceCreateSpace { return v.CreateSpace }
+
+// CreateWebhookCreateWebhookWebhookRegistration includes the requested fields of the GraphQL type WebhookRegistration.
+type CreateWebhookCreateWebhookWebhookRegistration struct {
+	Webhook *CreateWebhookCreateWebhookWebhookRegistrationWebhook `json:"webhook"`
+	Secret  string                                                `json:"secret"`
+}
+
+// GetWebhook returns CreateWebhookCreateWebhookWebhookRegistration.Webhook, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistration) GetWebhook() *CreateWebhookCreateWebhookWebhookRegistrationWebhook {
+	return v.Webhook
+}
+
+// GetSecret returns CreateWebhookCreateWebhookWebhookRegistration.Secret, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistration) GetSecret() string { return v.Secret }
+
+// CreateWebhookCreateWebhookWebhookRegistrationWebhook includes the requested fields of the GraphQL type Webhook.
+type CreateWebhookCreateWebhookWebhookRegistrationWebhook struct {
+	WebhookFields `json:"-"`
+}
+
+// GetId returns CreateWebhookCreateWebhookWebhookRegistrationWebhook.Id, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) GetId() string {
+	return v.WebhookFields.Id
+}
+
+// GetSpaceID returns CreateWebhookCreateWebhookWebhookRegistrationWebhook.SpaceID, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) GetSpaceID() string {
+	return v.WebhookFields.SpaceID
+}
+
+// GetUrl returns CreateWebhookCreateWebhookWebhookRegistrationWebhook.Url, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) GetUrl() string {
+	return v.WebhookFields.Url
+}
+
+// GetEvents returns CreateWebhookCreateWebhookWebhookRegistrationWebhook.Events, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) GetEvents() []WebhookEventType {
+	return v.WebhookFields.Events
+}
+
+// GetCreatedAt returns CreateWebhookCreateWebhookWebhookRegistrationWebhook.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) GetCreatedAt() time.Time {
+	return v.WebhookFields.CreatedAt
+}
+
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateWebhookCreateWebhookWebhookRegistrationWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateWebhookCreateWebhookWebhookRegistrationWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WebhookFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateWebhookCreateWebhookWebhookRegistrationWebhook struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Url string `json:"url"`
+
+	Events []WebhookEventType `json:"events"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateWebhookCreateWebhookWebhookRegistrationWebhook) __premarshalJSON() (*__premarshalCreateWebhookCreateWebhookWebhookRegistrationWebhook, error) {
+	var retval __premarshalCreateWebhookCreateWebhookWebhookRegistrationWebhook
+
+	retval.Id = v.WebhookFields.Id
+	retval.SpaceID = v.WebhookFields.SpaceID
+	retval.Url = v.WebhookFields.Url
+	retval.Events = v.WebhookFields.Events
+	retval.CreatedAt = v.WebhookFields.CreatedAt
+	return &retval, nil
+}
+
+// CreateWebhookResponse is returned by CreateWebhook on success.
+type CreateWebhookResponse struct {
+	CreateWebhook *CreateWebhookCreateWebhookWebhookRegistration `json:"createWebhook"`
+}
+
+// GetCreateWebhook returns CreateWebhookResponse.CreateWebhook, and is useful for accessing the field via an interface.
+func (v *CreateWebhookResponse) GetCreateWebhook() *CreateWebhookCreateWebhookWebhookRegistration {
+	return v.CreateWebhook
+}
+
+// DeleteSlashCommandResponse is returned by DeleteSlashCommand on success.
+type DeleteSlashCommandResponse struct {
+	DeleteSlashCommand bool `json:"deleteSlashCommand"`
+}
+
+// GetDeleteSlashCommand returns DeleteSlashCommandResponse.DeleteSlashCommand, and is useful for accessing the field via an interface.
+func (v *DeleteSlashCommandResponse) GetDeleteSlashCommand() bool { return v.DeleteSlashCommand }
+
+// DeleteWebhookResponse is returned by DeleteWebhook on success.
+type DeleteWebhookResponse struct {
+	DeleteWebhook bool `json:"deleteWebhook"`
+}
+
+// GetDeleteWebhook returns DeleteWebhookResponse.DeleteWebhook, and is useful for accessing the field via an interface.
+func (v *DeleteWebhookResponse) GetDeleteWebhook() bool { return v.DeleteWebhook }
+
+// IncomingWebhookFields includes the GraphQL fields of IncomingWebhook requested by the fragment IncomingWebhookFields.
+type IncomingWebhookFields struct {
+	Id        string                        `json:"id"`
+	SpaceID   string                        `json:"spaceID"`
+	Name      string                        `json:"name"`
+	AvatarURL *string                       `json:"avatarURL"`
+	Bot       *IncomingWebhookFieldsBotUser `json:"bot"`
+	CreatedAt time.Time                     `json:"createdAt"`
+	RevokedAt *time.Time                    `json:"revokedAt"`
+}
+
+// GetId returns IncomingWebhookFields.Id, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetId() string { return v.Id }
+
+// GetSpaceID returns IncomingWebhookFields.SpaceID, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetSpaceID() string { return v.SpaceID }
+
+// GetName returns IncomingWebhookFields.Name, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetName() string { return v.Name }
+
+// GetAvatarURL returns IncomingWebhookFields.AvatarURL, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetAvatarURL() *string { return v.AvatarURL }
+
+// GetBot returns IncomingWebhookFields.Bot, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetBot() *IncomingWebhookFieldsBotUser { return v.Bot }
+
+// GetCreatedAt returns IncomingWebhookFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetRevokedAt returns IncomingWebhookFields.RevokedAt, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFields) GetRevokedAt() *time.Time { return v.RevokedAt }
+
+// IncomingWebhookFieldsBotUser includes the requested fields of the GraphQL type User.
+type IncomingWebhookFieldsBotUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns IncomingWebhookFieldsBotUser.Id, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFieldsBotUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns IncomingWebhookFieldsBotUser.Email, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFieldsBotUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns IncomingWebhookFieldsBotUser.Name, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFieldsBotUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns IncomingWebhookFieldsBotUser.Bot, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFieldsBotUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns IncomingWebhookFieldsBotUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *IncomingWebhookFieldsBotUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *IncomingWebhookFieldsBotUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IncomingWebhookFieldsBotUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IncomingWebhookFieldsBotUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIncomingWebhookFieldsBotUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *IncomingWebhookFieldsBotUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IncomingWebhookFieldsBotUser) __premarshalJSON() (*__premarshalIncomingWebhookFieldsBotUser, error) {
+	var retval __premarshalIncomingWebhookFieldsBotUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// IncomingWebhooksIncomingWebhooksIncomingWebhook includes the requested fields of the GraphQL type IncomingWebhook.
+type IncomingWebhooksIncomingWebhooksIncomingWebhook struct {
+	IncomingWebhookFields `json:"-"`
+}
+
+// GetId returns IncomingWebhooksIncomingWebhooksIncomingWebhook.Id, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetId() string {
+	return v.IncomingWebhookFields.Id
+}
+
+// GetSpaceID returns IncomingWebhooksIncomingWebhooksIncomingWebhook.SpaceID, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetSpaceID() string {
+	return v.IncomingWebhookFields.SpaceID
+}
+
+// GetName returns IncomingWebhooksIncomingWebhooksIncomingWebhook.Name, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetName() string {
+	return v.IncomingWebhookFields.Name
+}
+
+// GetAvatarURL returns IncomingWebhooksIncomingWebhooksIncomingWebhook.AvatarURL, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetAvatarURL() *string {
+	return v.IncomingWebhookFields.AvatarURL
+}
+
+// GetBot returns IncomingWebhooksIncomingWebhooksIncomingWebhook.Bot, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetBot() *IncomingWebhookFieldsBotUser {
+	return v.IncomingWebhookFields.Bot
+}
+
+// GetCreatedAt returns IncomingWebhooksIncomingWebhooksIncomingWebhook.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetCreatedAt() time.Time {
+	return v.IncomingWebhookFields.CreatedAt
+}
+
+// GetRevokedAt returns IncomingWebhooksIncomingWebhooksIncomingWebhook.RevokedAt, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) GetRevokedAt() *time.Time {
+	return v.IncomingWebhookFields.RevokedAt
+}
+
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IncomingWebhooksIncomingWebhooksIncomingWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IncomingWebhooksIncomingWebhooksIncomingWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IncomingWebhookFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIncomingWebhooksIncomingWebhooksIncomingWebhook struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Name string `json:"name"`
+
+	AvatarURL *string `json:"avatarURL"`
+
+	Bot *IncomingWebhookFieldsBotUser `json:"bot"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	RevokedAt *time.Time `json:"revokedAt"`
+}
+
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IncomingWebhooksIncomingWebhooksIncomingWebhook) __premarshalJSON() (*__premarshalIncomingWebhooksIncomingWebhooksIncomingWebhook, error) {
+	var retval __premarshalIncomingWebhooksIncomingWebhooksIncomingWebhook
+
+	retval.Id = v.IncomingWebhookFields.Id
+	retval.SpaceID = v.IncomingWebhookFields.SpaceID
+	retval.Name = v.IncomingWebhookFields.Name
+	retval.AvatarURL = v.IncomingWebhookFields.AvatarURL
+	retval.Bot = v.IncomingWebhookFields.Bot
+	retval.CreatedAt = v.IncomingWebhookFields.CreatedAt
+	retval.RevokedAt = v.IncomingWebhookFields.RevokedAt
+	return &retval, nil
+}
+
+// IncomingWebhooksResponse is returned by IncomingWebhooks on success.
+type IncomingWebhooksResponse struct {
+	IncomingWebhooks []*IncomingWebhooksIncomingWebhooksIncomingWebhook `json:"incomingWebhooks"`
+}
+
+// GetIncomingWebhooks returns IncomingWebhooksResponse.IncomingWebhooks, and is useful for accessing the field via an interface.
+func (v *IncomingWebhooksResponse) GetIncomingWebhooks() []*IncomingWebhooksIncomingWebhooksIncomingWebhook {
+	return v.IncomingWebhooks
+}
+
+// JoinSpaceJoinSpace includes the requested fields of the GraphQL type Space.
+type JoinSpaceJoinSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns JoinSpaceJoinSpace.Id, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns JoinSpaceJoinSpace.Name, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns JoinSpaceJoinSpace.Description, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetDescription() *string { return v.SpaceFields.Description }
+
+// GetMemberCount returns JoinSpaceJoinSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetMemberCount() int { return v.SpaceFields.MemberCount }
+
+// GetLastActivityAt returns JoinSpaceJoinSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetLastActivityAt() *time.Time { return v.SpaceFields.LastActivityAt }
+
+// GetIsMember returns JoinSpaceJoinSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetIsMember() bool { return v.SpaceFields.IsMember }
+
+// GetLastReadSeq returns JoinSpaceJoinSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetLastReadSeq() int64 { return v.SpaceFields.LastReadSeq }
+
+// GetUnreadCount returns JoinSpaceJoinSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetUnreadCount() int { return v.SpaceFields.UnreadCount }
+
+// GetMessageTTL returns JoinSpaceJoinSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetMessageTTL() *int { return v.SpaceFields.MessageTTL }
+
+// GetRetentionPolicy returns JoinSpaceJoinSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *JoinSpaceJoinSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *JoinSpaceJoinSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*JoinSpaceJoinSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.JoinSpaceJoinSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalJoinSpaceJoinSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *JoinSpaceJoinSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *JoinSpaceJoinSpace) __premarshalJSON() (*__premarshalJoinSpaceJoinSpace, error) {
+	var retval __premarshalJoinSpaceJoinSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// JoinSpaceResponse is returned by JoinSpace on success.
+type JoinSpaceResponse struct {
+	JoinSpace *JoinSpaceJoinSpace `json:"joinSpace"`
+}
+
+// GetJoinSpace returns JoinSpaceResponse.JoinSpace, and is useful for accessing the field via an interface.
+func (v *JoinSpaceResponse) GetJoinSpace() *JoinSpaceJoinSpace { return v.JoinSpace }
+
+// LoginLoginAuthResponse includes the requested fields of the GraphQL type AuthResponse.
+type LoginLoginAuthResponse struct {
+	Token        string  `json:"token"`
+	RefreshToken *string `json:"refreshToken"`
+}
+
+// GetToken returns LoginLoginAuthResponse.Token, and is useful for accessing the field via an interface.
+func (v *LoginLoginAuthResponse) GetToken() string { return v.Token }
+
+// GetRefreshToken returns LoginLoginAuthResponse.RefreshToken, and is useful for accessing the field via an interface.
+func (v *LoginLoginAuthResponse) GetRefreshToken() *string { return v.RefreshToken }
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// GetEmail returns LoginRequest.Email, and is useful for accessing the field via an interface.
+func (v *LoginRequest) GetEmail() string { return v.Email }
+
+// GetPassword returns LoginRequest.Password, and is useful for accessing the field via an interface.
+func (v *LoginRequest) GetPassword() string { return v.Password }
+
+// LoginResponse is returned by Login on success.
+type LoginResponse struct {
+	Login *LoginLoginAuthResponse `json:"login"`
+}
+
+// GetLogin returns LoginResponse.Login, and is useful for accessing the field via an interface.
+func (v *LoginResponse) GetLogin() *LoginLoginAuthResponse { return v.Login }
+
+// MarkSpaceReadMarkSpaceReadSpace includes the requested fields of the GraphQL type Space.
+type MarkSpaceReadMarkSpaceReadSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns MarkSpaceReadMarkSpaceReadSpace.Id, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns MarkSpaceReadMarkSpaceReadSpace.Name, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns MarkSpaceReadMarkSpaceReadSpace.Description, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetDescription() *string { return v.SpaceFields.Description }
+
+// GetMemberCount returns MarkSpaceReadMarkSpaceReadSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetMemberCount() int { return v.SpaceFields.MemberCount }
+
+// GetLastActivityAt returns MarkSpaceReadMarkSpaceReadSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetLastActivityAt() *time.Time {
+	return v.SpaceFields.LastActivityAt
+}
+
+// GetIsMember returns MarkSpaceReadMarkSpaceReadSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetIsMember() bool { return v.SpaceFields.IsMember }
+
+// GetLastReadSeq returns MarkSpaceReadMarkSpaceReadSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetLastReadSeq() int64 { return v.SpaceFields.LastReadSeq }
+
+// GetUnreadCount returns MarkSpaceReadMarkSpaceReadSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetUnreadCount() int { return v.SpaceFields.UnreadCount }
+
+// GetMessageTTL returns MarkSpaceReadMarkSpaceReadSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetMessageTTL() *int { return v.SpaceFields.MessageTTL }
+
+// GetRetentionPolicy returns MarkSpaceReadMarkSpaceReadSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadMarkSpaceReadSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *MarkSpaceReadMarkSpaceReadSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MarkSpaceReadMarkSpaceReadSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MarkSpaceReadMarkSpaceReadSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMarkSpaceReadMarkSpaceReadSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *MarkSpaceReadMarkSpaceReadSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MarkSpaceReadMarkSpaceReadSpace) __premarshalJSON() (*__premarshalMarkSpaceReadMarkSpaceReadSpace, error) {
+	var retval __premarshalMarkSpaceReadMarkSpaceReadSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// MarkSpaceReadResponse is returned by MarkSpaceRead on success.
+type MarkSpaceReadResponse struct {
+	MarkSpaceRead *MarkSpaceReadMarkSpaceReadSpace `json:"markSpaceRead"`
+}
+
+// GetMarkSpaceRead returns MarkSpaceReadResponse.MarkSpaceRead, and is useful for accessing the field via an interface.
+func (v *MarkSpaceReadResponse) GetMarkSpaceRead() *MarkSpaceReadMarkSpaceReadSpace {
+	return v.MarkSpaceRead
+}
+
+// MeResponse is returned by Me on success.
+type MeResponse struct {
+	User *MeUser `json:"user"`
+}
+
+// GetUser returns MeResponse.User, and is useful for accessing the field via an interface.
+func (v *MeResponse) GetUser() *MeUser { return v.User }
+
+// MeUser includes the requested fields of the GraphQL type User.
+type MeUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns MeUser.Id, and is useful for accessing the field via an interface.
+func (v *MeUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns MeUser.Email, and is useful for accessing the field via an interface.
+func (v *MeUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns MeUser.Name, and is useful for accessing the field via an interface.
+func (v *MeUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns MeUser.Bot, and is useful for accessing the field via an interface.
+func (v *MeUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns MeUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *MeUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *MeUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MeUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MeUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMeUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *MeUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MeUser) __premarshalJSON() (*__premarshalMeUser, error) {
+	var retval __premarshalMeUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// MessageFields includes the GraphQL fields of Message requested by the fragment MessageFields.
+type MessageFields struct {
+	Id              string                                  `json:"id"`
+	Content         string                                  `json:"content"`
+	Format          MessageFormat                           `json:"format"`
+	Html            string                                  `json:"html"`
+	User            *MessageFieldsUser                      `json:"user"`
+	Space           *MessageFieldsSpace                     `json:"space"`
+	CreatedAt       time.Time                               `json:"createdAt"`
+	ExpiresAt       *time.Time                              `json:"expiresAt"`
+	Ephemeral       bool                                    `json:"ephemeral"`
+	FromBlockedUser bool                                    `json:"fromBlockedUser"`
+	ClientMessageID *string                                 `json:"clientMessageID"`
+	Seq             *int64                                  `json:"seq"`
+	Attachments     []*MessageFieldsAttachmentsAttachment   `json:"attachments"`
+	LinkPreviews    []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+	Poll            *MessageFieldsPoll                      `json:"poll"`
+}
+
+// GetId returns MessageFields.Id, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetId() string { return v.Id }
+
+// GetContent returns MessageFields.Content, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetContent() string { return v.Content }
+
+// GetFormat returns MessageFields.Format, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetFormat() MessageFormat { return v.Format }
+
+// GetHtml returns MessageFields.Html, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetHtml() string { return v.Html }
+
+// GetUser returns MessageFields.User, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetUser() *MessageFieldsUser { return v.User }
+
+// GetSpace returns MessageFields.Space, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetSpace() *MessageFieldsSpace { return v.Space }
+
+// GetCreatedAt returns MessageFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetExpiresAt returns MessageFields.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetExpiresAt() *time.Time { return v.ExpiresAt }
+
+// GetEphemeral returns MessageFields.Ephemeral, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetEphemeral() bool { return v.Ephemeral }
+
+// GetFromBlockedUser returns MessageFields.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetFromBlockedUser() bool { return v.FromBlockedUser }
+
+// GetClientMessageID returns MessageFields.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetClientMessageID() *string { return v.ClientMessageID }
+
+// GetSeq returns MessageFields.Seq, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetSeq() *int64 { return v.Seq }
+
+// GetAttachments returns MessageFields.Attachments, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetAttachments() []*MessageFieldsAttachmentsAttachment { return v.Attachments }
+
+// GetLinkPreviews returns MessageFields.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.LinkPreviews
+}
+
+// GetPoll returns MessageFields.Poll, and is useful for accessing the field via an interface.
+func (v *MessageFields) GetPoll() *MessageFieldsPoll { return v.Poll }
+
+// MessageFieldsAttachmentsAttachment includes the requested fields of the GraphQL type Attachment.
+type MessageFieldsAttachmentsAttachment struct {
+	AttachmentFields `json:"-"`
+}
+
+// GetId returns MessageFieldsAttachmentsAttachment.Id, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetId() string { return v.AttachmentFields.Id }
+
+// GetFilename returns MessageFieldsAttachmentsAttachment.Filename, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetFilename() string { return v.AttachmentFields.Filename }
+
+// GetContentType returns MessageFieldsAttachmentsAttachment.ContentType, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetContentType() string {
+	return v.AttachmentFields.ContentType
+}
+
+// GetSize returns MessageFieldsAttachmentsAttachment.Size, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetSize() int64 { return v.AttachmentFields.Size }
+
+// GetUrl returns MessageFieldsAttachmentsAttachment.Url, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetUrl() *string { return v.AttachmentFields.Url }
+
+// GetStatus returns MessageFieldsAttachmentsAttachment.Status, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetStatus() AttachmentStatus {
+	return v.AttachmentFields.Status
+}
+
+// GetWidth returns MessageFieldsAttachmentsAttachment.Width, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetWidth() *int { return v.AttachmentFields.Width }
+
+// GetHeight returns MessageFieldsAttachmentsAttachment.Height, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetHeight() *int { return v.AttachmentFields.Height }
+
+// GetBlurhash returns MessageFieldsAttachmentsAttachment.Blurhash, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetBlurhash() *string {
+	return v.AttachmentFields.Blurhash
+}
+
+// GetThumbnails returns MessageFieldsAttachmentsAttachment.Thumbnails, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetThumbnails() []*AttachmentFieldsThumbnailsAttachmentThumbnail {
+	return v.AttachmentFields.Thumbnails
+}
+
+// GetCreatedAt returns MessageFieldsAttachmentsAttachment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MessageFieldsAttachmentsAttachment) GetCreatedAt() time.Time {
+	return v.AttachmentFields.CreatedAt
+}
+
+func (v *MessageFieldsAttachmentsAttachment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MessageFieldsAttachmentsAttachment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MessageFieldsAttachmentsAttachment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AttachmentFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMessageFieldsAttachmentsAttachment struct {
+	Id string `json:"id"`
+
+	Filename string `json:"filename"`
+
+	ContentType string `json:"contentType"`
+
+	Size int64 `json:"size"`
+
+	Url *string `json:"url"`
+
+	Status AttachmentStatus `json:"status"`
+
+	Width *int `json:"width"`
+
+	Height *int `json:"height"`
+
+	Blurhash *string `json:"blurhash"`
+
+	Thumbnails []*AttachmentFieldsThumbnailsAttachmentThumbnail `json:"thumbnails"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *MessageFieldsAttachmentsAttachment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MessageFieldsAttachmentsAttachment) __premarshalJSON() (*__premarshalMessageFieldsAttachmentsAttachment, error) {
+	var retval __premarshalMessageFieldsAttachmentsAttachment
+
+	retval.Id = v.AttachmentFields.Id
+	retval.Filename = v.AttachmentFields.Filename
+	retval.ContentType = v.AttachmentFields.ContentType
+	retval.Size = v.AttachmentFields.Size
+	retval.Url = v.AttachmentFields.Url
+	retval.Status = v.AttachmentFields.Status
+	retval.Width = v.AttachmentFields.Width
+	retval.Height = v.AttachmentFields.Height
+	retval.Blurhash = v.AttachmentFields.Blurhash
+	retval.Thumbnails = v.AttachmentFields.Thumbnails
+	retval.CreatedAt = v.AttachmentFields.CreatedAt
+	return &retval, nil
+}
+
+// MessageFieldsLinkPreviewsLinkPreview includes the requested fields of the GraphQL type LinkPreview.
+type MessageFieldsLinkPreviewsLinkPreview struct {
+	Url         string  `json:"url"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	ImageURL    *string `json:"imageURL"`
+	SiteName    *string `json:"siteName"`
+}
+
+// GetUrl returns MessageFieldsLinkPreviewsLinkPreview.Url, and is useful for accessing the field via an interface.
+func (v *MessageFieldsLinkPreviewsLinkPreview) GetUrl() string { return v.Url }
+
+// GetTitle returns MessageFieldsLinkPreviewsLinkPreview.Title, and is useful for accessing the field via an interface.
+func (v *MessageFieldsLinkPreviewsLinkPreview) GetTitle() *string { return v.Title }
+
+// GetDescription returns MessageFieldsLinkPreviewsLinkPreview.Description, and is useful for accessing the field via an interface.
+func (v *MessageFieldsLinkPreviewsLinkPreview) GetDescription() *string { return v.Description }
+
+// GetImageURL returns MessageFieldsLinkPreviewsLinkPreview.ImageURL, and is useful for accessing the field via an interface.
+func (v *MessageFieldsLinkPreviewsLinkPreview) GetImageURL() *string { return v.ImageURL }
+
+// GetSiteName returns MessageFieldsLinkPreviewsLinkPreview.SiteName, and is useful for accessing the field via an interface.
+func (v *MessageFieldsLinkPreviewsLinkPreview) GetSiteName() *string { return v.SiteName }
+
+// MessageFieldsPoll includes the requested fields of the GraphQL type Poll.
+type MessageFieldsPoll struct {
+	PollFields `json:"-"`
+}
+
+// GetId returns MessageFieldsPoll.Id, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetId() string { return v.PollFields.Id }
+
+// GetQuestion returns MessageFieldsPoll.Question, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetQuestion() string { return v.PollFields.Question }
+
+// GetOptions returns MessageFieldsPoll.Options, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetOptions() []*PollFieldsOptionsPollOption { return v.PollFields.Options }
+
+// GetMultiChoice returns MessageFieldsPoll.MultiChoice, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetMultiChoice() bool { return v.PollFields.MultiChoice }
+
+// GetAnonymous returns MessageFieldsPoll.Anonymous, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetAnonymous() bool { return v.PollFields.Anonymous }
+
+// GetClosesAt returns MessageFieldsPoll.ClosesAt, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetClosesAt() *time.Time { return v.PollFields.ClosesAt }
+
+// GetClosed returns MessageFieldsPoll.Closed, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetClosed() bool { return v.PollFields.Closed }
+
+// GetTotalVoters returns MessageFieldsPoll.TotalVoters, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetTotalVoters() int { return v.PollFields.TotalVoters }
+
+// GetMyVotes returns MessageFieldsPoll.MyVotes, and is useful for accessing the field via an interface.
+func (v *MessageFieldsPoll) GetMyVotes() []string { return v.PollFields.MyVotes }
+
+func (v *MessageFieldsPoll) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MessageFieldsPoll
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MessageFieldsPoll = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PollFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMessageFieldsPoll struct {
+	Id string `json:"id"`
+
+	Question string `json:"question"`
+
+	Options []*PollFieldsOptionsPollOption `json:"options"`
+
+	MultiChoice bool `json:"multiChoice"`
+
+	Anonymous bool `json:"anonymous"`
+
+	ClosesAt *time.Time `json:"closesAt"`
+
+	Closed bool `json:"closed"`
+
+	TotalVoters int `json:"totalVoters"`
+
+	MyVotes []string `json:"myVotes"`
+}
+
+func (v *MessageFieldsPoll) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MessageFieldsPoll) __premarshalJSON() (*__premarshalMessageFieldsPoll, error) {
+	var retval __premarshalMessageFieldsPoll
+
+	retval.Id = v.PollFields.Id
+	retval.Question = v.PollFields.Question
+	retval.Options = v.PollFields.Options
+	retval.MultiChoice = v.PollFields.MultiChoice
+	retval.Anonymous = v.PollFields.Anonymous
+	retval.ClosesAt = v.PollFields.ClosesAt
+	retval.Closed = v.PollFields.Closed
+	retval.TotalVoters = v.PollFields.TotalVoters
+	retval.MyVotes = v.PollFields.MyVotes
+	return &retval, nil
+}
+
+// MessageFieldsSpace includes the requested fields of the GraphQL type Space.
+type MessageFieldsSpace struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns MessageFieldsSpace.Id, and is useful for accessing the field via an interface.
+func (v *MessageFieldsSpace) GetId() string { return v.Id }
+
+// GetName returns MessageFieldsSpace.Name, and is useful for accessing the field via an interface.
+func (v *MessageFieldsSpace) GetName() string { return v.Name }
+
+// MessageFieldsUser includes the requested fields of the GraphQL type User.
+type MessageFieldsUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns MessageFieldsUser.Id, and is useful for accessing the field via an interface.
+func (v *MessageFieldsUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns MessageFieldsUser.Email, and is useful for accessing the field via an interface.
+func (v *MessageFieldsUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns MessageFieldsUser.Name, and is useful for accessing the field via an interface.
+func (v *MessageFieldsUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns MessageFieldsUser.Bot, and is useful for accessing the field via an interface.
+func (v *MessageFieldsUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns MessageFieldsUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *MessageFieldsUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *MessageFieldsUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MessageFieldsUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MessageFieldsUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMessageFieldsUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *MessageFieldsUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MessageFieldsUser) __premarshalJSON() (*__premarshalMessageFieldsUser, error) {
+	var retval __premarshalMessageFieldsUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+type MessageFormat string
+
+const (
+	MessageFormatPlain    MessageFormat = "PLAIN"
+	MessageFormatMarkdown MessageFormat = "MARKDOWN"
+)
+
+var AllMessageFormat = []MessageFormat{
+	MessageFormatPlain,
+	MessageFormatMarkdown,
+}
+
+type MessageSearchFilter struct {
+	SpaceID       *string    `json:"spaceID"`
+	FromUserID    *string    `json:"fromUserID"`
+	Before        *time.Time `json:"before"`
+	After         *time.Time `json:"after"`
+	HasAttachment *bool      `json:"hasAttachment"`
+}
+
+// GetSpaceID returns MessageSearchFilter.SpaceID, and is useful for accessing the field via an interface.
+func (v *MessageSearchFilter) GetSpaceID() *string { return v.SpaceID }
+
+// GetFromUserID returns MessageSearchFilter.FromUserID, and is useful for accessing the field via an interface.
+func (v *MessageSearchFilter) GetFromUserID() *string { return v.FromUserID }
+
+// GetBefore returns MessageSearchFilter.Before, and is useful for accessing the field via an interface.
+func (v *MessageSearchFilter) GetBefore() *time.Time { return v.Before }
+
+// GetAfter returns MessageSearchFilter.After, and is useful for accessing the field via an interface.
+func (v *MessageSearchFilter) GetAfter() *time.Time { return v.After }
+
+// GetHasAttachment returns MessageSearchFilter.HasAttachment, and is useful for accessing the field via an interface.
+func (v *MessageSearchFilter) GetHasAttachment() *bool { return v.HasAttachment }
+
+// MessageSentMessageSentMessage includes the requested fields of the GraphQL type Message.
+type MessageSentMessageSentMessage struct {
+	MessageFields `json:"-"`
+	Cursor        *string `json:"cursor"`
+}
+
+// GetCursor returns MessageSentMessageSentMessage.Cursor, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetCursor() *string { return v.Cursor }
+
+// GetId returns MessageSentMessageSentMessage.Id, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns MessageSentMessageSentMessage.Content, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns MessageSentMessageSentMessage.Format, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns MessageSentMessageSentMessage.Html, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns MessageSentMessageSentMessage.User, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns MessageSentMessageSentMessage.Space, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetSpace() *MessageFieldsSpace { return v.MessageFields.Space }
+
+// GetCreatedAt returns MessageSentMessageSentMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns MessageSentMessageSentMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns MessageSentMessageSentMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns MessageSentMessageSentMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetFromBlockedUser() bool {
+	return v.MessageFields.FromBlockedUser
+}
+
+// GetClientMessageID returns MessageSentMessageSentMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns MessageSentMessageSentMessage.Seq, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns MessageSentMessageSentMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns MessageSentMessageSentMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns MessageSentMessageSentMessage.Poll, and is useful for accessing the field via an interface.
+func (v *MessageSentMessageSentMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *MessageSentMessageSentMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MessageSentMessageSentMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MessageSentMessageSentMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMessageSentMessageSentMessage struct {
+	Cursor *string `json:"cursor"`
+
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *MessageSentMessageSentMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MessageSentMessageSentMessage) __premarshalJSON() (*__premarshalMessageSentMessageSentMessage, error) {
+	var retval __premarshalMessageSentMessageSentMessage
+
+	retval.Cursor = v.Cursor
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// MessageSentResponse is returned by MessageSent on success.
+type MessageSentResponse struct {
+	MessageSent *MessageSentMessageSentMessage `json:"messageSent"`
+}
+
+// GetMessageSent returns MessageSentResponse.MessageSent, and is useful for accessing the field via an interface.
+func (v *MessageSentResponse) GetMessageSent() *MessageSentMessageSentMessage { return v.MessageSent }
+
+// MessagesMessagesMessage includes the requested fields of the GraphQL type Message.
+type MessagesMessagesMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns MessagesMessagesMessage.Id, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns MessagesMessagesMessage.Content, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns MessagesMessagesMessage.Format, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns MessagesMessagesMessage.Html, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns MessagesMessagesMessage.User, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns MessagesMessagesMessage.Space, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetSpace() *MessageFieldsSpace { return v.MessageFields.Space }
+
+// GetCreatedAt returns MessagesMessagesMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns MessagesMessagesMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns MessagesMessagesMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns MessagesMessagesMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetFromBlockedUser() bool { return v.MessageFields.FromBlockedUser }
+
+// GetClientMessageID returns MessagesMessagesMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns MessagesMessagesMessage.Seq, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns MessagesMessagesMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns MessagesMessagesMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns MessagesMessagesMessage.Poll, and is useful for accessing the field via an interface.
+func (v *MessagesMessagesMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *MessagesMessagesMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MessagesMessagesMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MessagesMessagesMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMessagesMessagesMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *MessagesMessagesMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MessagesMessagesMessage) __premarshalJSON() (*__premarshalMessagesMessagesMessage, error) {
+	var retval __premarshalMessagesMessagesMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// MessagesResponse is returned by Messages on success.
+type MessagesResponse struct {
+	Messages []*MessagesMessagesMessage `json:"messages"`
+}
+
+// GetMessages returns MessagesResponse.Messages, and is useful for accessing the field via an interface.
+func (v *MessagesResponse) GetMessages() []*MessagesMessagesMessage { return v.Messages }
+
+// MySpacesMySpacesSpace includes the requested fields of the GraphQL type Space.
+type MySpacesMySpacesSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns MySpacesMySpacesSpace.Id, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns MySpacesMySpacesSpace.Name, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns MySpacesMySpacesSpace.Description, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetDescription() *string { return v.SpaceFields.Description }
+
+// GetMemberCount returns MySpacesMySpacesSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetMemberCount() int { return v.SpaceFields.MemberCount }
+
+// GetLastActivityAt returns MySpacesMySpacesSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetLastActivityAt() *time.Time { return v.SpaceFields.LastActivityAt }
+
+// GetIsMember returns MySpacesMySpacesSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetIsMember() bool { return v.SpaceFields.IsMember }
+
+// GetLastReadSeq returns MySpacesMySpacesSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetLastReadSeq() int64 { return v.SpaceFields.LastReadSeq }
+
+// GetUnreadCount returns MySpacesMySpacesSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetUnreadCount() int { return v.SpaceFields.UnreadCount }
+
+// GetMessageTTL returns MySpacesMySpacesSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetMessageTTL() *int { return v.SpaceFields.MessageTTL }
+
+// GetRetentionPolicy returns MySpacesMySpacesSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *MySpacesMySpacesSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *MySpacesMySpacesSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MySpacesMySpacesSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MySpacesMySpacesSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMySpacesMySpacesSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *MySpacesMySpacesSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MySpacesMySpacesSpace) __premarshalJSON() (*__premarshalMySpacesMySpacesSpace, error) {
+	var retval __premarshalMySpacesMySpacesSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// MySpacesResponse is returned by MySpaces on success.
+type MySpacesResponse struct {
+	MySpaces []*MySpacesMySpacesSpace `json:"mySpaces"`
+}
+
+// GetMySpaces returns MySpacesResponse.MySpaces, and is useful for accessing the field via an interface.
+func (v *MySpacesResponse) GetMySpaces() []*MySpacesMySpacesSpace { return v.MySpaces }
+
+// PageInfoFields includes the GraphQL fields of PageInfo requested by the fragment PageInfoFields.
+type PageInfoFields struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+// GetEndCursor returns PageInfoFields.EndCursor, and is useful for accessing the field via an interface.
+func (v *PageInfoFields) GetEndCursor() *string { return v.EndCursor }
+
+// GetHasNextPage returns PageInfoFields.HasNextPage, and is useful for accessing the field via an interface.
+func (v *PageInfoFields) GetHasNextPage() bool { return v.HasNextPage }
+
+// PinMessagePinMessage includes the requested fields of the GraphQL type Message.
+type PinMessagePinMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns PinMessagePinMessage.Id, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns PinMessagePinMessage.Content, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns PinMessagePinMessage.Format, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns PinMessagePinMessage.Html, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns PinMessagePinMessage.User, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns PinMessagePinMessage.Space, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetSpace() *MessageFieldsSpace { return v.MessageFields.Space }
+
+// GetCreatedAt returns PinMessagePinMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns PinMessagePinMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns PinMessagePinMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns PinMessagePinMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetFromBlockedUser() bool { return v.MessageFields.FromBlockedUser }
+
+// GetClientMessageID returns PinMessagePinMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetClientMessageID() *string { return v.MessageFields.ClientMessageID }
+
+// GetSeq returns PinMessagePinMessage.Seq, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns PinMessagePinMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns PinMessagePinMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns PinMessagePinMessage.Poll, and is useful for accessing the field via an interface.
+func (v *PinMessagePinMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *PinMessagePinMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PinMessagePinMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PinMessagePinMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPinMessagePinMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *PinMessagePinMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PinMessagePinMessage) __premarshalJSON() (*__premarshalPinMessagePinMessage, error) {
+	var retval __premarshalPinMessagePinMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// PinMessageResponse is returned by PinMessage on success.
+type PinMessageResponse struct {
+	PinMessage *PinMessagePinMessage `json:"pinMessage"`
+}
+
+// GetPinMessage returns PinMessageResponse.PinMessage, and is useful for accessing the field via an interface.
+func (v *PinMessageResponse) GetPinMessage() *PinMessagePinMessage { return v.PinMessage }
+
+// PollFields includes the GraphQL fields of Poll requested by the fragment PollFields.
+type PollFields struct {
+	Id          string                         `json:"id"`
+	Question    string                         `json:"question"`
+	Options     []*PollFieldsOptionsPollOption `json:"options"`
+	MultiChoice bool                           `json:"multiChoice"`
+	Anonymous   bool                           `json:"anonymous"`
+	ClosesAt    *time.Time                     `json:"closesAt"`
+	Closed      bool                           `json:"closed"`
+	TotalVoters int                            `json:"totalVoters"`
+	MyVotes     []string                       `json:"myVotes"`
+}
+
+// GetId returns PollFields.Id, and is useful for accessing the field via an interface.
+func (v *PollFields) GetId() string { return v.Id }
+
+// GetQuestion returns PollFields.Question, and is useful for accessing the field via an interface.
+func (v *PollFields) GetQuestion() string { return v.Question }
+
+// GetOptions returns PollFields.Options, and is useful for accessing the field via an interface.
+func (v *PollFields) GetOptions() []*PollFieldsOptionsPollOption { return v.Options }
+
+// GetMultiChoice returns PollFields.MultiChoice, and is useful for accessing the field via an interface.
+func (v *PollFields) GetMultiChoice() bool { return v.MultiChoice }
+
+// GetAnonymous returns PollFields.Anonymous, and is useful for accessing the field via an interface.
+func (v *PollFields) GetAnonymous() bool { return v.Anonymous }
+
+// GetClosesAt returns PollFields.ClosesAt, and is useful for accessing the field via an interface.
+func (v *PollFields) GetClosesAt() *time.Time { return v.ClosesAt }
+
+// GetClosed returns PollFields.Closed, and is useful for accessing the field via an interface.
+func (v *PollFields) GetClosed() bool { return v.Closed }
+
+// GetTotalVoters returns PollFields.TotalVoters, and is useful for accessing the field via an interface.
+func (v *PollFields) GetTotalVoters() int { return v.TotalVoters }
+
+// GetMyVotes returns PollFields.MyVotes, and is useful for accessing the field via an interface.
+func (v *PollFields) GetMyVotes() []string { return v.MyVotes }
+
+// PollFieldsOptionsPollOption includes the requested fields of the GraphQL type PollOption.
+type PollFieldsOptionsPollOption struct {
+	Id        string                                   `json:"id"`
+	Text      string                                   `json:"text"`
+	VoteCount int                                      `json:"voteCount"`
+	Voters    []*PollFieldsOptionsPollOptionVotersUser `json:"voters"`
+}
+
+// GetId returns PollFieldsOptionsPollOption.Id, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOption) GetId() string { return v.Id }
+
+// GetText returns PollFieldsOptionsPollOption.Text, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOption) GetText() string { return v.Text }
+
+// GetVoteCount returns PollFieldsOptionsPollOption.VoteCount, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOption) GetVoteCount() int { return v.VoteCount }
+
+// GetVoters returns PollFieldsOptionsPollOption.Voters, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOption) GetVoters() []*PollFieldsOptionsPollOptionVotersUser {
+	return v.Voters
+}
+
+// PollFieldsOptionsPollOptionVotersUser includes the requested fields of the GraphQL type User.
+type PollFieldsOptionsPollOptionVotersUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns PollFieldsOptionsPollOptionVotersUser.Id, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOptionVotersUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns PollFieldsOptionsPollOptionVotersUser.Email, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOptionVotersUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns PollFieldsOptionsPollOptionVotersUser.Name, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOptionVotersUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns PollFieldsOptionsPollOptionVotersUser.Bot, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOptionVotersUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns PollFieldsOptionsPollOptionVotersUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *PollFieldsOptionsPollOptionVotersUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *PollFieldsOptionsPollOptionVotersUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PollFieldsOptionsPollOptionVotersUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PollFieldsOptionsPollOptionVotersUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPollFieldsOptionsPollOptionVotersUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *PollFieldsOptionsPollOptionVotersUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PollFieldsOptionsPollOptionVotersUser) __premarshalJSON() (*__premarshalPollFieldsOptionsPollOptionVotersUser, error) {
+	var retval __premarshalPollFieldsOptionsPollOptionVotersUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+type PollRequest struct {
+	SpaceID     string     `json:"spaceID"`
+	Question    string     `json:"question"`
+	Options     []string   `json:"options"`
+	MultiChoice *bool      `json:"multiChoice"`
+	Anonymous   *bool      `json:"anonymous"`
+	ClosesAt    *time.Time `json:"closesAt"`
+}
+
+// GetSpaceID returns PollRequest.SpaceID, and is useful for accessing the field via an interface.
+func (v *PollRequest) GetSpaceID() string { return v.SpaceID }
+
+// GetQuestion returns PollRequest.Question, and is useful for accessing the field via an interface.
+func (v *PollRequest) GetQuestion() string { return v.Question }
+
+// GetOptions returns PollRequest.Options, and is useful for accessing the field via an interface.
+func (v *PollRequest) GetOptions() []string { return v.Options }
+
+// GetMultiChoice returns PollRequest.MultiChoice, and is useful for accessing the field via an interface.
+func (v *PollRequest) GetMultiChoice() *bool { return v.MultiChoice }
+
+// GetAnonymous returns PollRequest.Anonymous, and is useful for accessing the field via an interface.
+func (v *PollRequest) GetAnonymous() *bool { return v.Anonymous }
+
+// GetClosesAt returns PollRequest.ClosesAt, and is useful for accessing the field via an interface.
+func (v *PollRequest) GetClosesAt() *time.Time { return v.ClosesAt }
+
+// RedeliverWebhookRedeliverWebhookWebhookDelivery includes the requested fields of the GraphQL type WebhookDelivery.
+type RedeliverWebhookRedeliverWebhookWebhookDelivery struct {
+	WebhookDeliveryFields `json:"-"`
+}
+
+// GetId returns RedeliverWebhookRedeliverWebhookWebhookDelivery.Id, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetId() string {
+	return v.WebhookDeliveryFields.Id
+}
+
+// GetWebhookID returns RedeliverWebhookRedeliverWebhookWebhookDelivery.WebhookID, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetWebhookID() string {
+	return v.WebhookDeliveryFields.WebhookID
+}
+
+// GetEventType returns RedeliverWebhookRedeliverWebhookWebhookDelivery.EventType, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetEventType() WebhookEventType {
+	return v.WebhookDeliveryFields.EventType
+}
+
+// GetPayload returns RedeliverWebhookRedeliverWebhookWebhookDelivery.Payload, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetPayload() string {
+	return v.WebhookDeliveryFields.Payload
+}
+
+// GetStatus returns RedeliverWebhookRedeliverWebhookWebhookDelivery.Status, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return v.WebhookDeliveryFields.Status
+}
+
+// GetAttempts returns RedeliverWebhookRedeliverWebhookWebhookDelivery.Attempts, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetAttempts() int {
+	return v.WebhookDeliveryFields.Attempts
+}
+
+// GetNextAttemptAt returns RedeliverWebhookRedeliverWebhookWebhookDelivery.NextAttemptAt, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetNextAttemptAt() *time.Time {
+	return v.WebhookDeliveryFields.NextAttemptAt
+}
+
+// GetResponseStatus returns RedeliverWebhookRedeliverWebhookWebhookDelivery.ResponseStatus, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetResponseStatus() *int {
+	return v.WebhookDeliveryFields.ResponseStatus
+}
+
+// GetError returns RedeliverWebhookRedeliverWebhookWebhookDelivery.Error, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetError() *string {
+	return v.WebhookDeliveryFields.Error
+}
+
+// GetDeliveredAt returns RedeliverWebhookRedeliverWebhookWebhookDelivery.DeliveredAt, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetDeliveredAt() *time.Time {
+	return v.WebhookDeliveryFields.DeliveredAt
+}
+
+// GetRedeliveryOf returns RedeliverWebhookRedeliverWebhookWebhookDelivery.RedeliveryOf, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetRedeliveryOf() *string {
+	return v.WebhookDeliveryFields.RedeliveryOf
+}
+
+// GetCreatedAt returns RedeliverWebhookRedeliverWebhookWebhookDelivery.CreatedAt, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) GetCreatedAt() time.Time {
+	return v.WebhookDeliveryFields.CreatedAt
+}
+
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*RedeliverWebhookRedeliverWebhookWebhookDelivery
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.RedeliverWebhookRedeliverWebhookWebhookDelivery = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WebhookDeliveryFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalRedeliverWebhookRedeliverWebhookWebhookDelivery struct {
+	Id string `json:"id"`
+
+	WebhookID string `json:"webhookID"`
+
+	EventType WebhookEventType `json:"eventType"`
+
+	Payload string `json:"payload"`
+
+	Status WebhookDeliveryStatus `json:"status"`
+
+	Attempts int `json:"attempts"`
+
+	NextAttemptAt *time.Time `json:"nextAttemptAt"`
+
+	ResponseStatus *int `json:"responseStatus"`
+
+	Error *string `json:"error"`
+
+	DeliveredAt *time.Time `json:"deliveredAt"`
+
+	RedeliveryOf *string `json:"redeliveryOf"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *RedeliverWebhookRedeliverWebhookWebhookDelivery) __premarshalJSON() (*__premarshalRedeliverWebhookRedeliverWebhookWebhookDelivery, error) {
+	var retval __premarshalRedeliverWebhookRedeliverWebhookWebhookDelivery
+
+	retval.Id = v.WebhookDeliveryFields.Id
+	retval.WebhookID = v.WebhookDeliveryFields.WebhookID
+	retval.EventType = v.WebhookDeliveryFields.EventType
+	retval.Payload = v.WebhookDeliveryFields.Payload
+	retval.Status = v.WebhookDeliveryFields.Status
+	retval.Attempts = v.WebhookDeliveryFields.Attempts
+	retval.NextAttemptAt = v.WebhookDeliveryFields.NextAttemptAt
+	retval.ResponseStatus = v.WebhookDeliveryFields.ResponseStatus
+	retval.Error = v.WebhookDeliveryFields.Error
+	retval.DeliveredAt = v.WebhookDeliveryFields.DeliveredAt
+	retval.RedeliveryOf = v.WebhookDeliveryFields.RedeliveryOf
+	retval.CreatedAt = v.WebhookDeliveryFields.CreatedAt
+	return &retval, nil
+}
+
+// RedeliverWebhookResponse is returned by RedeliverWebhook on success.
+type RedeliverWebhookResponse struct {
+	RedeliverWebhook *RedeliverWebhookRedeliverWebhookWebhookDelivery `json:"redeliverWebhook"`
+}
+
+// GetRedeliverWebhook returns RedeliverWebhookResponse.RedeliverWebhook, and is useful for accessing the field via an interface.
+func (v *RedeliverWebhookResponse) GetRedeliverWebhook() *RedeliverWebhookRedeliverWebhookWebhookDelivery {
+	return v.RedeliverWebhook
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetRefreshToken returns RefreshRequest.RefreshToken, and is useful for accessing the field via an interface.
+func (v *RefreshRequest) GetRefreshToken() string { return v.RefreshToken }
+
+// RefreshTokenRefreshTokenAuthResponse includes the requested fields of the GraphQL type AuthResponse.
+type RefreshTokenRefreshTokenAuthResponse struct {
+	Token        string  `json:"token"`
+	RefreshToken *string `json:"refreshToken"`
+}
+
+// GetToken returns RefreshTokenRefreshTokenAuthResponse.Token, and is useful for accessing the field via an interface.
+func (v *RefreshTokenRefreshTokenAuthResponse) GetToken() string { return v.Token }
+
+// GetRefreshToken returns RefreshTokenRefreshTokenAuthResponse.RefreshToken, and is useful for accessing the field via an interface.
+func (v *RefreshTokenRefreshTokenAuthResponse) GetRefreshToken() *string { return v.RefreshToken }
+
+// RefreshTokenResponse is returned by RefreshToken on success.
+type RefreshTokenResponse struct {
+	RefreshToken *RefreshTokenRefreshTokenAuthResponse `json:"refreshToken"`
+}
+
+// GetRefreshToken returns RefreshTokenResponse.RefreshToken, and is useful for accessing the field via an interface.
+func (v *RefreshTokenResponse) GetRefreshToken() *RefreshTokenRefreshTokenAuthResponse {
+	return v.RefreshToken
+}
+
+// RegisterRegisterAuthResponse includes the requested fields of the GraphQL type AuthResponse.
+type RegisterRegisterAuthResponse struct {
+	Token        string  `json:"token"`
+	RefreshToken *string `json:"refreshToken"`
+}
+
+// GetToken returns RegisterRegisterAuthResponse.Token, and is useful for accessing the field via an interface.
+func (v *RegisterRegisterAuthResponse) GetToken() string { return v.Token }
+
+// GetRefreshToken returns RegisterRegisterAuthResponse.RefreshToken, and is useful for accessing the field via an interface.
+func (v *RegisterRegisterAuthResponse) GetRefreshToken() *string { return v.RefreshToken }
+
+type RegisterRequest struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// GetEmail returns RegisterRequest.Email, and is useful for accessing the field via an interface.
+func (v *RegisterRequest) GetEmail() string { return v.Email }
+
+// GetName returns RegisterRequest.Name, and is useful for accessing the field via an interface.
+func (v *RegisterRequest) GetName() string { return v.Name }
+
+// GetPassword returns RegisterRequest.Password, and is useful for accessing the field via an interface.
+func (v *RegisterRequest) GetPassword() string { return v.Password }
+
+// RegisterResponse is returned by Register on success.
+type RegisterResponse struct {
+	Register *RegisterRegisterAuthResponse `json:"register"`
+}
+
+// GetRegister returns RegisterResponse.Register, and is useful for accessing the field via an interface.
+func (v *RegisterResponse) GetRegister() *RegisterRegisterAuthResponse { return v.Register }
+
+// RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration includes the requested fields of the GraphQL type SlashCommandRegistration.
+type RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration struct {
+	Command *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand `json:"command"`
+	Secret  string                                                                               `json:"secret"`
+}
+
+// GetCommand returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration.Command, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration) GetCommand() *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand {
+	return v.Command
+}
+
+// GetSecret returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration.Secret, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration) GetSecret() string {
+	return v.Secret
+}
+
+// RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand includes the requested fields of the GraphQL type SlashCommand.
+type RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand struct {
+	SlashCommandFields `json:"-"`
+}
+
+// GetId returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand.Id, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) GetId() string {
+	return v.SlashCommandFields.Id
+}
+
+// GetSpaceID returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand.SpaceID, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) GetSpaceID() string {
+	return v.SlashCommandFields.SpaceID
+}
+
+// GetName returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand.Name, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) GetName() string {
+	return v.SlashCommandFields.Name
+}
+
+// GetDescription returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand.Description, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) GetDescription() string {
+	return v.SlashCommandFields.Description
+}
+
+// GetUrl returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand.Url, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) GetUrl() *string {
+	return v.SlashCommandFields.Url
+}
+
+// GetBuiltin returns RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand.Builtin, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) GetBuiltin() bool {
+	return v.SlashCommandFields.Builtin
+}
+
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SlashCommandFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalRegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Url *string `json:"url"`
+
+	Builtin bool `json:"builtin"`
+}
+
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand) __premarshalJSON() (*__premarshalRegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand, error) {
+	var retval __premarshalRegisterSlashCommandRegisterSlashCommandSlashCommandRegistrationCommandSlashCommand
+
+	retval.Id = v.SlashCommandFields.Id
+	retval.SpaceID = v.SlashCommandFields.SpaceID
+	retval.Name = v.SlashCommandFields.Name
+	retval.Description = v.SlashCommandFields.Description
+	retval.Url = v.SlashCommandFields.Url
+	retval.Builtin = v.SlashCommandFields.Builtin
+	return &retval, nil
+}
+
+// RegisterSlashCommandResponse is returned by RegisterSlashCommand on success.
+type RegisterSlashCommandResponse struct {
+	RegisterSlashCommand *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration `json:"registerSlashCommand"`
+}
+
+// GetRegisterSlashCommand returns RegisterSlashCommandResponse.RegisterSlashCommand, and is useful for accessing the field via an interface.
+func (v *RegisterSlashCommandResponse) GetRegisterSlashCommand() *RegisterSlashCommandRegisterSlashCommandSlashCommandRegistration {
+	return v.RegisterSlashCommand
+}
+
+type RetentionMode string
+
+const (
+	RetentionModeDefault  RetentionMode = "DEFAULT"
+	RetentionModeForever  RetentionMode = "FOREVER"
+	RetentionModeDays     RetentionMode = "DAYS"
+	RetentionModeMessages RetentionMode = "MESSAGES"
+)
+
+var AllRetentionMode = []RetentionMode{
+	RetentionModeDefault,
+	RetentionModeForever,
+	RetentionModeDays,
+	RetentionModeMessages,
+}
+
+type RetentionPolicyRequest struct {
+	Mode  RetentionMode `json:"mode"`
+	Value *int          `json:"value"`
+}
+
+// GetMode returns RetentionPolicyRequest.Mode, and is useful for accessing the field via an interface.
+func (v *RetentionPolicyRequest) GetMode() RetentionMode { return v.Mode }
+
+// GetValue returns RetentionPolicyRequest.Value, and is useful for accessing the field via an interface.
+func (v *RetentionPolicyRequest) GetValue() *int { return v.Value }
+
+// RevokeIncomingWebhookResponse is returned by RevokeIncomingWebhook on success.
+type RevokeIncomingWebhookResponse struct {
+	RevokeIncomingWebhook bool `json:"revokeIncomingWebhook"`
+}
+
+// GetRevokeIncomingWebhook returns RevokeIncomingWebhookResponse.RevokeIncomingWebhook, and is useful for accessing the field via an interface.
+func (v *RevokeIncomingWebhookResponse) GetRevokeIncomingWebhook() bool {
+	return v.RevokeIncomingWebhook
+}
+
+// RotateBotTokenResponse is returned by RotateBotToken on success.
+type RotateBotTokenResponse struct {
+	RotateBotToken *RotateBotTokenRotateBotTokenBotRegistration `json:"rotateBotToken"`
+}
+
+// GetRotateBotToken returns RotateBotTokenResponse.RotateBotToken, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenResponse) GetRotateBotToken() *RotateBotTokenRotateBotTokenBotRegistration {
+	return v.RotateBotToken
+}
+
+// RotateBotTokenRotateBotTokenBotRegistration includes the requested fields of the GraphQL type BotRegistration.
+type RotateBotTokenRotateBotTokenBotRegistration struct {
+	Bot   *RotateBotTokenRotateBotTokenBotRegistrationBotUser `json:"bot"`
+	Token string                                              `json:"token"`
+}
+
+// GetBot returns RotateBotTokenRotateBotTokenBotRegistration.Bot, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistration) GetBot() *RotateBotTokenRotateBotTokenBotRegistrationBotUser {
+	return v.Bot
+}
+
+// GetToken returns RotateBotTokenRotateBotTokenBotRegistration.Token, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistration) GetToken() string { return v.Token }
+
+// RotateBotTokenRotateBotTokenBotRegistrationBotUser includes the requested fields of the GraphQL type User.
+type RotateBotTokenRotateBotTokenBotRegistrationBotUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns RotateBotTokenRotateBotTokenBotRegistrationBotUser.Id, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns RotateBotTokenRotateBotTokenBotRegistrationBotUser.Email, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) GetEmail() string {
+	return v.UserFields.Email
+}
+
+// GetName returns RotateBotTokenRotateBotTokenBotRegistrationBotUser.Name, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) GetName() string {
+	return v.UserFields.Name
+}
+
+// GetBot returns RotateBotTokenRotateBotTokenBotRegistrationBotUser.Bot, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns RotateBotTokenRotateBotTokenBotRegistrationBotUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) GetAvatarURL() *string {
+	return v.UserFields.AvatarURL
+}
+
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*RotateBotTokenRotateBotTokenBotRegistrationBotUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.RotateBotTokenRotateBotTokenBotRegistrationBotUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalRotateBotTokenRotateBotTokenBotRegistrationBotUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *RotateBotTokenRotateBotTokenBotRegistrationBotUser) __premarshalJSON() (*__premarshalRotateBotTokenRotateBotTokenBotRegistrationBotUser, error) {
+	var retval __premarshalRotateBotTokenRotateBotTokenBotRegistrationBotUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// SaveMessageResponse is returned by SaveMessage on success.
+type SaveMessageResponse struct {
+	SaveMessage *SaveMessageSaveMessageSavedMessage `json:"saveMessage"`
+}
+
+// GetSaveMessage returns SaveMessageResponse.SaveMessage, and is useful for accessing the field via an interface.
+func (v *SaveMessageResponse) GetSaveMessage() *SaveMessageSaveMessageSavedMessage {
+	return v.SaveMessage
+}
+
+// SaveMessageSaveMessageSavedMessage includes the requested fields of the GraphQL type SavedMessage.
+type SaveMessageSaveMessageSavedMessage struct {
+	SavedMessageFields `json:"-"`
+}
+
+// GetId returns SaveMessageSaveMessageSavedMessage.Id, and is useful for accessing the field via an interface.
+func (v *SaveMessageSaveMessageSavedMessage) GetId() string { return v.SavedMessageFields.Id }
+
+// GetMessage returns SaveMessageSaveMessageSavedMessage.Message, and is useful for accessing the field via an interface.
+func (v *SaveMessageSaveMessageSavedMessage) GetMessage() *SavedMessageFieldsMessage {
+	return v.SavedMessageFields.Message
+}
+
+// GetNote returns SaveMessageSaveMessageSavedMessage.Note, and is useful for accessing the field via an interface.
+func (v *SaveMessageSaveMessageSavedMessage) GetNote() *string { return v.SavedMessageFields.Note }
+
+// GetRemindAt returns SaveMessageSaveMessageSavedMessage.RemindAt, and is useful for accessing the field via an interface.
+func (v *SaveMessageSaveMessageSavedMessage) GetRemindAt() *time.Time {
+	return v.SavedMessageFields.RemindAt
+}
+
+// GetCreatedAt returns SaveMessageSaveMessageSavedMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SaveMessageSaveMessageSavedMessage) GetCreatedAt() time.Time {
+	return v.SavedMessageFields.CreatedAt
+}
+
+func (v *SaveMessageSaveMessageSavedMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SaveMessageSaveMessageSavedMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SaveMessageSaveMessageSavedMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SavedMessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSaveMessageSaveMessageSavedMessage struct {
+	Id string `json:"id"`
+
+	Message *SavedMessageFieldsMessage `json:"message"`
+
+	Note *string `json:"note"`
+
+	RemindAt *time.Time `json:"remindAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *SaveMessageSaveMessageSavedMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SaveMessageSaveMessageSavedMessage) __premarshalJSON() (*__premarshalSaveMessageSaveMessageSavedMessage, error) {
+	var retval __premarshalSaveMessageSaveMessageSavedMessage
+
+	retval.Id = v.SavedMessageFields.Id
+	retval.Message = v.SavedMessageFields.Message
+	retval.Note = v.SavedMessageFields.Note
+	retval.RemindAt = v.SavedMessageFields.RemindAt
+	retval.CreatedAt = v.SavedMessageFields.CreatedAt
+	return &retval, nil
+}
+
+// SavedMessageFields includes the GraphQL fields of SavedMessage requested by the fragment SavedMessageFields.
+type SavedMessageFields struct {
+	Id        string                     `json:"id"`
+	Message   *SavedMessageFieldsMessage `json:"message"`
+	Note      *string                    `json:"note"`
+	RemindAt  *time.Time                 `json:"remindAt"`
+	CreatedAt time.Time                  `json:"createdAt"`
+}
+
+// GetId returns SavedMessageFields.Id, and is useful for accessing the field via an interface.
+func (v *SavedMessageFields) GetId() string { return v.Id }
+
+// GetMessage returns SavedMessageFields.Message, and is useful for accessing the field via an interface.
+func (v *SavedMessageFields) GetMessage() *SavedMessageFieldsMessage { return v.Message }
+
+// GetNote returns SavedMessageFields.Note, and is useful for accessing the field via an interface.
+func (v *SavedMessageFields) GetNote() *string { return v.Note }
+
+// GetRemindAt returns SavedMessageFields.RemindAt, and is useful for accessing the field via an interface.
+func (v *SavedMessageFields) GetRemindAt() *time.Time { return v.RemindAt }
+
+// GetCreatedAt returns SavedMessageFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SavedMessageFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// SavedMessageFieldsMessage includes the requested fields of the GraphQL type Message.
+type SavedMessageFieldsMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns SavedMessageFieldsMessage.Id, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns SavedMessageFieldsMessage.Content, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns SavedMessageFieldsMessage.Format, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns SavedMessageFieldsMessage.Html, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns SavedMessageFieldsMessage.User, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns SavedMessageFieldsMessage.Space, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetSpace() *MessageFieldsSpace { return v.MessageFields.Space }
+
+// GetCreatedAt returns SavedMessageFieldsMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns SavedMessageFieldsMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns SavedMessageFieldsMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns SavedMessageFieldsMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetFromBlockedUser() bool { return v.MessageFields.FromBlockedUser }
+
+// GetClientMessageID returns SavedMessageFieldsMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns SavedMessageFieldsMessage.Seq, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns SavedMessageFieldsMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns SavedMessageFieldsMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns SavedMessageFieldsMessage.Poll, and is useful for accessing the field via an interface.
+func (v *SavedMessageFieldsMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *SavedMessageFieldsMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SavedMessageFieldsMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SavedMessageFieldsMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSavedMessageFieldsMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *SavedMessageFieldsMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SavedMessageFieldsMessage) __premarshalJSON() (*__premarshalSavedMessageFieldsMessage, error) {
+	var retval __premarshalSavedMessageFieldsMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// SavedMessagesResponse is returned by SavedMessages on success.
+type SavedMessagesResponse struct {
+	SavedMessages *SavedMessagesSavedMessagesSavedMessageConnection `json:"savedMessages"`
+}
+
+// GetSavedMessages returns SavedMessagesResponse.SavedMessages, and is useful for accessing the field via an interface.
+func (v *SavedMessagesResponse) GetSavedMessages() *SavedMessagesSavedMessagesSavedMessageConnection {
+	return v.SavedMessages
+}
+
+// SavedMessagesSavedMessagesSavedMessageConnection includes the requested fields of the GraphQL type SavedMessageConnection.
+type SavedMessagesSavedMessagesSavedMessageConnection struct {
+	Edges    []*SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage `json:"edges"`
+	PageInfo *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo            `json:"pageInfo"`
+}
+
+// GetEdges returns SavedMessagesSavedMessagesSavedMessageConnection.Edges, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnection) GetEdges() []*SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage {
+	return v.Edges
+}
+
+// GetPageInfo returns SavedMessagesSavedMessagesSavedMessageConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnection) GetPageInfo() *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo {
+	return v.PageInfo
+}
+
+// SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage includes the requested fields of the GraphQL type SavedMessage.
+type SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage struct {
+	SavedMessageFields `json:"-"`
+}
+
+// GetId returns SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage.Id, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) GetId() string {
+	return v.SavedMessageFields.Id
+}
+
+// GetMessage returns SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage.Message, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) GetMessage() *SavedMessageFieldsMessage {
+	return v.SavedMessageFields.Message
+}
+
+// GetNote returns SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage.Note, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) GetNote() *string {
+	return v.SavedMessageFields.Note
+}
+
+// GetRemindAt returns SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage.RemindAt, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) GetRemindAt() *time.Time {
+	return v.SavedMessageFields.RemindAt
+}
+
+// GetCreatedAt returns SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) GetCreatedAt() time.Time {
+	return v.SavedMessageFields.CreatedAt
+}
+
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SavedMessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage struct {
+	Id string `json:"id"`
+
+	Message *SavedMessageFieldsMessage `json:"message"`
+
+	Note *string `json:"note"`
+
+	RemindAt *time.Time `json:"remindAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage) __premarshalJSON() (*__premarshalSavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage, error) {
+	var retval __premarshalSavedMessagesSavedMessagesSavedMessageConnectionEdgesSavedMessage
+
+	retval.Id = v.SavedMessageFields.Id
+	retval.Message = v.SavedMessageFields.Message
+	retval.Note = v.SavedMessageFields.Note
+	retval.RemindAt = v.SavedMessageFields.RemindAt
+	retval.CreatedAt = v.SavedMessageFields.CreatedAt
+	return &retval, nil
+}
+
+// SavedMessagesSavedMessagesSavedMessageConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type SavedMessagesSavedMessagesSavedMessageConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetEndCursor returns SavedMessagesSavedMessagesSavedMessageConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoFields.EndCursor
+}
+
+// GetHasNextPage returns SavedMessagesSavedMessagesSavedMessageConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SavedMessagesSavedMessagesSavedMessageConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SavedMessagesSavedMessagesSavedMessageConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSavedMessagesSavedMessagesSavedMessageConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SavedMessagesSavedMessagesSavedMessageConnectionPageInfo) __premarshalJSON() (*__premarshalSavedMessagesSavedMessagesSavedMessageConnectionPageInfo, error) {
+	var retval __premarshalSavedMessagesSavedMessagesSavedMessageConnectionPageInfo
+
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	return &retval, nil
+}
+
+// ScheduleMessageResponse is returned by ScheduleMessage on success.
+type ScheduleMessageResponse struct {
+	ScheduleMessage *ScheduleMessageScheduleMessageScheduledMessage `json:"scheduleMessage"`
+}
+
+// GetScheduleMessage returns ScheduleMessageResponse.ScheduleMessage, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageResponse) GetScheduleMessage() *ScheduleMessageScheduleMessageScheduledMessage {
+	return v.ScheduleMessage
+}
+
+// ScheduleMessageScheduleMessageScheduledMessage includes the requested fields of the GraphQL type ScheduledMessage.
+type ScheduleMessageScheduleMessageScheduledMessage struct {
+	ScheduledMessageFields `json:"-"`
+}
+
+// GetId returns ScheduleMessageScheduleMessageScheduledMessage.Id, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetId() string {
+	return v.ScheduledMessageFields.Id
+}
+
+// GetSpaceID returns ScheduleMessageScheduleMessageScheduledMessage.SpaceID, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetSpaceID() string {
+	return v.ScheduledMessageFields.SpaceID
+}
+
+// GetContent returns ScheduleMessageScheduleMessageScheduledMessage.Content, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetContent() string {
+	return v.ScheduledMessageFields.Content
+}
+
+// GetFormat returns ScheduleMessageScheduleMessageScheduledMessage.Format, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetFormat() MessageFormat {
+	return v.ScheduledMessageFields.Format
+}
+
+// GetEphemeral returns ScheduleMessageScheduleMessageScheduledMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetEphemeral() bool {
+	return v.ScheduledMessageFields.Ephemeral
+}
+
+// GetSendAt returns ScheduleMessageScheduleMessageScheduledMessage.SendAt, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetSendAt() time.Time {
+	return v.ScheduledMessageFields.SendAt
+}
+
+// GetStatus returns ScheduleMessageScheduleMessageScheduledMessage.Status, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetStatus() ScheduledMessageStatus {
+	return v.ScheduledMessageFields.Status
+}
+
+// GetCreatedAt returns ScheduleMessageScheduleMessageScheduledMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ScheduleMessageScheduleMessageScheduledMessage) GetCreatedAt() time.Time {
+	return v.ScheduledMessageFields.CreatedAt
+}
+
+func (v *ScheduleMessageScheduleMessageScheduledMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ScheduleMessageScheduleMessageScheduledMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ScheduleMessageScheduleMessageScheduledMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ScheduledMessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalScheduleMessageScheduleMessageScheduledMessage struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	SendAt time.Time `json:"sendAt"`
+
+	Status ScheduledMessageStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ScheduleMessageScheduleMessageScheduledMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ScheduleMessageScheduleMessageScheduledMessage) __premarshalJSON() (*__premarshalScheduleMessageScheduleMessageScheduledMessage, error) {
+	var retval __premarshalScheduleMessageScheduleMessageScheduledMessage
+
+	retval.Id = v.ScheduledMessageFields.Id
+	retval.SpaceID = v.ScheduledMessageFields.SpaceID
+	retval.Content = v.ScheduledMessageFields.Content
+	retval.Format = v.ScheduledMessageFields.Format
+	retval.Ephemeral = v.ScheduledMessageFields.Ephemeral
+	retval.SendAt = v.ScheduledMessageFields.SendAt
+	retval.Status = v.ScheduledMessageFields.Status
+	retval.CreatedAt = v.ScheduledMessageFields.CreatedAt
+	return &retval, nil
+}
+
+// ScheduledMessageFields includes the GraphQL fields of ScheduledMessage requested by the fragment ScheduledMessageFields.
+type ScheduledMessageFields struct {
+	Id        string                 `json:"id"`
+	SpaceID   string                 `json:"spaceID"`
+	Content   string                 `json:"content"`
+	Format    MessageFormat          `json:"format"`
+	Ephemeral bool                   `json:"ephemeral"`
+	SendAt    time.Time              `json:"sendAt"`
+	Status    ScheduledMessageStatus `json:"status"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// GetId returns ScheduledMessageFields.Id, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetId() string { return v.Id }
+
+// GetSpaceID returns ScheduledMessageFields.SpaceID, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetSpaceID() string { return v.SpaceID }
+
+// GetContent returns ScheduledMessageFields.Content, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetContent() string { return v.Content }
+
+// GetFormat returns ScheduledMessageFields.Format, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetFormat() MessageFormat { return v.Format }
+
+// GetEphemeral returns ScheduledMessageFields.Ephemeral, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetEphemeral() bool { return v.Ephemeral }
+
+// GetSendAt returns ScheduledMessageFields.SendAt, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetSendAt() time.Time { return v.SendAt }
+
+// GetStatus returns ScheduledMessageFields.Status, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetStatus() ScheduledMessageStatus { return v.Status }
+
+// GetCreatedAt returns ScheduledMessageFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ScheduledMessageFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+type ScheduledMessageStatus string
+
+const (
+	ScheduledMessageStatusPending  ScheduledMessageStatus = "PENDING"
+	ScheduledMessageStatusSent     ScheduledMessageStatus = "SENT"
+	ScheduledMessageStatusCanceled ScheduledMessageStatus = "CANCELED"
+	ScheduledMessageStatusFailed   ScheduledMessageStatus = "FAILED"
+)
+
+var AllScheduledMessageStatus = []ScheduledMessageStatus{
+	ScheduledMessageStatusPending,
+	ScheduledMessageStatusSent,
+	ScheduledMessageStatusCanceled,
+	ScheduledMessageStatusFailed,
+}
+
+// ScheduledMessagesResponse is returned by ScheduledMessages on success.
+type ScheduledMessagesResponse struct {
+	ScheduledMessages []*ScheduledMessagesScheduledMessagesScheduledMessage `json:"scheduledMessages"`
+}
+
+// GetScheduledMessages returns ScheduledMessagesResponse.ScheduledMessages, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesResponse) GetScheduledMessages() []*ScheduledMessagesScheduledMessagesScheduledMessage {
+	return v.ScheduledMessages
+}
+
+// ScheduledMessagesScheduledMessagesScheduledMessage includes the requested fields of the GraphQL type ScheduledMessage.
+type ScheduledMessagesScheduledMessagesScheduledMessage struct {
+	ScheduledMessageFields `json:"-"`
+}
+
+// GetId returns ScheduledMessagesScheduledMessagesScheduledMessage.Id, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetId() string {
+	return v.ScheduledMessageFields.Id
+}
+
+// GetSpaceID returns ScheduledMessagesScheduledMessagesScheduledMessage.SpaceID, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetSpaceID() string {
+	return v.ScheduledMessageFields.SpaceID
+}
+
+// GetContent returns ScheduledMessagesScheduledMessagesScheduledMessage.Content, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetContent() string {
+	return v.ScheduledMessageFields.Content
+}
+
+// GetFormat returns ScheduledMessagesScheduledMessagesScheduledMessage.Format, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetFormat() MessageFormat {
+	return v.ScheduledMessageFields.Format
+}
+
+// GetEphemeral returns ScheduledMessagesScheduledMessagesScheduledMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetEphemeral() bool {
+	return v.ScheduledMessageFields.Ephemeral
+}
+
+// GetSendAt returns ScheduledMessagesScheduledMessagesScheduledMessage.SendAt, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetSendAt() time.Time {
+	return v.ScheduledMessageFields.SendAt
+}
+
+// GetStatus returns ScheduledMessagesScheduledMessagesScheduledMessage.Status, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetStatus() ScheduledMessageStatus {
+	return v.ScheduledMessageFields.Status
+}
+
+// GetCreatedAt returns ScheduledMessagesScheduledMessagesScheduledMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) GetCreatedAt() time.Time {
+	return v.ScheduledMessageFields.CreatedAt
+}
+
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ScheduledMessagesScheduledMessagesScheduledMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ScheduledMessagesScheduledMessagesScheduledMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ScheduledMessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalScheduledMessagesScheduledMessagesScheduledMessage struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	SendAt time.Time `json:"sendAt"`
+
+	Status ScheduledMessageStatus `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ScheduledMessagesScheduledMessagesScheduledMessage) __premarshalJSON() (*__premarshalScheduledMessagesScheduledMessagesScheduledMessage, error) {
+	var retval __premarshalScheduledMessagesScheduledMessagesScheduledMessage
+
+	retval.Id = v.ScheduledMessageFields.Id
+	retval.SpaceID = v.ScheduledMessageFields.SpaceID
+	retval.Content = v.ScheduledMessageFields.Content
+	retval.Format = v.ScheduledMessageFields.Format
+	retval.Ephemeral = v.ScheduledMessageFields.Ephemeral
+	retval.SendAt = v.ScheduledMessageFields.SendAt
+	retval.Status = v.ScheduledMessageFields.Status
+	retval.CreatedAt = v.ScheduledMessageFields.CreatedAt
+	return &retval, nil
+}
+
+// SearchMessagesResponse is returned by SearchMessages on success.
+type SearchMessagesResponse struct {
+	SearchMessages *SearchMessagesSearchMessagesMessageSearchConnection `json:"searchMessages"`
+}
+
+// GetSearchMessages returns SearchMessagesResponse.SearchMessages, and is useful for accessing the field via an interface.
+func (v *SearchMessagesResponse) GetSearchMessages() *SearchMessagesSearchMessagesMessageSearchConnection {
+	return v.SearchMessages
+}
+
+// SearchMessagesSearchMessagesMessageSearchConnection includes the requested fields of the GraphQL type MessageSearchConnection.
+type SearchMessagesSearchMessagesMessageSearchConnection struct {
+	Edges    []*SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult `json:"edges"`
+	PageInfo *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo                   `json:"pageInfo"`
+}
+
+// GetEdges returns SearchMessagesSearchMessagesMessageSearchConnection.Edges, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnection) GetEdges() []*SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult {
+	return v.Edges
+}
+
+// GetPageInfo returns SearchMessagesSearchMessagesMessageSearchConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnection) GetPageInfo() *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo {
+	return v.PageInfo
+}
+
+// SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult includes the requested fields of the GraphQL type MessageSearchResult.
+type SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult struct {
+	Message *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage `json:"message"`
+	Snippet string                                                                              `json:"snippet"`
+	Rank    float64                                                                             `json:"rank"`
+}
+
+// GetMessage returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult.Message, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult) GetMessage() *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage {
+	return v.Message
+}
+
+// GetSnippet returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult.Snippet, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult) GetSnippet() string {
+	return v.Snippet
+}
+
+// GetRank returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult.Rank, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResult) GetRank() float64 {
+	return v.Rank
+}
+
+// SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage includes the requested fields of the GraphQL type Message.
+type SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Id, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetId() string {
+	return v.MessageFields.Id
+}
+
+// GetContent returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Content, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetContent() string {
+	return v.MessageFields.Content
+}
+
+// GetFormat returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Format, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetFormat() MessageFormat {
+	return v.MessageFields.Format
+}
+
+// GetHtml returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Html, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetHtml() string {
+	return v.MessageFields.Html
+}
+
+// GetUser returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.User, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetUser() *MessageFieldsUser {
+	return v.MessageFields.User
+}
+
+// GetSpace returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Space, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetSpace() *MessageFieldsSpace {
+	return v.MessageFields.Space
+}
+
+// GetCreatedAt returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetCreatedAt() time.Time {
+	return v.MessageFields.CreatedAt
+}
+
+// GetExpiresAt returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetExpiresAt() *time.Time {
+	return v.MessageFields.ExpiresAt
+}
+
+// GetEphemeral returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetEphemeral() bool {
+	return v.MessageFields.Ephemeral
+}
+
+// GetFromBlockedUser returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetFromBlockedUser() bool {
+	return v.MessageFields.FromBlockedUser
+}
+
+// GetClientMessageID returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Seq, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetSeq() *int64 {
+	return v.MessageFields.Seq
+}
+
+// GetAttachments returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage.Poll, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) GetPoll() *MessageFieldsPoll {
+	return v.MessageFields.Poll
+}
+
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage) __premarshalJSON() (*__premarshalSearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage, error) {
+	var retval __premarshalSearchMessagesSearchMessagesMessageSearchConnectionEdgesMessageSearchResultMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// SearchMessagesSearchMessagesMessageSearchConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type SearchMessagesSearchMessagesMessageSearchConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetEndCursor returns SearchMessagesSearchMessagesMessageSearchConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoFields.EndCursor
+}
+
+// GetHasNextPage returns SearchMessagesSearchMessagesMessageSearchConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchMessagesSearchMessagesMessageSearchConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchMessagesSearchMessagesMessageSearchConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchMessagesSearchMessagesMessageSearchConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchMessagesSearchMessagesMessageSearchConnectionPageInfo) __premarshalJSON() (*__premarshalSearchMessagesSearchMessagesMessageSearchConnectionPageInfo, error) {
+	var retval __premarshalSearchMessagesSearchMessagesMessageSearchConnectionPageInfo
+
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	return &retval, nil
+}
+
+// SearchSpacesResponse is returned by SearchSpaces on success.
+type SearchSpacesResponse struct {
+	SearchSpaces *SearchSpacesSearchSpacesSpaceConnection `json:"searchSpaces"`
+}
+
+// GetSearchSpaces returns SearchSpacesResponse.SearchSpaces, and is useful for accessing the field via an interface.
+func (v *SearchSpacesResponse) GetSearchSpaces() *SearchSpacesSearchSpacesSpaceConnection {
+	return v.SearchSpaces
+}
+
+// SearchSpacesSearchSpacesSpaceConnection includes the requested fields of the GraphQL type SpaceConnection.
+type SearchSpacesSearchSpacesSpaceConnection struct {
+	Nodes    []*SearchSpacesSearchSpacesSpaceConnectionNodesSpace `json:"nodes"`
+	PageInfo *SearchSpacesSearchSpacesSpaceConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns SearchSpacesSearchSpacesSpaceConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnection) GetNodes() []*SearchSpacesSearchSpacesSpaceConnectionNodesSpace {
+	return v.Nodes
+}
+
+// GetPageInfo returns SearchSpacesSearchSpacesSpaceConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnection) GetPageInfo() *SearchSpacesSearchSpacesSpaceConnectionPageInfo {
+	return v.PageInfo
+}
+
+// SearchSpacesSearchSpacesSpaceConnectionNodesSpace includes the requested fields of the GraphQL type Space.
+type SearchSpacesSearchSpacesSpaceConnectionNodesSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.Id, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.Name, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetName() string {
+	return v.SpaceFields.Name
+}
+
+// GetDescription returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.Description, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetDescription() *string {
+	return v.SpaceFields.Description
+}
+
+// GetMemberCount returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetMemberCount() int {
+	return v.SpaceFields.MemberCount
+}
+
+// GetLastActivityAt returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetLastActivityAt() *time.Time {
+	return v.SpaceFields.LastActivityAt
+}
+
+// GetIsMember returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetIsMember() bool {
+	return v.SpaceFields.IsMember
+}
+
+// GetLastReadSeq returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetLastReadSeq() int64 {
+	return v.SpaceFields.LastReadSeq
+}
+
+// GetUnreadCount returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetUnreadCount() int {
+	return v.SpaceFields.UnreadCount
+}
+
+// GetMessageTTL returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetMessageTTL() *int {
+	return v.SpaceFields.MessageTTL
+}
+
+// GetRetentionPolicy returns SearchSpacesSearchSpacesSpaceConnectionNodesSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchSpacesSearchSpacesSpaceConnectionNodesSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchSpacesSearchSpacesSpaceConnectionNodesSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchSpacesSearchSpacesSpaceConnectionNodesSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchSpacesSearchSpacesSpaceConnectionNodesSpace) __premarshalJSON() (*__premarshalSearchSpacesSearchSpacesSpaceConnectionNodesSpace, error) {
+	var retval __premarshalSearchSpacesSearchSpacesSpaceConnectionNodesSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// SearchSpacesSearchSpacesSpaceConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type SearchSpacesSearchSpacesSpaceConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetEndCursor returns SearchSpacesSearchSpacesSpaceConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoFields.EndCursor
+}
+
+// GetHasNextPage returns SearchSpacesSearchSpacesSpaceConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *SearchSpacesSearchSpacesSpaceConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+func (v *SearchSpacesSearchSpacesSpaceConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchSpacesSearchSpacesSpaceConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchSpacesSearchSpacesSpaceConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchSpacesSearchSpacesSpaceConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *SearchSpacesSearchSpacesSpaceConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchSpacesSearchSpacesSpaceConnectionPageInfo) __premarshalJSON() (*__premarshalSearchSpacesSearchSpacesSpaceConnectionPageInfo, error) {
+	var retval __premarshalSearchSpacesSearchSpacesSpaceConnectionPageInfo
+
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	return &retval, nil
+}
+
+// SearchUsersResponse is returned by SearchUsers on success.
+type SearchUsersResponse struct {
+	SearchUsers *SearchUsersSearchUsersUserSearchConnection `json:"searchUsers"`
+}
+
+// GetSearchUsers returns SearchUsersResponse.SearchUsers, and is useful for accessing the field via an interface.
+func (v *SearchUsersResponse) GetSearchUsers() *SearchUsersSearchUsersUserSearchConnection {
+	return v.SearchUsers
+}
+
+// SearchUsersSearchUsersUserSearchConnection includes the requested fields of the GraphQL type UserSearchConnection.
+type SearchUsersSearchUsersUserSearchConnection struct {
+	Edges    []*SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult `json:"edges"`
+	PageInfo *SearchUsersSearchUsersUserSearchConnectionPageInfo                `json:"pageInfo"`
+}
+
+// GetEdges returns SearchUsersSearchUsersUserSearchConnection.Edges, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnection) GetEdges() []*SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult {
+	return v.Edges
+}
+
+// GetPageInfo returns SearchUsersSearchUsersUserSearchConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnection) GetPageInfo() *SearchUsersSearchUsersUserSearchConnectionPageInfo {
+	return v.PageInfo
+}
+
+// SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult includes the requested fields of the GraphQL type UserSearchResult.
+type SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult struct {
+	User             *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser `json:"user"`
+	SharedSpaceCount int                                                                  `json:"sharedSpaceCount"`
+}
+
+// GetUser returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult.User, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult) GetUser() *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser {
+	return v.User
+}
+
+// GetSharedSpaceCount returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult.SharedSpaceCount, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResult) GetSharedSpaceCount() int {
+	return v.SharedSpaceCount
+}
+
+// SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser includes the requested fields of the GraphQL type User.
+type SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser.Id, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) GetId() string {
+	return v.UserFields.Id
+}
+
+// GetEmail returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser.Email, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) GetEmail() string {
+	return v.UserFields.Email
+}
+
+// GetName returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser.Name, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) GetName() string {
+	return v.UserFields.Name
+}
+
+// GetBot returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser.Bot, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) GetBot() bool {
+	return v.UserFields.Bot
+}
+
+// GetAvatarURL returns SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) GetAvatarURL() *string {
+	return v.UserFields.AvatarURL
+}
+
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser) __premarshalJSON() (*__premarshalSearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser, error) {
+	var retval __premarshalSearchUsersSearchUsersUserSearchConnectionEdgesUserSearchResultUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// SearchUsersSearchUsersUserSearchConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type SearchUsersSearchUsersUserSearchConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetEndCursor returns SearchUsersSearchUsersUserSearchConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoFields.EndCursor
+}
+
+// GetHasNextPage returns SearchUsersSearchUsersUserSearchConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *SearchUsersSearchUsersUserSearchConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+func (v *SearchUsersSearchUsersUserSearchConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SearchUsersSearchUsersUserSearchConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SearchUsersSearchUsersUserSearchConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSearchUsersSearchUsersUserSearchConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *SearchUsersSearchUsersUserSearchConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SearchUsersSearchUsersUserSearchConnectionPageInfo) __premarshalJSON() (*__premarshalSearchUsersSearchUsersUserSearchConnectionPageInfo, error) {
+	var retval __premarshalSearchUsersSearchUsersUserSearchConnectionPageInfo
+
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	return &retval, nil
+}
+
+// SendMessageResponse is returned by SendMessage on success.
+type SendMessageResponse struct {
+	SendMessage *SendMessageSendMessage `json:"sendMessage"`
+}
+
+// GetSendMessage returns SendMessageResponse.SendMessage, and is useful for accessing the field via an interface.
+func (v *SendMessageResponse) GetSendMessage() *SendMessageSendMessage { return v.SendMessage }
+
+// SendMessageSendMessage includes the requested fields of the GraphQL type Message.
+type SendMessageSendMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns SendMessageSendMessage.Id, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns SendMessageSendMessage.Content, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns SendMessageSendMessage.Format, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns SendMessageSendMessage.Html, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns SendMessageSendMessage.User, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns SendMessageSendMessage.Space, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetSpace() *MessageFieldsSpace { return v.MessageFields.Space }
+
+// GetCreatedAt returns SendMessageSendMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns SendMessageSendMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns SendMessageSendMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns SendMessageSendMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetFromBlockedUser() bool { return v.MessageFields.FromBlockedUser }
+
+// GetClientMessageID returns SendMessageSendMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetClientMessageID() *string { return v.MessageFields.ClientMessageID }
+
+// GetSeq returns SendMessageSendMessage.Seq, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns SendMessageSendMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns SendMessageSendMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns SendMessageSendMessage.Poll, and is useful for accessing the field via an interface.
+func (v *SendMessageSendMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *SendMessageSendMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SendMessageSendMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SendMessageSendMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSendMessageSendMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *SendMessageSendMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SendMessageSendMessage) __premarshalJSON() (*__premarshalSendMessageSendMessage, error) {
+	var retval __premarshalSendMessageSendMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// SlashCommandFields includes the GraphQL fields of SlashCommand requested by the fragment SlashCommandFields.
+type SlashCommandFields struct {
+	Id          string  `json:"id"`
+	SpaceID     string  `json:"spaceID"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Url         *string `json:"url"`
+	Builtin     bool    `json:"builtin"`
+}
+
+// GetId returns SlashCommandFields.Id, and is useful for accessing the field via an interface.
+func (v *SlashCommandFields) GetId() string { return v.Id }
+
+// GetSpaceID returns SlashCommandFields.SpaceID, and is useful for accessing the field via an interface.
+func (v *SlashCommandFields) GetSpaceID() string { return v.SpaceID }
+
+// GetName returns SlashCommandFields.Name, and is useful for accessing the field via an interface.
+func (v *SlashCommandFields) GetName() string { return v.Name }
+
+// GetDescription returns SlashCommandFields.Description, and is useful for accessing the field via an interface.
+func (v *SlashCommandFields) GetDescription() string { return v.Description }
+
+// GetUrl returns SlashCommandFields.Url, and is useful for accessing the field via an interface.
+func (v *SlashCommandFields) GetUrl() *string { return v.Url }
+
+// GetBuiltin returns SlashCommandFields.Builtin, and is useful for accessing the field via an interface.
+func (v *SlashCommandFields) GetBuiltin() bool { return v.Builtin }
+
+type SlashCommandRequest struct {
+	Name        string  `json:"name"`
+	Url         string  `json:"url"`
+	Description *string `json:"description"`
+}
+
+// GetName returns SlashCommandRequest.Name, and is useful for accessing the field via an interface.
+func (v *SlashCommandRequest) GetName() string { return v.Name }
+
+// GetUrl returns SlashCommandRequest.Url, and is useful for accessing the field via an interface.
+func (v *SlashCommandRequest) GetUrl() string { return v.Url }
+
+// GetDescription returns SlashCommandRequest.Description, and is useful for accessing the field via an interface.
+func (v *SlashCommandRequest) GetDescription() *string { return v.Description }
+
+// SlashCommandsResponse is returned by SlashCommands on success.
+type SlashCommandsResponse struct {
+	SlashCommands []*SlashCommandsSlashCommandsSlashCommand `json:"slashCommands"`
+}
+
+// GetSlashCommands returns SlashCommandsResponse.SlashCommands, and is useful for accessing the field via an interface.
+func (v *SlashCommandsResponse) GetSlashCommands() []*SlashCommandsSlashCommandsSlashCommand {
+	return v.SlashCommands
+}
+
+// SlashCommandsSlashCommandsSlashCommand includes the requested fields of the GraphQL type SlashCommand.
+type SlashCommandsSlashCommandsSlashCommand struct {
+	SlashCommandFields `json:"-"`
+}
+
+// GetId returns SlashCommandsSlashCommandsSlashCommand.Id, and is useful for accessing the field via an interface.
+func (v *SlashCommandsSlashCommandsSlashCommand) GetId() string { return v.SlashCommandFields.Id }
+
+// GetSpaceID returns SlashCommandsSlashCommandsSlashCommand.SpaceID, and is useful for accessing the field via an interface.
+func (v *SlashCommandsSlashCommandsSlashCommand) GetSpaceID() string {
+	return v.SlashCommandFields.SpaceID
+}
+
+// GetName returns SlashCommandsSlashCommandsSlashCommand.Name, and is useful for accessing the field via an interface.
+func (v *SlashCommandsSlashCommandsSlashCommand) GetName() string { return v.SlashCommandFields.Name }
+
+// GetDescription returns SlashCommandsSlashCommandsSlashCommand.Description, and is useful for accessing the field via an interface.
+func (v *SlashCommandsSlashCommandsSlashCommand) GetDescription() string {
+	return v.SlashCommandFields.Description
+}
+
+// GetUrl returns SlashCommandsSlashCommandsSlashCommand.Url, and is useful for accessing the field via an interface.
+func (v *SlashCommandsSlashCommandsSlashCommand) GetUrl() *string { return v.SlashCommandFields.Url }
+
+// GetBuiltin returns SlashCommandsSlashCommandsSlashCommand.Builtin, and is useful for accessing the field via an interface.
+func (v *SlashCommandsSlashCommandsSlashCommand) GetBuiltin() bool {
+	return v.SlashCommandFields.Builtin
+}
+
+func (v *SlashCommandsSlashCommandsSlashCommand) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SlashCommandsSlashCommandsSlashCommand
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SlashCommandsSlashCommandsSlashCommand = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SlashCommandFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSlashCommandsSlashCommandsSlashCommand struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Url *string `json:"url"`
+
+	Builtin bool `json:"builtin"`
+}
+
+func (v *SlashCommandsSlashCommandsSlashCommand) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SlashCommandsSlashCommandsSlashCommand) __premarshalJSON() (*__premarshalSlashCommandsSlashCommandsSlashCommand, error) {
+	var retval __premarshalSlashCommandsSlashCommandsSlashCommand
+
+	retval.Id = v.SlashCommandFields.Id
+	retval.SpaceID = v.SlashCommandFields.SpaceID
+	retval.Name = v.SlashCommandFields.Name
+	retval.Description = v.SlashCommandFields.Description
+	retval.Url = v.SlashCommandFields.Url
+	retval.Builtin = v.SlashCommandFields.Builtin
+	return &retval, nil
+}
+
+type SpaceEventType string
+
+const (
+	SpaceEventTypeMessageCreated  SpaceEventType = "MESSAGE_CREATED"
+	SpaceEventTypeMessageUpdated  SpaceEventType = "MESSAGE_UPDATED"
+	SpaceEventTypeMessagePinned   SpaceEventType = "MESSAGE_PINNED"
+	SpaceEventTypeMessageUnpinned SpaceEventType = "MESSAGE_UNPINNED"
+	SpaceEventTypeMessageDeleted  SpaceEventType = "MESSAGE_DELETED"
+	SpaceEventTypePollUpdated     SpaceEventType = "POLL_UPDATED"
+	SpaceEventTypeMemberJoined    SpaceEventType = "MEMBER_JOINED"
+	SpaceEventTypeReactionAdded   SpaceEventType = "REACTION_ADDED"
+	SpaceEventTypeReactionRemoved SpaceEventType = "REACTION_REMOVED"
+)
+
+var AllSpaceEventType = []SpaceEventType{
+	SpaceEventTypeMessageCreated,
+	SpaceEventTypeMessageUpdated,
+	SpaceEventTypeMessagePinned,
+	SpaceEventTypeMessageUnpinned,
+	SpaceEventTypeMessageDeleted,
+	SpaceEventTypePollUpdated,
+	SpaceEventTypeMemberJoined,
+	SpaceEventTypeReactionAdded,
+	SpaceEventTypeReactionRemoved,
+}
+
+// SpaceEventsResponse is returned by SpaceEvents on success.
+type SpaceEventsResponse struct {
+	SpaceEvents *SpaceEventsSpaceEventsSpaceEvent `json:"spaceEvents"`
+}
+
+// GetSpaceEvents returns SpaceEventsResponse.SpaceEvents, and is useful for accessing the field via an interface.
+func (v *SpaceEventsResponse) GetSpaceEvents() *SpaceEventsSpaceEventsSpaceEvent {
+	return v.SpaceEvents
+}
+
+// SpaceEventsSpaceEventsSpaceEvent includes the requested fields of the GraphQL type SpaceEvent.
+type SpaceEventsSpaceEventsSpaceEvent struct {
+	Type      SpaceEventType                           `json:"type"`
+	SpaceID   string                                   `json:"spaceID"`
+	Cursor    *string                                  `json:"cursor"`
+	Message   *SpaceEventsSpaceEventsSpaceEventMessage `json:"message"`
+	MessageID *string                                  `json:"messageID"`
+	Poll      *SpaceEventsSpaceEventsSpaceEventPoll    `json:"poll"`
+	User      *SpaceEventsSpaceEventsSpaceEventUser    `json:"user"`
+}
+
+// GetType returns SpaceEventsSpaceEventsSpaceEvent.Type, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetType() SpaceEventType { return v.Type }
+
+// GetSpaceID returns SpaceEventsSpaceEventsSpaceEvent.SpaceID, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetSpaceID() string { return v.SpaceID }
+
+// GetCursor returns SpaceEventsSpaceEventsSpaceEvent.Cursor, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetCursor() *string { return v.Cursor }
+
+// GetMessage returns SpaceEventsSpaceEventsSpaceEvent.Message, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetMessage() *SpaceEventsSpaceEventsSpaceEventMessage {
+	return v.Message
+}
+
+// GetMessageID returns SpaceEventsSpaceEventsSpaceEvent.MessageID, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetMessageID() *string { return v.MessageID }
+
+// GetPoll returns SpaceEventsSpaceEventsSpaceEvent.Poll, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetPoll() *SpaceEventsSpaceEventsSpaceEventPoll {
+	return v.Poll
+}
+
+// GetUser returns SpaceEventsSpaceEventsSpaceEvent.User, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEvent) GetUser() *SpaceEventsSpaceEventsSpaceEventUser {
+	return v.User
+}
+
+// SpaceEventsSpaceEventsSpaceEventMessage includes the requested fields of the GraphQL type Message.
+type SpaceEventsSpaceEventsSpaceEventMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns SpaceEventsSpaceEventsSpaceEventMessage.Id, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns SpaceEventsSpaceEventsSpaceEventMessage.Content, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns SpaceEventsSpaceEventsSpaceEventMessage.Format, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetFormat() MessageFormat {
+	return v.MessageFields.Format
+}
+
+// GetHtml returns SpaceEventsSpaceEventsSpaceEventMessage.Html, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns SpaceEventsSpaceEventsSpaceEventMessage.User, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetUser() *MessageFieldsUser {
+	return v.MessageFields.User
+}
+
+// GetSpace returns SpaceEventsSpaceEventsSpaceEventMessage.Space, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetSpace() *MessageFieldsSpace {
+	return v.MessageFields.Space
+}
+
+// GetCreatedAt returns SpaceEventsSpaceEventsSpaceEventMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetCreatedAt() time.Time {
+	return v.MessageFields.CreatedAt
+}
+
+// GetExpiresAt returns SpaceEventsSpaceEventsSpaceEventMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetExpiresAt() *time.Time {
+	return v.MessageFields.ExpiresAt
+}
+
+// GetEphemeral returns SpaceEventsSpaceEventsSpaceEventMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetEphemeral() bool {
+	return v.MessageFields.Ephemeral
+}
+
+// GetFromBlockedUser returns SpaceEventsSpaceEventsSpaceEventMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetFromBlockedUser() bool {
+	return v.MessageFields.FromBlockedUser
+}
+
+// GetClientMessageID returns SpaceEventsSpaceEventsSpaceEventMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns SpaceEventsSpaceEventsSpaceEventMessage.Seq, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns SpaceEventsSpaceEventsSpaceEventMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns SpaceEventsSpaceEventsSpaceEventMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns SpaceEventsSpaceEventsSpaceEventMessage.Poll, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) GetPoll() *MessageFieldsPoll {
+	return v.MessageFields.Poll
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceEventsSpaceEventsSpaceEventMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceEventsSpaceEventsSpaceEventMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceEventsSpaceEventsSpaceEventMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventMessage) __premarshalJSON() (*__premarshalSpaceEventsSpaceEventsSpaceEventMessage, error) {
+	var retval __premarshalSpaceEventsSpaceEventsSpaceEventMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// SpaceEventsSpaceEventsSpaceEventPoll includes the requested fields of the GraphQL type Poll.
+type SpaceEventsSpaceEventsSpaceEventPoll struct {
+	PollFields `json:"-"`
+}
+
+// GetId returns SpaceEventsSpaceEventsSpaceEventPoll.Id, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetId() string { return v.PollFields.Id }
+
+// GetQuestion returns SpaceEventsSpaceEventsSpaceEventPoll.Question, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetQuestion() string { return v.PollFields.Question }
+
+// GetOptions returns SpaceEventsSpaceEventsSpaceEventPoll.Options, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetOptions() []*PollFieldsOptionsPollOption {
+	return v.PollFields.Options
+}
+
+// GetMultiChoice returns SpaceEventsSpaceEventsSpaceEventPoll.MultiChoice, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetMultiChoice() bool { return v.PollFields.MultiChoice }
+
+// GetAnonymous returns SpaceEventsSpaceEventsSpaceEventPoll.Anonymous, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetAnonymous() bool { return v.PollFields.Anonymous }
+
+// GetClosesAt returns SpaceEventsSpaceEventsSpaceEventPoll.ClosesAt, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetClosesAt() *time.Time { return v.PollFields.ClosesAt }
+
+// GetClosed returns SpaceEventsSpaceEventsSpaceEventPoll.Closed, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetClosed() bool { return v.PollFields.Closed }
+
+// GetTotalVoters returns SpaceEventsSpaceEventsSpaceEventPoll.TotalVoters, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetTotalVoters() int { return v.PollFields.TotalVoters }
+
+// GetMyVotes returns SpaceEventsSpaceEventsSpaceEventPoll.MyVotes, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) GetMyVotes() []string { return v.PollFields.MyVotes }
+
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceEventsSpaceEventsSpaceEventPoll
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceEventsSpaceEventsSpaceEventPoll = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PollFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceEventsSpaceEventsSpaceEventPoll struct {
+	Id string `json:"id"`
+
+	Question string `json:"question"`
+
+	Options []*PollFieldsOptionsPollOption `json:"options"`
+
+	MultiChoice bool `json:"multiChoice"`
+
+	Anonymous bool `json:"anonymous"`
+
+	ClosesAt *time.Time `json:"closesAt"`
+
+	Closed bool `json:"closed"`
+
+	TotalVoters int `json:"totalVoters"`
+
+	MyVotes []string `json:"myVotes"`
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventPoll) __premarshalJSON() (*__premarshalSpaceEventsSpaceEventsSpaceEventPoll, error) {
+	var retval __premarshalSpaceEventsSpaceEventsSpaceEventPoll
+
+	retval.Id = v.PollFields.Id
+	retval.Question = v.PollFields.Question
+	retval.Options = v.PollFields.Options
+	retval.MultiChoice = v.PollFields.MultiChoice
+	retval.Anonymous = v.PollFields.Anonymous
+	retval.ClosesAt = v.PollFields.ClosesAt
+	retval.Closed = v.PollFields.Closed
+	retval.TotalVoters = v.PollFields.TotalVoters
+	retval.MyVotes = v.PollFields.MyVotes
+	return &retval, nil
+}
+
+// SpaceEventsSpaceEventsSpaceEventUser includes the requested fields of the GraphQL type User.
+type SpaceEventsSpaceEventsSpaceEventUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns SpaceEventsSpaceEventsSpaceEventUser.Id, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns SpaceEventsSpaceEventsSpaceEventUser.Email, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns SpaceEventsSpaceEventsSpaceEventUser.Name, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns SpaceEventsSpaceEventsSpaceEventUser.Bot, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns SpaceEventsSpaceEventsSpaceEventUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *SpaceEventsSpaceEventsSpaceEventUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *SpaceEventsSpaceEventsSpaceEventUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceEventsSpaceEventsSpaceEventUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceEventsSpaceEventsSpaceEventUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceEventsSpaceEventsSpaceEventUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceEventsSpaceEventsSpaceEventUser) __premarshalJSON() (*__premarshalSpaceEventsSpaceEventsSpaceEventUser, error) {
+	var retval __premarshalSpaceEventsSpaceEventsSpaceEventUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// SpaceFields includes the GraphQL fields of Space requested by the fragment SpaceFields.
+type SpaceFields struct {
+	Id              string                      `json:"id"`
+	Name            string                      `json:"name"`
+	Description     *string                     `json:"description"`
+	MemberCount     int                         `json:"memberCount"`
+	LastActivityAt  *time.Time                  `json:"lastActivityAt"`
+	IsMember        bool                        `json:"isMember"`
+	LastReadSeq     int64                       `json:"lastReadSeq"`
+	UnreadCount     int                         `json:"unreadCount"`
+	MessageTTL      *int                        `json:"messageTTL"`
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+// GetId returns SpaceFields.Id, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetId() string { return v.Id }
+
+// GetName returns SpaceFields.Name, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetName() string { return v.Name }
+
+// GetDescription returns SpaceFields.Description, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetDescription() *string { return v.Description }
+
+// GetMemberCount returns SpaceFields.MemberCount, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetMemberCount() int { return v.MemberCount }
+
+// GetLastActivityAt returns SpaceFields.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetLastActivityAt() *time.Time { return v.LastActivityAt }
+
+// GetIsMember returns SpaceFields.IsMember, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetIsMember() bool { return v.IsMember }
+
+// GetLastReadSeq returns SpaceFields.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetLastReadSeq() int64 { return v.LastReadSeq }
+
+// GetUnreadCount returns SpaceFields.UnreadCount, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetUnreadCount() int { return v.UnreadCount }
+
+// GetMessageTTL returns SpaceFields.MessageTTL, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetMessageTTL() *int { return v.MessageTTL }
+
+// GetRetentionPolicy returns SpaceFields.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *SpaceFields) GetRetentionPolicy() *SpaceFieldsRetentionPolicy { return v.RetentionPolicy }
+
+// SpaceFieldsRetentionPolicy includes the requested fields of the GraphQL type RetentionPolicy.
+type SpaceFieldsRetentionPolicy struct {
+	Mode      RetentionMode `json:"mode"`
+	Value     *int          `json:"value"`
+	Inherited bool          `json:"inherited"`
+}
+
+// GetMode returns SpaceFieldsRetentionPolicy.Mode, and is useful for accessing the field via an interface.
+func (v *SpaceFieldsRetentionPolicy) GetMode() RetentionMode { return v.Mode }
+
+// GetValue returns SpaceFieldsRetentionPolicy.Value, and is useful for accessing the field via an interface.
+func (v *SpaceFieldsRetentionPolicy) GetValue() *int { return v.Value }
+
+// GetInherited returns SpaceFieldsRetentionPolicy.Inherited, and is useful for accessing the field via an interface.
+func (v *SpaceFieldsRetentionPolicy) GetInherited() bool { return v.Inherited }
+
+type SpaceRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// GetName returns SpaceRequest.Name, and is useful for accessing the field via an interface.
+func (v *SpaceRequest) GetName() string { return v.Name }
+
+// GetDescription returns SpaceRequest.Description, and is useful for accessing the field via an interface.
+func (v *SpaceRequest) GetDescription() *string { return v.Description }
+
+// SpaceResponse is returned by Space on success.
+type SpaceResponse struct {
+	Space *SpaceSpace `json:"space"`
+}
+
+// GetSpace returns SpaceResponse.Space, and is useful for accessing the field via an interface.
+func (v *SpaceResponse) GetSpace() *SpaceSpace { return v.Space }
+
+type SpaceSort string
+
+const (
+	SpaceSortMembers  SpaceSort = "MEMBERS"
+	SpaceSortActivity SpaceSort = "ACTIVITY"
+	SpaceSortCreated  SpaceSort = "CREATED"
+)
+
+var AllSpaceSort = []SpaceSort{
+	SpaceSortMembers,
+	SpaceSortActivity,
+	SpaceSortCreated,
+}
+
+// SpaceSpace includes the requested fields of the GraphQL type Space.
+type SpaceSpace struct {
+	SpaceFields    `json:"-"`
+	Members        []*SpaceSpaceMembersUser           `json:"members"`
+	Admins         []*SpaceSpaceAdminsUser            `json:"admins"`
+	PinnedMessages []*SpaceSpacePinnedMessagesMessage `json:"pinnedMessages"`
+}
+
+// GetMembers returns SpaceSpace.Members, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetMembers() []*SpaceSpaceMembersUser { return v.Members }
+
+// GetAdmins returns SpaceSpace.Admins, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetAdmins() []*SpaceSpaceAdminsUser { return v.Admins }
+
+// GetPinnedMessages returns SpaceSpace.PinnedMessages, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetPinnedMessages() []*SpaceSpacePinnedMessagesMessage { return v.PinnedMessages }
+
+// GetId returns SpaceSpace.Id, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns SpaceSpace.Name, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns SpaceSpace.Description, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetDescription() *string { return v.SpaceFields.Description }
+
+// GetMemberCount returns SpaceSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetMemberCount() int { return v.SpaceFields.MemberCount }
+
+// GetLastActivityAt returns SpaceSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetLastActivityAt() *time.Time { return v.SpaceFields.LastActivityAt }
+
+// GetIsMember returns SpaceSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetIsMember() bool { return v.SpaceFields.IsMember }
+
+// GetLastReadSeq returns SpaceSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetLastReadSeq() int64 { return v.SpaceFields.LastReadSeq }
+
+// GetUnreadCount returns SpaceSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetUnreadCount() int { return v.SpaceFields.UnreadCount }
+
+// GetMessageTTL returns SpaceSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetMessageTTL() *int { return v.SpaceFields.MessageTTL }
+
+// GetRetentionPolicy returns SpaceSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *SpaceSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *SpaceSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceSpace struct {
+	Members []*SpaceSpaceMembersUser `json:"members"`
+
+	Admins []*SpaceSpaceAdminsUser `json:"admins"`
+
+	PinnedMessages []*SpaceSpacePinnedMessagesMessage `json:"pinnedMessages"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *SpaceSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceSpace) __premarshalJSON() (*__premarshalSpaceSpace, error) {
+	var retval __premarshalSpaceSpace
+
+	retval.Members = v.Members
+	retval.Admins = v.Admins
+	retval.PinnedMessages = v.PinnedMessages
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// SpaceSpaceAdminsUser includes the requested fields of the GraphQL type User.
+type SpaceSpaceAdminsUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns SpaceSpaceAdminsUser.Id, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceAdminsUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns SpaceSpaceAdminsUser.Email, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceAdminsUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns SpaceSpaceAdminsUser.Name, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceAdminsUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns SpaceSpaceAdminsUser.Bot, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceAdminsUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns SpaceSpaceAdminsUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceAdminsUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *SpaceSpaceAdminsUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceSpaceAdminsUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceSpaceAdminsUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceSpaceAdminsUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *SpaceSpaceAdminsUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceSpaceAdminsUser) __premarshalJSON() (*__premarshalSpaceSpaceAdminsUser, error) {
+	var retval __premarshalSpaceSpaceAdminsUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// SpaceSpaceMembersUser includes the requested fields of the GraphQL type User.
+type SpaceSpaceMembersUser struct {
+	UserFields `json:"-"`
+}
+
+// GetId returns SpaceSpaceMembersUser.Id, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceMembersUser) GetId() string { return v.UserFields.Id }
+
+// GetEmail returns SpaceSpaceMembersUser.Email, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceMembersUser) GetEmail() string { return v.UserFields.Email }
+
+// GetName returns SpaceSpaceMembersUser.Name, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceMembersUser) GetName() string { return v.UserFields.Name }
+
+// GetBot returns SpaceSpaceMembersUser.Bot, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceMembersUser) GetBot() bool { return v.UserFields.Bot }
+
+// GetAvatarURL returns SpaceSpaceMembersUser.AvatarURL, and is useful for accessing the field via an interface.
+func (v *SpaceSpaceMembersUser) GetAvatarURL() *string { return v.UserFields.AvatarURL }
+
+func (v *SpaceSpaceMembersUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceSpaceMembersUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceSpaceMembersUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceSpaceMembersUser struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	Bot bool `json:"bot"`
+
+	AvatarURL *string `json:"avatarURL"`
+}
+
+func (v *SpaceSpaceMembersUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceSpaceMembersUser) __premarshalJSON() (*__premarshalSpaceSpaceMembersUser, error) {
+	var retval __premarshalSpaceSpaceMembersUser
+
+	retval.Id = v.UserFields.Id
+	retval.Email = v.UserFields.Email
+	retval.Name = v.UserFields.Name
+	retval.Bot = v.UserFields.Bot
+	retval.AvatarURL = v.UserFields.AvatarURL
+	return &retval, nil
+}
+
+// SpaceSpacePinnedMessagesMessage includes the requested fields of the GraphQL type Message.
+type SpaceSpacePinnedMessagesMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns SpaceSpacePinnedMessagesMessage.Id, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns SpaceSpacePinnedMessagesMessage.Content, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns SpaceSpacePinnedMessagesMessage.Format, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetFormat() MessageFormat { return v.MessageFields.Format }
+
+// GetHtml returns SpaceSpacePinnedMessagesMessage.Html, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns SpaceSpacePinnedMessagesMessage.User, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetUser() *MessageFieldsUser { return v.MessageFields.User }
+
+// GetSpace returns SpaceSpacePinnedMessagesMessage.Space, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetSpace() *MessageFieldsSpace {
+	return v.MessageFields.Space
+}
+
+// GetCreatedAt returns SpaceSpacePinnedMessagesMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetCreatedAt() time.Time { return v.MessageFields.CreatedAt }
+
+// GetExpiresAt returns SpaceSpacePinnedMessagesMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetExpiresAt() *time.Time { return v.MessageFields.ExpiresAt }
+
+// GetEphemeral returns SpaceSpacePinnedMessagesMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns SpaceSpacePinnedMessagesMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetFromBlockedUser() bool {
+	return v.MessageFields.FromBlockedUser
+}
+
+// GetClientMessageID returns SpaceSpacePinnedMessagesMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns SpaceSpacePinnedMessagesMessage.Seq, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns SpaceSpacePinnedMessagesMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns SpaceSpacePinnedMessagesMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns SpaceSpacePinnedMessagesMessage.Poll, and is useful for accessing the field via an interface.
+func (v *SpaceSpacePinnedMessagesMessage) GetPoll() *MessageFieldsPoll { return v.MessageFields.Poll }
+
+func (v *SpaceSpacePinnedMessagesMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpaceSpacePinnedMessagesMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpaceSpacePinnedMessagesMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpaceSpacePinnedMessagesMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *SpaceSpacePinnedMessagesMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpaceSpacePinnedMessagesMessage) __premarshalJSON() (*__premarshalSpaceSpacePinnedMessagesMessage, error) {
+	var retval __premarshalSpaceSpacePinnedMessagesMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// SpacesResponse is returned by Spaces on success.
+type SpacesResponse struct {
+	Spaces []*SpacesSpacesSpace `json:"spaces"`
+}
+
+// GetSpaces returns SpacesResponse.Spaces, and is useful for accessing the field via an interface.
+func (v *SpacesResponse) GetSpaces() []*SpacesSpacesSpace { return v.Spaces }
+
+// SpacesSpacesSpace includes the requested fields of the GraphQL type Space.
+type SpacesSpacesSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns SpacesSpacesSpace.Id, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns SpacesSpacesSpace.Name, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns SpacesSpacesSpace.Description, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetDescription() *string { return v.SpaceFields.Description }
+
+// GetMemberCount returns SpacesSpacesSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetMemberCount() int { return v.SpaceFields.MemberCount }
+
+// GetLastActivityAt returns SpacesSpacesSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetLastActivityAt() *time.Time { return v.SpaceFields.LastActivityAt }
+
+// GetIsMember returns SpacesSpacesSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetIsMember() bool { return v.SpaceFields.IsMember }
+
+// GetLastReadSeq returns SpacesSpacesSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetLastReadSeq() int64 { return v.SpaceFields.LastReadSeq }
+
+// GetUnreadCount returns SpacesSpacesSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetUnreadCount() int { return v.SpaceFields.UnreadCount }
+
+// GetMessageTTL returns SpacesSpacesSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetMessageTTL() *int { return v.SpaceFields.MessageTTL }
+
+// GetRetentionPolicy returns SpacesSpacesSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *SpacesSpacesSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *SpacesSpacesSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SpacesSpacesSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SpacesSpacesSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSpacesSpacesSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *SpacesSpacesSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SpacesSpacesSpace) __premarshalJSON() (*__premarshalSpacesSpacesSpace, error) {
+	var retval __premarshalSpacesSpacesSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// UnblockUserResponse is returned by UnblockUser on success.
+type UnblockUserResponse struct {
+	UnblockUser bool `json:"unblockUser"`
+}
+
+// GetUnblockUser returns UnblockUserResponse.UnblockUser, and is useful for accessing the field via an interface.
+func (v *UnblockUserResponse) GetUnblockUser() bool { return v.UnblockUser }
+
+// UnpinMessageResponse is returned by UnpinMessage on success.
+type UnpinMessageResponse struct {
+	UnpinMessage bool `json:"unpinMessage"`
+}
+
+// GetUnpinMessage returns UnpinMessageResponse.UnpinMessage, and is useful for accessing the field via an interface.
+func (v *UnpinMessageResponse) GetUnpinMessage() bool { return v.UnpinMessage }
+
+// UnsaveMessageResponse is returned by UnsaveMessage on success.
+type UnsaveMessageResponse struct {
+	UnsaveMessage bool `json:"unsaveMessage"`
+}
+
+// GetUnsaveMessage returns UnsaveMessageResponse.UnsaveMessage, and is useful for accessing the field via an interface.
+func (v *UnsaveMessageResponse) GetUnsaveMessage() bool { return v.UnsaveMessage }
+
+// UpdateAttachmentPolicyResponse is returned by UpdateAttachmentPolicy on success.
+type UpdateAttachmentPolicyResponse struct {
+	UpdateAttachmentPolicy *UpdateAttachmentPolicyUpdateAttachmentPolicy `json:"updateAttachmentPolicy"`
+}
+
+// GetUpdateAttachmentPolicy returns UpdateAttachmentPolicyResponse.UpdateAttachmentPolicy, and is useful for accessing the field via an interface.
+func (v *UpdateAttachmentPolicyResponse) GetUpdateAttachmentPolicy() *UpdateAttachmentPolicyUpdateAttachmentPolicy {
+	return v.UpdateAttachmentPolicy
+}
+
+// UpdateAttachmentPolicyUpdateAttachmentPolicy includes the requested fields of the GraphQL type AttachmentPolicy.
+type UpdateAttachmentPolicyUpdateAttachmentPolicy struct {
+	MaxSize   int64    `json:"maxSize"`
+	MimeTypes []string `json:"mimeTypes"`
+}
+
+// GetMaxSize returns UpdateAttachmentPolicyUpdateAttachmentPolicy.MaxSize, and is useful for accessing the field via an interface.
+func (v *UpdateAttachmentPolicyUpdateAttachmentPolicy) GetMaxSize() int64 { return v.MaxSize }
+
+// GetMimeTypes returns UpdateAttachmentPolicyUpdateAttachmentPolicy.MimeTypes, and is useful for accessing the field via an interface.
+func (v *UpdateAttachmentPolicyUpdateAttachmentPolicy) GetMimeTypes() []string { return v.MimeTypes }
+
+// UpdateRetentionPolicyResponse is returned by UpdateRetentionPolicy on success.
+type UpdateRetentionPolicyResponse struct {
+	UpdateRetentionPolicy *UpdateRetentionPolicyUpdateRetentionPolicySpace `json:"updateRetentionPolicy"`
+}
+
+// GetUpdateRetentionPolicy returns UpdateRetentionPolicyResponse.UpdateRetentionPolicy, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyResponse) GetUpdateRetentionPolicy() *UpdateRetentionPolicyUpdateRetentionPolicySpace {
+	return v.UpdateRetentionPolicy
+}
+
+// UpdateRetentionPolicyUpdateRetentionPolicySpace includes the requested fields of the GraphQL type Space.
+type UpdateRetentionPolicyUpdateRetentionPolicySpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns UpdateRetentionPolicyUpdateRetentionPolicySpace.Id, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns UpdateRetentionPolicyUpdateRetentionPolicySpace.Name, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns UpdateRetentionPolicyUpdateRetentionPolicySpace.Description, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetDescription() *string {
+	return v.SpaceFields.Description
+}
+
+// GetMemberCount returns UpdateRetentionPolicyUpdateRetentionPolicySpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetMemberCount() int {
+	return v.SpaceFields.MemberCount
+}
+
+// GetLastActivityAt returns UpdateRetentionPolicyUpdateRetentionPolicySpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetLastActivityAt() *time.Time {
+	return v.SpaceFields.LastActivityAt
+}
+
+// GetIsMember returns UpdateRetentionPolicyUpdateRetentionPolicySpace.IsMember, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetIsMember() bool {
+	return v.SpaceFields.IsMember
+}
+
+// GetLastReadSeq returns UpdateRetentionPolicyUpdateRetentionPolicySpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetLastReadSeq() int64 {
+	return v.SpaceFields.LastReadSeq
+}
+
+// GetUnreadCount returns UpdateRetentionPolicyUpdateRetentionPolicySpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetUnreadCount() int {
+	return v.SpaceFields.UnreadCount
+}
+
+// GetMessageTTL returns UpdateRetentionPolicyUpdateRetentionPolicySpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetMessageTTL() *int {
+	return v.SpaceFields.MessageTTL
+}
+
+// GetRetentionPolicy returns UpdateRetentionPolicyUpdateRetentionPolicySpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateRetentionPolicyUpdateRetentionPolicySpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateRetentionPolicyUpdateRetentionPolicySpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUpdateRetentionPolicyUpdateRetentionPolicySpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UpdateRetentionPolicyUpdateRetentionPolicySpace) __premarshalJSON() (*__premarshalUpdateRetentionPolicyUpdateRetentionPolicySpace, error) {
+	var retval __premarshalUpdateRetentionPolicyUpdateRetentionPolicySpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// UpdateSpaceMessageTTLResponse is returned by UpdateSpaceMessageTTL on success.
+type UpdateSpaceMessageTTLResponse struct {
+	UpdateSpaceMessageTTL *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace `json:"updateSpaceMessageTTL"`
+}
+
+// GetUpdateSpaceMessageTTL returns UpdateSpaceMessageTTLResponse.UpdateSpaceMessageTTL, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLResponse) GetUpdateSpaceMessageTTL() *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace {
+	return v.UpdateSpaceMessageTTL
+}
+
+// UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace includes the requested fields of the GraphQL type Space.
+type UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace struct {
+	SpaceFields `json:"-"`
+}
+
+// GetId returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.Id, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetId() string { return v.SpaceFields.Id }
+
+// GetName returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.Name, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetName() string { return v.SpaceFields.Name }
+
+// GetDescription returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.Description, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetDescription() *string {
+	return v.SpaceFields.Description
+}
+
+// GetMemberCount returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.MemberCount, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetMemberCount() int {
+	return v.SpaceFields.MemberCount
+}
+
+// GetLastActivityAt returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.LastActivityAt, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetLastActivityAt() *time.Time {
+	return v.SpaceFields.LastActivityAt
+}
+
+// GetIsMember returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.IsMember, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetIsMember() bool {
+	return v.SpaceFields.IsMember
+}
+
+// GetLastReadSeq returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.LastReadSeq, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetLastReadSeq() int64 {
+	return v.SpaceFields.LastReadSeq
+}
+
+// GetUnreadCount returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.UnreadCount, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetUnreadCount() int {
+	return v.SpaceFields.UnreadCount
+}
+
+// GetMessageTTL returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.MessageTTL, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetMessageTTL() *int {
+	return v.SpaceFields.MessageTTL
+}
+
+// GetRetentionPolicy returns UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace.RetentionPolicy, and is useful for accessing the field via an interface.
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) GetRetentionPolicy() *SpaceFieldsRetentionPolicy {
+	return v.SpaceFields.RetentionPolicy
+}
+
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SpaceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description *string `json:"description"`
+
+	MemberCount int `json:"memberCount"`
+
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+
+	IsMember bool `json:"isMember"`
+
+	LastReadSeq int64 `json:"lastReadSeq"`
+
+	UnreadCount int `json:"unreadCount"`
+
+	MessageTTL *int `json:"messageTTL"`
+
+	RetentionPolicy *SpaceFieldsRetentionPolicy `json:"retentionPolicy"`
+}
+
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace) __premarshalJSON() (*__premarshalUpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace, error) {
+	var retval __premarshalUpdateSpaceMessageTTLUpdateSpaceMessageTTLSpace
+
+	retval.Id = v.SpaceFields.Id
+	retval.Name = v.SpaceFields.Name
+	retval.Description = v.SpaceFields.Description
+	retval.MemberCount = v.SpaceFields.MemberCount
+	retval.LastActivityAt = v.SpaceFields.LastActivityAt
+	retval.IsMember = v.SpaceFields.IsMember
+	retval.LastReadSeq = v.SpaceFields.LastReadSeq
+	retval.UnreadCount = v.SpaceFields.UnreadCount
+	retval.MessageTTL = v.SpaceFields.MessageTTL
+	retval.RetentionPolicy = v.SpaceFields.RetentionPolicy
+	return &retval, nil
+}
+
+// UploadAttachmentResponse is returned by UploadAttachment on success.
+type UploadAttachmentResponse struct {
+	UploadAttachment *UploadAttachmentUploadAttachment `json:"uploadAttachment"`
+}
+
+// GetUploadAttachment returns UploadAttachmentResponse.UploadAttachment, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentResponse) GetUploadAttachment() *UploadAttachmentUploadAttachment {
+	return v.UploadAttachment
+}
+
+// UploadAttachmentUploadAttachment includes the requested fields of the GraphQL type Attachment.
+type UploadAttachmentUploadAttachment struct {
+	AttachmentFields `json:"-"`
+}
+
+// GetId returns UploadAttachmentUploadAttachment.Id, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetId() string { return v.AttachmentFields.Id }
+
+// GetFilename returns UploadAttachmentUploadAttachment.Filename, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetFilename() string { return v.AttachmentFields.Filename }
+
+// GetContentType returns UploadAttachmentUploadAttachment.ContentType, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetContentType() string {
+	return v.AttachmentFields.ContentType
+}
+
+// GetSize returns UploadAttachmentUploadAttachment.Size, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetSize() int64 { return v.AttachmentFields.Size }
+
+// GetUrl returns UploadAttachmentUploadAttachment.Url, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetUrl() *string { return v.AttachmentFields.Url }
+
+// GetStatus returns UploadAttachmentUploadAttachment.Status, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetStatus() AttachmentStatus {
+	return v.AttachmentFields.Status
+}
+
+// GetWidth returns UploadAttachmentUploadAttachment.Width, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetWidth() *int { return v.AttachmentFields.Width }
+
+// GetHeight returns UploadAttachmentUploadAttachment.Height, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetHeight() *int { return v.AttachmentFields.Height }
+
+// GetBlurhash returns UploadAttachmentUploadAttachment.Blurhash, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetBlurhash() *string { return v.AttachmentFields.Blurhash }
+
+// GetThumbnails returns UploadAttachmentUploadAttachment.Thumbnails, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetThumbnails() []*AttachmentFieldsThumbnailsAttachmentThumbnail {
+	return v.AttachmentFields.Thumbnails
+}
+
+// GetCreatedAt returns UploadAttachmentUploadAttachment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *UploadAttachmentUploadAttachment) GetCreatedAt() time.Time {
+	return v.AttachmentFields.CreatedAt
+}
+
+func (v *UploadAttachmentUploadAttachment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UploadAttachmentUploadAttachment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UploadAttachmentUploadAttachment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AttachmentFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUploadAttachmentUploadAttachment struct {
+	Id string `json:"id"`
+
+	Filename string `json:"filename"`
+
+	ContentType string `json:"contentType"`
+
+	Size int64 `json:"size"`
+
+	Url *string `json:"url"`
+
+	Status AttachmentStatus `json:"status"`
+
+	Width *int `json:"width"`
+
+	Height *int `json:"height"`
+
+	Blurhash *string `json:"blurhash"`
+
+	Thumbnails []*AttachmentFieldsThumbnailsAttachmentThumbnail `json:"thumbnails"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *UploadAttachmentUploadAttachment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UploadAttachmentUploadAttachment) __premarshalJSON() (*__premarshalUploadAttachmentUploadAttachment, error) {
+	var retval __premarshalUploadAttachmentUploadAttachment
+
+	retval.Id = v.AttachmentFields.Id
+	retval.Filename = v.AttachmentFields.Filename
+	retval.ContentType = v.AttachmentFields.ContentType
+	retval.Size = v.AttachmentFields.Size
+	retval.Url = v.AttachmentFields.Url
+	retval.Status = v.AttachmentFields.Status
+	retval.Width = v.AttachmentFields.Width
+	retval.Height = v.AttachmentFields.Height
+	retval.Blurhash = v.AttachmentFields.Blurhash
+	retval.Thumbnails = v.AttachmentFields.Thumbnails
+	retval.CreatedAt = v.AttachmentFields.CreatedAt
+	return &retval, nil
+}
+
+type UserEventType string
+
+const (
+	UserEventTypeSavedMessageReminder UserEventType = "SAVED_MESSAGE_REMINDER"
+	UserEventTypeEphemeralMessage     UserEventType = "EPHEMERAL_MESSAGE"
+	UserEventTypeMention              UserEventType = "MENTION"
+)
+
+var AllUserEventType = []UserEventType{
+	UserEventTypeSavedMessageReminder,
+	UserEventTypeEphemeralMessage,
+	UserEventTypeMention,
+}
+
+// UserEventsResponse is returned by UserEvents on success.
+type UserEventsResponse struct {
+	UserEvents *UserEventsUserEventsUserEvent `json:"userEvents"`
+}
+
+// GetUserEvents returns UserEventsResponse.UserEvents, and is useful for accessing the field via an interface.
+func (v *UserEventsResponse) GetUserEvents() *UserEventsUserEventsUserEvent { return v.UserEvents }
+
+// UserEventsUserEventsUserEvent includes the requested fields of the GraphQL type UserEvent.
+type UserEventsUserEventsUserEvent struct {
+	Type         UserEventType                              `json:"type"`
+	SavedMessage *UserEventsUserEventsUserEventSavedMessage `json:"savedMessage"`
+	Message      *UserEventsUserEventsUserEventMessage      `json:"message"`
+}
+
+// GetType returns UserEventsUserEventsUserEvent.Type, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEvent) GetType() UserEventType { return v.Type }
+
+// GetSavedMessage returns UserEventsUserEventsUserEvent.SavedMessage, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEvent) GetSavedMessage() *UserEventsUserEventsUserEventSavedMessage {
+	return v.SavedMessage
+}
+
+// GetMessage returns UserEventsUserEventsUserEvent.Message, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEvent) GetMessage() *UserEventsUserEventsUserEventMessage {
+	return v.Message
+}
+
+// UserEventsUserEventsUserEventMessage includes the requested fields of the GraphQL type Message.
+type UserEventsUserEventsUserEventMessage struct {
+	MessageFields `json:"-"`
+}
+
+// GetId returns UserEventsUserEventsUserEventMessage.Id, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetId() string { return v.MessageFields.Id }
+
+// GetContent returns UserEventsUserEventsUserEventMessage.Content, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetContent() string { return v.MessageFields.Content }
+
+// GetFormat returns UserEventsUserEventsUserEventMessage.Format, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetFormat() MessageFormat {
+	return v.MessageFields.Format
+}
+
+// GetHtml returns UserEventsUserEventsUserEventMessage.Html, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetHtml() string { return v.MessageFields.Html }
+
+// GetUser returns UserEventsUserEventsUserEventMessage.User, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetUser() *MessageFieldsUser {
+	return v.MessageFields.User
+}
+
+// GetSpace returns UserEventsUserEventsUserEventMessage.Space, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetSpace() *MessageFieldsSpace {
+	return v.MessageFields.Space
+}
+
+// GetCreatedAt returns UserEventsUserEventsUserEventMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetCreatedAt() time.Time {
+	return v.MessageFields.CreatedAt
+}
+
+// GetExpiresAt returns UserEventsUserEventsUserEventMessage.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetExpiresAt() *time.Time {
+	return v.MessageFields.ExpiresAt
+}
+
+// GetEphemeral returns UserEventsUserEventsUserEventMessage.Ephemeral, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetEphemeral() bool { return v.MessageFields.Ephemeral }
+
+// GetFromBlockedUser returns UserEventsUserEventsUserEventMessage.FromBlockedUser, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetFromBlockedUser() bool {
+	return v.MessageFields.FromBlockedUser
+}
+
+// GetClientMessageID returns UserEventsUserEventsUserEventMessage.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetClientMessageID() *string {
+	return v.MessageFields.ClientMessageID
+}
+
+// GetSeq returns UserEventsUserEventsUserEventMessage.Seq, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetSeq() *int64 { return v.MessageFields.Seq }
+
+// GetAttachments returns UserEventsUserEventsUserEventMessage.Attachments, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetAttachments() []*MessageFieldsAttachmentsAttachment {
+	return v.MessageFields.Attachments
+}
+
+// GetLinkPreviews returns UserEventsUserEventsUserEventMessage.LinkPreviews, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetLinkPreviews() []*MessageFieldsLinkPreviewsLinkPreview {
+	return v.MessageFields.LinkPreviews
+}
+
+// GetPoll returns UserEventsUserEventsUserEventMessage.Poll, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventMessage) GetPoll() *MessageFieldsPoll {
+	return v.MessageFields.Poll
+}
+
+func (v *UserEventsUserEventsUserEventMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UserEventsUserEventsUserEventMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UserEventsUserEventsUserEventMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUserEventsUserEventsUserEventMessage struct {
+	Id string `json:"id"`
+
+	Content string `json:"content"`
+
+	Format MessageFormat `json:"format"`
+
+	Html string `json:"html"`
+
+	User *MessageFieldsUser `json:"user"`
+
+	Space *MessageFieldsSpace `json:"space"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	Ephemeral bool `json:"ephemeral"`
+
+	FromBlockedUser bool `json:"fromBlockedUser"`
+
+	ClientMessageID *string `json:"clientMessageID"`
+
+	Seq *int64 `json:"seq"`
+
+	Attachments []*MessageFieldsAttachmentsAttachment `json:"attachments"`
+
+	LinkPreviews []*MessageFieldsLinkPreviewsLinkPreview `json:"linkPreviews"`
+
+	Poll *MessageFieldsPoll `json:"poll"`
+}
+
+func (v *UserEventsUserEventsUserEventMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UserEventsUserEventsUserEventMessage) __premarshalJSON() (*__premarshalUserEventsUserEventsUserEventMessage, error) {
+	var retval __premarshalUserEventsUserEventsUserEventMessage
+
+	retval.Id = v.MessageFields.Id
+	retval.Content = v.MessageFields.Content
+	retval.Format = v.MessageFields.Format
+	retval.Html = v.MessageFields.Html
+	retval.User = v.MessageFields.User
+	retval.Space = v.MessageFields.Space
+	retval.CreatedAt = v.MessageFields.CreatedAt
+	retval.ExpiresAt = v.MessageFields.ExpiresAt
+	retval.Ephemeral = v.MessageFields.Ephemeral
+	retval.FromBlockedUser = v.MessageFields.FromBlockedUser
+	retval.ClientMessageID = v.MessageFields.ClientMessageID
+	retval.Seq = v.MessageFields.Seq
+	retval.Attachments = v.MessageFields.Attachments
+	retval.LinkPreviews = v.MessageFields.LinkPreviews
+	retval.Poll = v.MessageFields.Poll
+	return &retval, nil
+}
+
+// UserEventsUserEventsUserEventSavedMessage includes the requested fields of the GraphQL type SavedMessage.
+type UserEventsUserEventsUserEventSavedMessage struct {
+	SavedMessageFields `json:"-"`
+}
+
+// GetId returns UserEventsUserEventsUserEventSavedMessage.Id, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventSavedMessage) GetId() string { return v.SavedMessageFields.Id }
+
+// GetMessage returns UserEventsUserEventsUserEventSavedMessage.Message, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventSavedMessage) GetMessage() *SavedMessageFieldsMessage {
+	return v.SavedMessageFields.Message
+}
+
+// GetNote returns UserEventsUserEventsUserEventSavedMessage.Note, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventSavedMessage) GetNote() *string {
+	return v.SavedMessageFields.Note
+}
+
+// GetRemindAt returns UserEventsUserEventsUserEventSavedMessage.RemindAt, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventSavedMessage) GetRemindAt() *time.Time {
+	return v.SavedMessageFields.RemindAt
+}
+
+// GetCreatedAt returns UserEventsUserEventsUserEventSavedMessage.CreatedAt, and is useful for accessing the field via an interface.
+func (v *UserEventsUserEventsUserEventSavedMessage) GetCreatedAt() time.Time {
+	return v.SavedMessageFields.CreatedAt
+}
+
+func (v *UserEventsUserEventsUserEventSavedMessage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UserEventsUserEventsUserEventSavedMessage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UserEventsUserEventsUserEventSavedMessage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SavedMessageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUserEventsUserEventsUserEventSavedMessage struct {
+	Id string `json:"id"`
+
+	Message *SavedMessageFieldsMessage `json:"message"`
+
+	Note *string `json:"note"`
+
+	RemindAt *time.Time `json:"remindAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *UserEventsUserEventsUserEventSavedMessage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UserEventsUserEventsUserEventSavedMessage) __premarshalJSON() (*__premarshalUserEventsUserEventsUserEventSavedMessage, error) {
+	var retval __premarshalUserEventsUserEventsUserEventSavedMessage
+
+	retval.Id = v.SavedMessageFields.Id
+	retval.Message = v.SavedMessageFields.Message
+	retval.Note = v.SavedMessageFields.Note
+	retval.RemindAt = v.SavedMessageFields.RemindAt
+	retval.CreatedAt = v.SavedMessageFields.CreatedAt
+	return &retval, nil
+}
+
+// UserFields includes the GraphQL fields of User requested by the fragment UserFields.
+type UserFields struct {
+	Id        string  `json:"id"`
+	Email     string  `json:"email"`
+	Name      string  `json:"name"`
+	Bot       bool    `json:"bot"`
+	AvatarURL *string `json:"avatarURL"`
+}
+
+// GetId returns UserFields.Id, and is useful for accessing the field via an interface.
+func (v *UserFields) GetId() string { return v.Id }
+
+// GetEmail returns UserFields.Email, and is useful for accessing the field via an interface.
+func (v *UserFields) GetEmail() string { return v.Email }
+
+// GetName returns UserFields.Name, and is useful for accessing the field via an interface.
+func (v *UserFields) GetName() string { return v.Name }
+
+// GetBot returns UserFields.Bot, and is useful for accessing the field via an interface.
+func (v *UserFields) GetBot() bool { return v.Bot }
+
+// GetAvatarURL returns UserFields.AvatarURL, and is useful for accessing the field via an interface.
+func (v *UserFields) GetAvatarURL() *string { return v.AvatarURL }
+
+// VotePollResponse is returned by VotePoll on success.
+type VotePollResponse struct {
+	VotePoll *VotePollVotePoll `json:"votePoll"`
+}
+
+// GetVotePoll returns VotePollResponse.VotePoll, and is useful for accessing the field via an interface.
+func (v *VotePollResponse) GetVotePoll() *VotePollVotePoll { return v.VotePoll }
+
+// VotePollVotePoll includes the requested fields of the GraphQL type Poll.
+type VotePollVotePoll struct {
+	PollFields `json:"-"`
+}
+
+// GetId returns VotePollVotePoll.Id, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetId() string { return v.PollFields.Id }
+
+// GetQuestion returns VotePollVotePoll.Question, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetQuestion() string { return v.PollFields.Question }
+
+// GetOptions returns VotePollVotePoll.Options, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetOptions() []*PollFieldsOptionsPollOption { return v.PollFields.Options }
+
+// GetMultiChoice returns VotePollVotePoll.MultiChoice, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetMultiChoice() bool { return v.PollFields.MultiChoice }
+
+// GetAnonymous returns VotePollVotePoll.Anonymous, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetAnonymous() bool { return v.PollFields.Anonymous }
+
+// GetClosesAt returns VotePollVotePoll.ClosesAt, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetClosesAt() *time.Time { return v.PollFields.ClosesAt }
+
+// GetClosed returns VotePollVotePoll.Closed, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetClosed() bool { return v.PollFields.Closed }
+
+// GetTotalVoters returns VotePollVotePoll.TotalVoters, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetTotalVoters() int { return v.PollFields.TotalVoters }
+
+// GetMyVotes returns VotePollVotePoll.MyVotes, and is useful for accessing the field via an interface.
+func (v *VotePollVotePoll) GetMyVotes() []string { return v.PollFields.MyVotes }
+
+func (v *VotePollVotePoll) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*VotePollVotePoll
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.VotePollVotePoll = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PollFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalVotePollVotePoll struct {
+	Id string `json:"id"`
+
+	Question string `json:"question"`
+
+	Options []*PollFieldsOptionsPollOption `json:"options"`
+
+	MultiChoice bool `json:"multiChoice"`
+
+	Anonymous bool `json:"anonymous"`
+
+	ClosesAt *time.Time `json:"closesAt"`
+
+	Closed bool `json:"closed"`
+
+	TotalVoters int `json:"totalVoters"`
+
+	MyVotes []string `json:"myVotes"`
+}
+
+func (v *VotePollVotePoll) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *VotePollVotePoll) __premarshalJSON() (*__premarshalVotePollVotePoll, error) {
+	var retval __premarshalVotePollVotePoll
+
+	retval.Id = v.PollFields.Id
+	retval.Question = v.PollFields.Question
+	retval.Options = v.PollFields.Options
+	retval.MultiChoice = v.PollFields.MultiChoice
+	retval.Anonymous = v.PollFields.Anonymous
+	retval.ClosesAt = v.PollFields.ClosesAt
+	retval.Closed = v.PollFields.Closed
+	retval.TotalVoters = v.PollFields.TotalVoters
+	retval.MyVotes = v.PollFields.MyVotes
+	return &retval, nil
+}
+
+// WebhookDeliveriesResponse is returned by WebhookDeliveries on success.
+type WebhookDeliveriesResponse struct {
+	WebhookDeliveries *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection `json:"webhookDeliveries"`
+}
+
+// GetWebhookDeliveries returns WebhookDeliveriesResponse.WebhookDeliveries, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesResponse) GetWebhookDeliveries() *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection {
+	return v.WebhookDeliveries
+}
+
+// WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection includes the requested fields of the GraphQL type WebhookDeliveryConnection.
+type WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection struct {
+	Edges    []*WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery `json:"edges"`
+	PageInfo *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo               `json:"pageInfo"`
+}
+
+// GetEdges returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection.Edges, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection) GetEdges() []*WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery {
+	return v.Edges
+}
+
+// GetPageInfo returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnection) GetPageInfo() *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo {
+	return v.PageInfo
+}
+
+// WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery includes the requested fields of the GraphQL type WebhookDelivery.
+type WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery struct {
+	WebhookDeliveryFields `json:"-"`
+}
+
+// GetId returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.Id, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetId() string {
+	return v.WebhookDeliveryFields.Id
+}
+
+// GetWebhookID returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.WebhookID, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetWebhookID() string {
+	return v.WebhookDeliveryFields.WebhookID
+}
+
+// GetEventType returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.EventType, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetEventType() WebhookEventType {
+	return v.WebhookDeliveryFields.EventType
+}
+
+// GetPayload returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.Payload, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetPayload() string {
+	return v.WebhookDeliveryFields.Payload
+}
+
+// GetStatus returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.Status, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return v.WebhookDeliveryFields.Status
+}
+
+// GetAttempts returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.Attempts, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetAttempts() int {
+	return v.WebhookDeliveryFields.Attempts
+}
+
+// GetNextAttemptAt returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.NextAttemptAt, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetNextAttemptAt() *time.Time {
+	return v.WebhookDeliveryFields.NextAttemptAt
+}
+
+// GetResponseStatus returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.ResponseStatus, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetResponseStatus() *int {
+	return v.WebhookDeliveryFields.ResponseStatus
+}
+
+// GetError returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.Error, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetError() *string {
+	return v.WebhookDeliveryFields.Error
+}
+
+// GetDeliveredAt returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.DeliveredAt, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetDeliveredAt() *time.Time {
+	return v.WebhookDeliveryFields.DeliveredAt
+}
+
+// GetRedeliveryOf returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.RedeliveryOf, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetRedeliveryOf() *string {
+	return v.WebhookDeliveryFields.RedeliveryOf
+}
+
+// GetCreatedAt returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery.CreatedAt, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) GetCreatedAt() time.Time {
+	return v.WebhookDeliveryFields.CreatedAt
+}
+
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WebhookDeliveryFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalWebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery struct {
+	Id string `json:"id"`
+
+	WebhookID string `json:"webhookID"`
+
+	EventType WebhookEventType `json:"eventType"`
+
+	Payload string `json:"payload"`
+
+	Status WebhookDeliveryStatus `json:"status"`
+
+	Attempts int `json:"attempts"`
+
+	NextAttemptAt *time.Time `json:"nextAttemptAt"`
+
+	ResponseStatus *int `json:"responseStatus"`
+
+	Error *string `json:"error"`
+
+	DeliveredAt *time.Time `json:"deliveredAt"`
+
+	RedeliveryOf *string `json:"redeliveryOf"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery) __premarshalJSON() (*__premarshalWebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery, error) {
+	var retval __premarshalWebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionEdgesWebhookDelivery
+
+	retval.Id = v.WebhookDeliveryFields.Id
+	retval.WebhookID = v.WebhookDeliveryFields.WebhookID
+	retval.EventType = v.WebhookDeliveryFields.EventType
+	retval.Payload = v.WebhookDeliveryFields.Payload
+	retval.Status = v.WebhookDeliveryFields.Status
+	retval.Attempts = v.WebhookDeliveryFields.Attempts
+	retval.NextAttemptAt = v.WebhookDeliveryFields.NextAttemptAt
+	retval.ResponseStatus = v.WebhookDeliveryFields.ResponseStatus
+	retval.Error = v.WebhookDeliveryFields.Error
+	retval.DeliveredAt = v.WebhookDeliveryFields.DeliveredAt
+	retval.RedeliveryOf = v.WebhookDeliveryFields.RedeliveryOf
+	retval.CreatedAt = v.WebhookDeliveryFields.CreatedAt
+	return &retval, nil
+}
+
+// WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo struct {
+	PageInfoFields `json:"-"`
+}
+
+// GetEndCursor returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoFields.EndCursor
+}
+
+// GetHasNextPage returns WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoFields.HasNextPage
+}
+
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalWebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *WebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo) __premarshalJSON() (*__premarshalWebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo, error) {
+	var retval __premarshalWebhookDeliveriesWebhookDeliveriesWebhookDeliveryConnectionPageInfo
+
+	retval.EndCursor = v.PageInfoFields.EndCursor
+	retval.HasNextPage = v.PageInfoFields.HasNextPage
+	return &retval, nil
+}
+
+// WebhookDeliveryFields includes the GraphQL fields of WebhookDelivery requested by the fragment WebhookDeliveryFields.
+type WebhookDeliveryFields struct {
+	Id             string                `json:"id"`
+	WebhookID      string                `json:"webhookID"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"nextAttemptAt"`
+	ResponseStatus *int                  `json:"responseStatus"`
+	Error          *string               `json:"error"`
+	DeliveredAt    *time.Time            `json:"deliveredAt"`
+	RedeliveryOf   *string               `json:"redeliveryOf"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+// GetId returns WebhookDeliveryFields.Id, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetId() string { return v.Id }
+
+// GetWebhookID returns WebhookDeliveryFields.WebhookID, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetWebhookID() string { return v.WebhookID }
+
+// GetEventType returns WebhookDeliveryFields.EventType, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetEventType() WebhookEventType { return v.EventType }
+
+// GetPayload returns WebhookDeliveryFields.Payload, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetPayload() string { return v.Payload }
+
+// GetStatus returns WebhookDeliveryFields.Status, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetStatus() WebhookDeliveryStatus { return v.Status }
+
+// GetAttempts returns WebhookDeliveryFields.Attempts, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetAttempts() int { return v.Attempts }
+
+// GetNextAttemptAt returns WebhookDeliveryFields.NextAttemptAt, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetNextAttemptAt() *time.Time { return v.NextAttemptAt }
+
+// GetResponseStatus returns WebhookDeliveryFields.ResponseStatus, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetResponseStatus() *int { return v.ResponseStatus }
+
+// GetError returns WebhookDeliveryFields.Error, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetError() *string { return v.Error }
+
+// GetDeliveredAt returns WebhookDeliveryFields.DeliveredAt, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetDeliveredAt() *time.Time { return v.DeliveredAt }
+
+// GetRedeliveryOf returns WebhookDeliveryFields.RedeliveryOf, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetRedeliveryOf() *string { return v.RedeliveryOf }
+
+// GetCreatedAt returns WebhookDeliveryFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *WebhookDeliveryFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeMessageCreated WebhookEventType = "MESSAGE_CREATED"
+	WebhookEventTypeMessageDeleted WebhookEventType = "MESSAGE_DELETED"
+	WebhookEventTypeMemberJoined   WebhookEventType = "MEMBER_JOINED"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeMessageCreated,
+	WebhookEventTypeMessageDeleted,
+	WebhookEventTypeMemberJoined,
+}
+
+// WebhookFields includes the GraphQL fields of Webhook requested by the fragment WebhookFields.
+type WebhookFields struct {
+	Id        string             `json:"id"`
+	SpaceID   string             `json:"spaceID"`
+	Url       string             `json:"url"`
+	Events    []WebhookEventType `json:"events"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+// GetId returns WebhookFields.Id, and is useful for accessing the field via an interface.
+func (v *WebhookFields) GetId() string { return v.Id }
+
+// GetSpaceID returns WebhookFields.SpaceID, and is useful for accessing the field via an interface.
+func (v *WebhookFields) GetSpaceID() string { return v.SpaceID }
+
+// GetUrl returns WebhookFields.Url, and is useful for accessing the field via an interface.
+func (v *WebhookFields) GetUrl() string { return v.Url }
+
+// GetEvents returns WebhookFields.Events, and is useful for accessing the field via an interface.
+func (v *WebhookFields) GetEvents() []WebhookEventType { return v.Events }
+
+// GetCreatedAt returns WebhookFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *WebhookFields) GetCreatedAt() time.Time { return v.CreatedAt }
+
+type WebhookRequest struct {
+	Url    string             `json:"url"`
+	Events []WebhookEventType `json:"events"`
+}
+
+// GetUrl returns WebhookRequest.Url, and is useful for accessing the field via an interface.
+func (v *WebhookRequest) GetUrl() string { return v.Url }
+
+// GetEvents returns WebhookRequest.Events, and is useful for accessing the field via an interface.
+func (v *WebhookRequest) GetEvents() []WebhookEventType { return v.Events }
+
+// WebhooksResponse is returned by Webhooks on success.
+type WebhooksResponse struct {
+	Webhooks []*WebhooksWebhooksWebhook `json:"webhooks"`
+}
+
+// GetWebhooks returns WebhooksResponse.Webhooks, and is useful for accessing the field via an interface.
+func (v *WebhooksResponse) GetWebhooks() []*WebhooksWebhooksWebhook { return v.Webhooks }
+
+// WebhooksWebhooksWebhook includes the requested fields of the GraphQL type Webhook.
+type WebhooksWebhooksWebhook struct {
+	WebhookFields `json:"-"`
+}
+
+// GetId returns WebhooksWebhooksWebhook.Id, and is useful for accessing the field via an interface.
+func (v *WebhooksWebhooksWebhook) GetId() string { return v.WebhookFields.Id }
+
+// GetSpaceID returns WebhooksWebhooksWebhook.SpaceID, and is useful for accessing the field via an interface.
+func (v *WebhooksWebhooksWebhook) GetSpaceID() string { return v.WebhookFields.SpaceID }
+
+// GetUrl returns WebhooksWebhooksWebhook.Url, and is useful for accessing the field via an interface.
+func (v *WebhooksWebhooksWebhook) GetUrl() string { return v.WebhookFields.Url }
+
+// GetEvents returns WebhooksWebhooksWebhook.Events, and is useful for accessing the field via an interface.
+func (v *WebhooksWebhooksWebhook) GetEvents() []WebhookEventType { return v.WebhookFields.Events }
+
+// GetCreatedAt returns WebhooksWebhooksWebhook.CreatedAt, and is useful for accessing the field via an interface.
+func (v *WebhooksWebhooksWebhook) GetCreatedAt() time.Time { return v.WebhookFields.CreatedAt }
+
+func (v *WebhooksWebhooksWebhook) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*WebhooksWebhooksWebhook
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.WebhooksWebhooksWebhook = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WebhookFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalWebhooksWebhooksWebhook struct {
+	Id string `json:"id"`
+
+	SpaceID string `json:"spaceID"`
+
+	Url string `json:"url"`
+
+	Events []WebhookEventType `json:"events"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *WebhooksWebhooksWebhook) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *WebhooksWebhooksWebhook) __premarshalJSON() (*__premarshalWebhooksWebhooksWebhook, error) {
+	var retval __premarshalWebhooksWebhooksWebhook
+
+	retval.Id = v.WebhookFields.Id
+	retval.SpaceID = v.WebhookFields.SpaceID
+	retval.Url = v.WebhookFields.Url
+	retval.Events = v.WebhookFields.Events
+	retval.CreatedAt = v.WebhookFields.CreatedAt
+	return &retval, nil
+}
+
+// __AttachmentPolicyInput is used internally by genqlient
+type __AttachmentPolicyInput struct {
+	SpaceID string `json:"spaceID"`
+}
+
+// GetSpaceID returns __AttachmentPolicyInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__AttachmentPolicyInput) GetSpaceID() string { return v.SpaceID }
+
+// __BlockUserInput is used internally by genqlient
+type __BlockUserInput struct {
+	UserID string `json:"userID"`
+}
+
+// GetUserID returns __BlockUserInput.UserID, and is useful for accessing the field via an interface.
+func (v *__BlockUserInput) GetUserID() string { return v.UserID }
+
+// __BotAccessTokenInput is used internally by genqlient
+type __BotAccessTokenInput struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns __BotAccessTokenInput.Token, and is useful for accessing the field via an interface.
+func (v *__BotAccessTokenInput) GetToken() string { return v.Token }
+
+// __CancelScheduledMessageInput is used internally by genqlient
+type __CancelScheduledMessageInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __CancelScheduledMessageInput.Id, and is useful for accessing the field via an interface.
+func (v *__CancelScheduledMessageInput) GetId() string { return v.Id }
+
+// __ClosePollInput is used internally by genqlient
+type __ClosePollInput struct {
+	PollID string `json:"pollID"`
+}
+
+// GetPollID returns __ClosePollInput.PollID, and is useful for accessing the field via an interface.
+func (v *__ClosePollInput) GetPollID() string { return v.PollID }
+
+// __CreateBotInput is used internally by genqlient
+type __CreateBotInput struct {
+	SpaceID string  `json:"spaceID"`
+	Name    string  `json:"name"`
+	Avatar  *string `json:"avatar"`
+}
+
+// GetSpaceID returns __CreateBotInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__CreateBotInput) GetSpaceID() string { return v.SpaceID }
+
+// GetName returns __CreateBotInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateBotInput) GetName() string { return v.Name }
+
+// GetAvatar returns __CreateBotInput.Avatar, and is useful for accessing the field via an interface.
+func (v *__CreateBotInput) GetAvatar() *string { return v.Avatar }
+
+// __CreateIncomingWebhookInput is used internally by genqlient
+type __CreateIncomingWebhookInput struct {
+	SpaceID string  `json:"spaceID"`
+	Name    string  `json:"name"`
+	Avatar  *string `json:"avatar"`
+}
+
+// GetSpaceID returns __CreateIncomingWebhookInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__CreateIncomingWebhookInput) GetSpaceID() string { return v.SpaceID }
+
+// GetName returns __CreateIncomingWebhookInput.Name, and is useful for accessing the field via an interface.
+func (v *__CreateIncomingWebhookInput) GetName() string { return v.Name }
+
+// GetAvatar returns __CreateIncomingWebhookInput.Avatar, and is useful for accessing the field via an interface.
+func (v *__CreateIncomingWebhookInput) GetAvatar() *string { return v.Avatar }
+
+// __CreatePollInput is used internally by genqlient
+type __CreatePollInput struct {
+	Request *PollRequest `json:"request,omitempty"`
+}
+
+// GetRequest returns __CreatePollInput.Request, and is useful for accessing the field via an interface.
+func (v *__CreatePollInput) GetRequest() *PollRequest { return v.Request }
+
+// __CreateSpaceInput is used internally by genqlient
+type __CreateSpaceInput struct {
+	Request *SpaceRequest `json:"request,omitempty"`
+}
+
+// GetRequest returns __CreateSpaceInput.Request, and is useful for accessing the field via an interface.
+func (v *__CreateSpaceInput) GetRequest() *SpaceRequest { return v.Request }
+
+// __CreateWebhookInput is used internally by genqlient
+type __CreateWebhookInput struct {
+	SpaceID string          `json:"spaceID"`
+	Request *WebhookRequest `json:"request,omitempty"`
+}
+
+// GetSpaceID returns __CreateWebhookInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__CreateWebhookInput) GetSpaceID() string { return v.SpaceID }
+
+// GetRequest returns __CreateWebhookInput.Request, and is useful for accessing the field via an interface.
+func (v *__CreateWebhookInput) GetRequest() *WebhookRequest { return v.Request }
+
+// __DeleteSlashCommandInput is used internally by genqlient
+type __DeleteSlashCommandInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeleteSlashCommandInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeleteSlashCommandInput) GetId() string { return v.Id }
+
+// __DeleteWebhookInput is used internally by genqlient
+type __DeleteWebhookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeleteWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeleteWebhookInput) GetId() string { return v.Id }
+
+// __IncomingWebhooksInput is used internally by genqlient
+type __IncomingWebhooksInput struct {
+	SpaceID string `json:"spaceID"`
+}
+
+// GetSpaceID returns __IncomingWebhooksInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__IncomingWebhooksInput) GetSpaceID() string { return v.SpaceID }
+
+// __JoinSpaceInput is used internally by genqlient
+type __JoinSpaceInput struct {
+	SpaceID string `json:"spaceID"`
+}
+
+// GetSpaceID returns __JoinSpaceInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__JoinSpaceInput) GetSpaceID() string { return v.SpaceID }
+
+// __LoginInput is used internally by genqlient
+type __LoginInput struct {
+	Request *LoginRequest `json:"request,omitempty"`
+}
+
+// GetRequest returns __LoginInput.Request, and is useful for accessing the field via an interface.
+func (v *__LoginInput) GetRequest() *LoginRequest { return v.Request }
+
+// __MarkSpaceReadInput is used internally by genqlient
+type __MarkSpaceReadInput struct {
+	SpaceID string `json:"spaceID"`
+	Seq     int64  `json:"seq"`
+}
+
+// GetSpaceID returns __MarkSpaceReadInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__MarkSpaceReadInput) GetSpaceID() string { return v.SpaceID }
+
+// GetSeq returns __MarkSpaceReadInput.Seq, and is useful for accessing the field via an interface.
+func (v *__MarkSpaceReadInput) GetSeq() int64 { return v.Seq }
+
+// __MessageSentInput is used internally by genqlient
+type __MessageSentInput struct {
+	SpaceID string  `json:"spaceID"`
+	Since   *string `json:"since"`
+}
+
+// GetSpaceID returns __MessageSentInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__MessageSentInput) GetSpaceID() string { return v.SpaceID }
+
+// GetSince returns __MessageSentInput.Since, and is useful for accessing the field via an interface.
+func (v *__MessageSentInput) GetSince() *string { return v.Since }
+
+// __MessagesInput is used internally by genqlient
+type __MessagesInput struct {
+	SpaceID string `json:"spaceID"`
+	First   *int   `json:"first"`
+	Before  *int64 `json:"before"`
+	After   *int64 `json:"after"`
+}
+
+// GetSpaceID returns __MessagesInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__MessagesInput) GetSpaceID() string { return v.SpaceID }
+
+// GetFirst returns __MessagesInput.First, and is useful for accessing the field via an interface.
+func (v *__MessagesInput) GetFirst() *int { return v.First }
+
+// GetBefore returns __MessagesInput.Before, and is useful for accessing the field via an interface.
+func (v *__MessagesInput) GetBefore() *int64 { return v.Before }
+
+// GetAfter returns __MessagesInput.After, and is useful for accessing the field via an interface.
+func (v *__MessagesInput) GetAfter() *int64 { return v.After }
+
+// __PinMessageInput is used internally by genqlient
+type __PinMessageInput struct {
+	MessageID string `json:"messageID"`
+}
+
+// GetMessageID returns __PinMessageInput.MessageID, and is useful for accessing the field via an interface.
+func (v *__PinMessageInput) GetMessageID() string { return v.MessageID }
+
+// __RedeliverWebhookInput is used internally by genqlient
+type __RedeliverWebhookInput struct {
+	DeliveryID string `json:"deliveryID"`
+}
+
+// GetDeliveryID returns __RedeliverWebhookInput.DeliveryID, and is useful for accessing the field via an interface.
+func (v *__RedeliverWebhookInput) GetDeliveryID() string { return v.DeliveryID }
+
+// __RefreshTokenInput is used internally by genqlient
+type __RefreshTokenInput struct {
+	Request *RefreshRequest `json:"request,omitempty"`
+}
+
+// GetRequest returns __RefreshTokenInput.Request, and is useful for accessing the field via an interface.
+func (v *__RefreshTokenInput) GetRequest() *RefreshRequest { return v.Request }
+
+// __RegisterInput is used internally by genqlient
+type __RegisterInput struct {
+	Request *RegisterRequest `json:"request,omitempty"`
+}
+
+// GetRequest returns __RegisterInput.Request, and is useful for accessing the field via an interface.
+func (v *__RegisterInput) GetRequest() *RegisterRequest { return v.Request }
+
+// __RegisterSlashCommandInput is used internally by genqlient
+type __RegisterSlashCommandInput struct {
+	SpaceID string               `json:"spaceID"`
+	Request *SlashCommandRequest `json:"request,omitempty"`
+}
+
+// GetSpaceID returns __RegisterSlashCommandInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__RegisterSlashCommandInput) GetSpaceID() string { return v.SpaceID }
+
+// GetRequest returns __RegisterSlashCommandInput.Request, and is useful for accessing the field via an interface.
+func (v *__RegisterSlashCommandInput) GetRequest() *SlashCommandRequest { return v.Request }
+
+// __RevokeIncomingWebhookInput is used internally by genqlient
+type __RevokeIncomingWebhookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __RevokeIncomingWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *__RevokeIncomingWebhookInput) GetId() string { return v.Id }
+
+// __RotateBotTokenInput is used internally by genqlient
+type __RotateBotTokenInput struct {
+	SpaceID string `json:"spaceID"`
+	BotID   string `json:"botID"`
+}
+
+// GetSpaceID returns __RotateBotTokenInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__RotateBotTokenInput) GetSpaceID() string { return v.SpaceID }
+
+// GetBotID returns __RotateBotTokenInput.BotID, and is useful for accessing the field via an interface.
+func (v *__RotateBotTokenInput) GetBotID() string { return v.BotID }
+
+// __SaveMessageInput is used internally by genqlient
+type __SaveMessageInput struct {
+	MessageID string     `json:"messageID"`
+	Note      *string    `json:"note"`
+	RemindAt  *time.Time `json:"remindAt"`
+}
+
+// GetMessageID returns __SaveMessageInput.MessageID, and is useful for accessing the field via an interface.
+func (v *__SaveMessageInput) GetMessageID() string { return v.MessageID }
+
+// GetNote returns __SaveMessageInput.Note, and is useful for accessing the field via an interface.
+func (v *__SaveMessageInput) GetNote() *string { return v.Note }
+
+// GetRemindAt returns __SaveMessageInput.RemindAt, and is useful for accessing the field via an interface.
+func (v *__SaveMessageInput) GetRemindAt() *time.Time { return v.RemindAt }
+
+// __SavedMessagesInput is used internally by genqlient
+type __SavedMessagesInput struct {
+	First *int    `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __SavedMessagesInput.First, and is useful for accessing the field via an interface.
+func (v *__SavedMessagesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __SavedMessagesInput.After, and is useful for accessing the field via an interface.
+func (v *__SavedMessagesInput) GetAfter() *string { return v.After }
+
+// __ScheduleMessageInput is used internally by genqlient
+type __ScheduleMessageInput struct {
+	SpaceID string         `json:"spaceID"`
+	Content string         `json:"content"`
+	Format  *MessageFormat `json:"format"`
+	SendAt  time.Time      `json:"sendAt"`
+}
+
+// GetSpaceID returns __ScheduleMessageInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__ScheduleMessageInput) GetSpaceID() string { return v.SpaceID }
+
+// GetContent returns __ScheduleMessageInput.Content, and is useful for accessing the field via an interface.
+func (v *__ScheduleMessageInput) GetContent() string { return v.Content }
+
+// GetFormat returns __ScheduleMessageInput.Format, and is useful for accessing the field via an interface.
+func (v *__ScheduleMessageInput) GetFormat() *MessageFormat { return v.Format }
+
+// GetSendAt returns __ScheduleMessageInput.SendAt, and is useful for accessing the field via an interface.
+func (v *__ScheduleMessageInput) GetSendAt() time.Time { return v.SendAt }
+
+// __ScheduledMessagesInput is used internally by genqlient
+type __ScheduledMessagesInput struct {
+	SpaceID *string `json:"spaceID"`
+}
+
+// GetSpaceID returns __ScheduledMessagesInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__ScheduledMessagesInput) GetSpaceID() *string { return v.SpaceID }
+
+// __SearchMessagesInput is used internally by genqlient
+type __SearchMessagesInput struct {
+	Query  string               `json:"query"`
+	Filter *MessageSearchFilter `json:"filter,omitempty"`
+	First  *int                 `json:"first"`
+	After  *string              `json:"after"`
+}
+
+// GetQuery returns __SearchMessagesInput.Query, and is useful for accessing the field via an interface.
+func (v *__SearchMessagesInput) GetQuery() string { return v.Query }
+
+// GetFilter returns __SearchMessagesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__SearchMessagesInput) GetFilter() *MessageSearchFilter { return v.Filter }
+
+// GetFirst returns __SearchMessagesInput.First, and is useful for accessing the field via an interface.
+func (v *__SearchMessagesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __SearchMessagesInput.After, and is useful for accessing the field via an interface.
+func (v *__SearchMessagesInput) GetAfter() *string { return v.After }
+
+// __SearchSpacesInput is used internally by genqlient
+type __SearchSpacesInput struct {
+	Query *string    `json:"query"`
+	First *int       `json:"first"`
+	After *string    `json:"after"`
+	Sort  *SpaceSort `json:"sort"`
+}
+
+// GetQuery returns __SearchSpacesInput.Query, and is useful for accessing the field via an interface.
+func (v *__SearchSpacesInput) GetQuery() *string { return v.Query }
+
+// GetFirst returns __SearchSpacesInput.First, and is useful for accessing the field via an interface.
+func (v *__SearchSpacesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __SearchSpacesInput.After, and is useful for accessing the field via an interface.
+func (v *__SearchSpacesInput) GetAfter() *string { return v.After }
+
+// GetSort returns __SearchSpacesInput.Sort, and is useful for accessing the field via an interface.
+func (v *__SearchSpacesInput) GetSort() *SpaceSort { return v.Sort }
+
+// __SearchUsersInput is used internally by genqlient
+type __SearchUsersInput struct {
+	Query string  `json:"query"`
+	First *int    `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetQuery returns __SearchUsersInput.Query, and is useful for accessing the field via an interface.
+func (v *__SearchUsersInput) GetQuery() string { return v.Query }
+
+// GetFirst returns __SearchUsersInput.First, and is useful for accessing the field via an interface.
+func (v *__SearchUsersInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __SearchUsersInput.After, and is useful for accessing the field via an interface.
+func (v *__SearchUsersInput) GetAfter() *string { return v.After }
+
+// __SendMessageInput is used internally by genqlient
+type __SendMessageInput struct {
+	SpaceID         string         `json:"spaceID"`
+	Content         string         `json:"content"`
+	Format          *MessageFormat `json:"format"`
+	AttachmentIDs   []string       `json:"attachmentIDs"`
+	ExpiresIn       *int           `json:"expiresIn"`
+	ClientMessageID *string        `json:"clientMessageID"`
+}
+
+// GetSpaceID returns __SendMessageInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__SendMessageInput) GetSpaceID() string { return v.SpaceID }
+
+// GetContent returns __SendMessageInput.Content, and is useful for accessing the field via an interface.
+func (v *__SendMessageInput) GetContent() string { return v.Content }
+
+// GetFormat returns __SendMessageInput.Format, and is useful for accessing the field via an interface.
+func (v *__SendMessageInput) GetFormat() *MessageFormat { return v.Format }
+
+// GetAttachmentIDs returns __SendMessageInput.AttachmentIDs, and is useful for accessing the field via an interface.
+func (v *__SendMessageInput) GetAttachmentIDs() []string { return v.AttachmentIDs }
+
+// GetExpiresIn returns __SendMessageInput.ExpiresIn, and is useful for accessing the field via an interface.
+func (v *__SendMessageInput) GetExpiresIn() *int { return v.ExpiresIn }
+
+// GetClientMessageID returns __SendMessageInput.ClientMessageID, and is useful for accessing the field via an interface.
+func (v *__SendMessageInput) GetClientMessageID() *string { return v.ClientMessageID }
+
+// __SlashCommandsInput is used internally by genqlient
+type __SlashCommandsInput struct {
+	SpaceID string `json:"spaceID"`
+}
+
+// GetSpaceID returns __SlashCommandsInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__SlashCommandsInput) GetSpaceID() string { return v.SpaceID }
+
+// __SpaceEventsInput is used internally by genqlient
+type __SpaceEventsInput struct {
+	SpaceID string  `json:"spaceID"`
+	Since   *string `json:"since"`
+}
+
+// GetSpaceID returns __SpaceEventsInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__SpaceEventsInput) GetSpaceID() string { return v.SpaceID }
+
+// GetSince returns __SpaceEventsInput.Since, and is useful for accessing the field via an interface.
+func (v *__SpaceEventsInput) GetSince() *string { return v.Since }
+
+// __SpaceInput is used internally by genqlient
+type __SpaceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __SpaceInput.Id, and is useful for accessing the field via an interface.
+func (v *__SpaceInput) GetId() string { return v.Id }
+
+// __UnblockUserInput is used internally by genqlient
+type __UnblockUserInput struct {
+	UserID string `json:"userID"`
+}
+
+// GetUserID returns __UnblockUserInput.UserID, and is useful for accessing the field via an interface.
+func (v *__UnblockUserInput) GetUserID() string { return v.UserID }
+
+// __UnpinMessageInput is used internally by genqlient
+type __UnpinMessageInput struct {
+	MessageID string `json:"messageID"`
+}
+
+// GetMessageID returns __UnpinMessageInput.MessageID, and is useful for accessing the field via an interface.
+func (v *__UnpinMessageInput) GetMessageID() string { return v.MessageID }
+
+// __UnsaveMessageInput is used internally by genqlient
+type __UnsaveMessageInput struct {
+	MessageID string `json:"messageID"`
+}
+
+// GetMessageID returns __UnsaveMessageInput.MessageID, and is useful for accessing the field via an interface.
+func (v *__UnsaveMessageInput) GetMessageID() string { return v.MessageID }
+
+// __UpdateAttachmentPolicyInput is used internally by genqlient
+type __UpdateAttachmentPolicyInput struct {
+	SpaceID string                   `json:"spaceID"`
+	Request *AttachmentPolicyRequest `json:"request,omitempty"`
+}
+
+// GetSpaceID returns __UpdateAttachmentPolicyInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__UpdateAttachmentPolicyInput) GetSpaceID() string { return v.SpaceID }
+
+// GetRequest returns __UpdateAttachmentPolicyInput.Request, and is useful for accessing the field via an interface.
+func (v *__UpdateAttachmentPolicyInput) GetRequest() *AttachmentPolicyRequest { return v.Request }
+
+// __UpdateRetentionPolicyInput is used internally by genqlient
+type __UpdateRetentionPolicyInput struct {
+	SpaceID string                  `json:"spaceID"`
+	Request *RetentionPolicyRequest `json:"request,omitempty"`
+}
+
+// GetSpaceID returns __UpdateRetentionPolicyInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__UpdateRetentionPolicyInput) GetSpaceID() string { return v.SpaceID }
+
+// GetRequest returns __UpdateRetentionPolicyInput.Request, and is useful for accessing the field via an interface.
+func (v *__UpdateRetentionPolicyInput) GetRequest() *RetentionPolicyRequest { return v.Request }
+
+// __UpdateSpaceMessageTTLInput is used internally by genqlient
+type __UpdateSpaceMessageTTLInput struct {
+	SpaceID string `json:"spaceID"`
+	Ttl     *int   `json:"ttl"`
+}
+
+// GetSpaceID returns __UpdateSpaceMessageTTLInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__UpdateSpaceMessageTTLInput) GetSpaceID() string { return v.SpaceID }
+
+// GetTtl returns __UpdateSpaceMessageTTLInput.Ttl, and is useful for accessing the field via an interface.
+func (v *__UpdateSpaceMessageTTLInput) GetTtl() *int { return v.Ttl }
+
+// __UploadAttachmentInput is used internally by genqlient
+type __UploadAttachmentInput struct {
+	SpaceID string `json:"spaceID"`
+	File    Upload `json:"file"`
+}
+
+// GetSpaceID returns __UploadAttachmentInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__UploadAttachmentInput) GetSpaceID() string { return v.SpaceID }
+
+// GetFile returns __UploadAttachmentInput.File, and is useful for accessing the field via an interface.
+func (v *__UploadAttachmentInput) GetFile() Upload { return v.File }
+
+// __VotePollInput is used internally by genqlient
+type __VotePollInput struct {
+	PollID    string   `json:"pollID"`
+	OptionIDs []string `json:"optionIDs"`
+}
+
+// GetPollID returns __VotePollInput.PollID, and is useful for accessing the field via an interface.
+func (v *__VotePollInput) GetPollID() string { return v.PollID }
+
+// GetOptionIDs returns __VotePollInput.OptionIDs, and is useful for accessing the field via an interface.
+func (v *__VotePollInput) GetOptionIDs() []string { return v.OptionIDs }
+
+// __WebhookDeliveriesInput is used internally by genqlient
+type __WebhookDeliveriesInput struct {
+	WebhookID string  `json:"webhookID"`
+	First     *int    `json:"first"`
+	After     *string `json:"after"`
+}
+
+// GetWebhookID returns __WebhookDeliveriesInput.WebhookID, and is useful for accessing the field via an interface.
+func (v *__WebhookDeliveriesInput) GetWebhookID() string { return v.WebhookID }
+
+// GetFirst returns __WebhookDeliveriesInput.First, and is useful for accessing the field via an interface.
+func (v *__WebhookDeliveriesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __WebhookDeliveriesInput.After, and is useful for accessing the field via an interface.
+func (v *__WebhookDeliveriesInput) GetAfter() *string { return v.After }
+
+// __WebhooksInput is used internally by genqlient
+type __WebhooksInput struct {
+	SpaceID string `json:"spaceID"`
+}
+
+// GetSpaceID returns __WebhooksInput.SpaceID, and is useful for accessing the field via an interface.
+func (v *__WebhooksInput) GetSpaceID() string { return v.SpaceID }
+
+// The query executed by AttachmentPolicy.
+const AttachmentPolicy_Operation = `
+query AttachmentPolicy ($spaceID: ID!) {
+	attachmentPolicy(spaceID: $spaceID) {
+		maxSize
+		mimeTypes
+	}
+}
+`
+
+func AttachmentPolicy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+) (data_ *AttachmentPolicyResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AttachmentPolicy",
+		Query:  AttachmentPolicy_Operation,
+		Variables: &__AttachmentPolicyInput{
+			SpaceID: spaceID,
+		},
+	}
+
+	data_ = &AttachmentPolicyResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by BlockUser.
+const BlockUser_Operation = `
+mutation BlockUser ($userID: ID!) {
+	blockUser(userID: $userID) {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func BlockUser(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	userID string,
+) (data_ *BlockUserResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "BlockUser",
+		Query:  BlockUser_Operation,
+		Variables: &__BlockUserInput{
+			UserID: userID,
+		},
+	}
+
+	data_ = &BlockUserResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by BlockedUsers.
+const BlockedUsers_Operation = `
+query BlockedUsers {
+	blockedUsers {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func BlockedUsers(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *BlockedUsersResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "BlockedUsers",
+		Query:  BlockedUsers_Operation,
+	}
+
+	data_ = &BlockedUsersResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by BotAccessToken.
+const BotAccessToken_Operation = `
+mutation BotAccessToken ($token: String!) {
+	botAccessToken(token: $token) {
+		token
+	}
+}
+`
+
+func BotAccessToken(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	token string,
+) (data_ *BotAccessTokenResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "BotAccessToken",
+		Query:  BotAccessToken_Operation,
+		Variables: &__BotAccessTokenInput{
+			Token: token,
+		},
+	}
+
+	data_ = &BotAccessTokenResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CancelScheduledMessage.
+const CancelScheduledMessage_Operation = `
+mutation CancelScheduledMessage ($id: ID!) {
+	cancelScheduledMessage(id: $id)
+}
+`
+
+func CancelScheduledMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *CancelScheduledMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CancelScheduledMessage",
+		Query:  CancelScheduledMessage_Operation,
+		Variables: &__CancelScheduledMessageInput{
+			Id: id,
+		},
+	}
+
+	data_ = &CancelScheduledMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by ClosePoll.
+const ClosePoll_Operation = `
+mutation ClosePoll ($pollID: ID!) {
+	closePoll(pollID: $pollID) {
+		... PollFields
+	}
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func ClosePoll(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pollID string,
+) (data_ *ClosePollResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ClosePoll",
+		Query:  ClosePoll_Operation,
+		Variables: &__ClosePollInput{
+			PollID: pollID,
+		},
+	}
+
+	data_ = &ClosePollResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateBot.
+const CreateBot_Operation = `
+mutation CreateBot ($spaceID: ID!, $name: String!, $avatar: String) {
+	createBot(spaceID: $spaceID, name: $name, avatar: $avatar) {
+		bot {
+			... UserFields
+		}
+		token
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func CreateBot(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	name string,
+	avatar *string,
+) (data_ *CreateBotResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateBot",
+		Query:  CreateBot_Operation,
+		Variables: &__CreateBotInput{
+			SpaceID: spaceID,
+			Name:    name,
+			Avatar:  avatar,
+		},
+	}
+
+	data_ = &CreateBotResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateIncomingWebhook.
+const CreateIncomingWebhook_Operation = `
+mutation CreateIncomingWebhook ($spaceID: ID!, $name: String!, $avatar: String) {
+	createIncomingWebhook(spaceID: $spaceID, name: $name, avatar: $avatar) {
+		webhook {
+			... IncomingWebhookFields
+		}
+		url
+	}
+}
+fragment IncomingWebhookFields on IncomingWebhook {
+	id
+	spaceID
+	name
+	avatarURL
+	bot {
+		... UserFields
+	}
+	createdAt
+	revokedAt
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func CreateIncomingWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	name string,
+	avatar *string,
+) (data_ *CreateIncomingWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateIncomingWebhook",
+		Query:  CreateIncomingWebhook_Operation,
+		Variables: &__CreateIncomingWebhookInput{
+			SpaceID: spaceID,
+			Name:    name,
+			Avatar:  avatar,
+		},
+	}
+
+	data_ = &CreateIncomingWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreatePoll.
+const CreatePoll_Operation = `
+mutation CreatePoll ($request: PollRequest!) {
+	createPoll(request: $request) {
+		... MessageFields
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func CreatePoll(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	request *PollRequest,
+) (data_ *CreatePollResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreatePoll",
+		Query:  CreatePoll_Operation,
+		Variables: &__CreatePollInput{
+			Request: request,
+		},
+	}
+
+	data_ = &CreatePollResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateSpace.
+const CreateSpace_Operation = `
+mutation CreateSpace ($request: SpaceRequest!) {
+	createSpace(request: $request) {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func CreateSpace(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	request *SpaceRequest,
+) (data_ *CreateSpaceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateSpace",
+		Query:  CreateSpace_Operation,
+		Variables: &__CreateSpaceInput{
+			Request: request,
+		},
+	}
+
+	data_ = &CreateSpaceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateWebhook.
+const CreateWebhook_Operation = `
+mutation CreateWebhook ($spaceID: ID!, $request: WebhookRequest!) {
+	createWebhook(spaceID: $spaceID, request: $request) {
+		webhook {
+			... WebhookFields
+		}
+		secret
+	}
+}
+fragment WebhookFields on Webhook {
+	id
+	spaceID
+	url
+	events
+	createdAt
+}
+`
+
+func CreateWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	request *WebhookRequest,
+) (data_ *CreateWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateWebhook",
+		Query:  CreateWebhook_Operation,
+		Variables: &__CreateWebhookInput{
+			SpaceID: spaceID,
+			Request: request,
+		},
+	}
+
+	data_ = &CreateWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by DeleteSlashCommand.
+const DeleteSlashCommand_Operation = `
+mutation DeleteSlashCommand ($id: ID!) {
+	deleteSlashCommand(id: $id)
+}
+`
+
+func DeleteSlashCommand(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *DeleteSlashCommandResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteSlashCommand",
+		Query:  DeleteSlashCommand_Operation,
+		Variables: &__DeleteSlashCommandInput{
+			Id: id,
+		},
+	}
+
+	data_ = &DeleteSlashCommandResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by DeleteWebhook.
+const DeleteWebhook_Operation = `
+mutation DeleteWebhook ($id: ID!) {
+	deleteWebhook(id: $id)
+}
+`
+
+func DeleteWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *DeleteWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteWebhook",
+		Query:  DeleteWebhook_Operation,
+		Variables: &__DeleteWebhookInput{
+			Id: id,
+		},
+	}
+
+	data_ = &DeleteWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by IncomingWebhooks.
+const IncomingWebhooks_Operation = `
+query IncomingWebhooks ($spaceID: ID!) {
+	incomingWebhooks(spaceID: $spaceID) {
+		... IncomingWebhookFields
+	}
+}
+fragment IncomingWebhookFields on IncomingWebhook {
+	id
+	spaceID
+	name
+	avatarURL
+	bot {
+		... UserFields
+	}
+	createdAt
+	revokedAt
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func IncomingWebhooks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+) (data_ *IncomingWebhooksResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "IncomingWebhooks",
+		Query:  IncomingWebhooks_Operation,
+		Variables: &__IncomingWebhooksInput{
+			SpaceID: spaceID,
+		},
+	}
+
+	data_ = &IncomingWebhooksResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by JoinSpace.
+const JoinSpace_Operation = `
+mutation JoinSpace ($spaceID: ID!) {
+	joinSpace(spaceID: $spaceID) {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func JoinSpace(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+) (data_ *JoinSpaceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "JoinSpace",
+		Query:  JoinSpace_Operation,
+		Variables: &__JoinSpaceInput{
+			SpaceID: spaceID,
+		},
+	}
+
+	data_ = &JoinSpaceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by Login.
+const Login_Operation = `
+mutation Login ($request: LoginRequest!) {
+	login(request: $request) {
+		token
+		refreshToken
+	}
+}
+`
+
+func Login(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	request *LoginRequest,
+) (data_ *LoginResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Login",
+		Query:  Login_Operation,
+		Variables: &__LoginInput{
+			Request: request,
+		},
+	}
+
+	data_ = &LoginResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by MarkSpaceRead.
+const MarkSpaceRead_Operation = `
+mutation MarkSpaceRead ($spaceID: ID!, $seq: Int64!) {
+	markSpaceRead(spaceID: $spaceID, seq: $seq) {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func MarkSpaceRead(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	seq int64,
+) (data_ *MarkSpaceReadResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "MarkSpaceRead",
+		Query:  MarkSpaceRead_Operation,
+		Variables: &__MarkSpaceReadInput{
+			SpaceID: spaceID,
+			Seq:     seq,
+		},
+	}
+
+	data_ = &MarkSpaceReadResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by Me.
+const Me_Operation = `
+query Me {
+	user {
+		... UserFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func Me(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *MeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Me",
+		Query:  Me_Operation,
+	}
+
+	data_ = &MeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The subscription executed by MessageSent.
+const MessageSent_Operation = `
+subscription MessageSent ($spaceID: ID!, $since: Cursor) {
+	messageSent(spaceID: $spaceID, since: $since) {
+		... MessageFields
+		cursor
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+// To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
+func MessageSent(
+	ctx_ context.Context,
+	client_ graphql.WebSocketClient,
+	spaceID string,
+	since *string,
+) (dataChan_ chan MessageSentWsResponse, subscriptionID_ string, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "MessageSent",
+		Query:  MessageSent_Operation,
+		Variables: &__MessageSentInput{
+			SpaceID: spaceID,
+			Since:   since,
+		},
+	}
+
+	dataChan_ = make(chan MessageSentWsResponse)
+	subscriptionID_, err_ = client_.Subscribe(req_, dataChan_, MessageSentForwardData)
+
+	return dataChan_, subscriptionID_, err_
+}
+
+type MessageSentWsResponse graphql.BaseResponse[*MessageSentResponse]
+
+func MessageSentForwardData(interfaceChan interface{}, jsonRawMsg json.RawMessage) error {
+	var gqlResp graphql.Response
+	var wsResp MessageSentWsResponse
+	err := json.Unmarshal(jsonRawMsg, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if len(gqlResp.Errors) == 0 {
+		err = json.Unmarshal(jsonRawMsg, &wsResp)
+		if err != nil {
+			return err
+		}
+	} else {
+		wsResp.Errors = gqlResp.Errors
+	}
+	dataChan_, ok := interfaceChan.(chan MessageSentWsResponse)
+	if !ok {
+		return errors.New("failed to cast interface into 'chan MessageSentWsResponse'")
+	}
+	dataChan_ <- wsResp
+	return nil
+}
+
+// The query executed by Messages.
+const Messages_Operation = `
+query Messages ($spaceID: ID!, $first: Int, $before: Int64, $after: Int64) {
+	messages(spaceID: $spaceID, first: $first, before: $before, after: $after) {
+		... MessageFields
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func Messages(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	first *int,
+	before *int64,
+	after *int64,
+) (data_ *MessagesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Messages",
+		Query:  Messages_Operation,
+		Variables: &__MessagesInput{
+			SpaceID: spaceID,
+			First:   first,
+			Before:  before,
+			After:   after,
+		},
+	}
+
+	data_ = &MessagesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by MySpaces.
+const MySpaces_Operation = `
+query MySpaces {
+	mySpaces {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func MySpaces(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *MySpacesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "MySpaces",
+		Query:  MySpaces_Operation,
+	}
+
+	data_ = &MySpacesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PinMessage.
+const PinMessage_Operation = `
+mutation PinMessage ($messageID: ID!) {
+	pinMessage(messageID: $messageID) {
+		... MessageFields
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func PinMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	messageID string,
+) (data_ *PinMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PinMessage",
+		Query:  PinMessage_Operation,
+		Variables: &__PinMessageInput{
+			MessageID: messageID,
+		},
+	}
+
+	data_ = &PinMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RedeliverWebhook.
+const RedeliverWebhook_Operation = `
+mutation RedeliverWebhook ($deliveryID: ID!) {
+	redeliverWebhook(deliveryID: $deliveryID) {
+		... WebhookDeliveryFields
+	}
+}
+fragment WebhookDeliveryFields on WebhookDelivery {
+	id
+	webhookID
+	eventType
+	payload
+	status
+	attempts
+	nextAttemptAt
+	responseStatus
+	error
+	deliveredAt
+	redeliveryOf
+	createdAt
+}
+`
+
+func RedeliverWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	deliveryID string,
+) (data_ *RedeliverWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RedeliverWebhook",
+		Query:  RedeliverWebhook_Operation,
+		Variables: &__RedeliverWebhookInput{
+			DeliveryID: deliveryID,
+		},
+	}
+
+	data_ = &RedeliverWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RefreshToken.
+const RefreshToken_Operation = `
+mutation RefreshToken ($request: RefreshRequest!) {
+	refreshToken(request: $request) {
+		token
+		refreshToken
+	}
+}
+`
+
+func RefreshToken(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	request *RefreshRequest,
+) (data_ *RefreshTokenResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RefreshToken",
+		Query:  RefreshToken_Operation,
+		Variables: &__RefreshTokenInput{
+			Request: request,
+		},
+	}
+
+	data_ = &RefreshTokenResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by Register.
+const Register_Operation = `
+mutation Register ($request: RegisterRequest!) {
+	register(request: $request) {
+		token
+		refreshToken
+	}
+}
+`
+
+func Register(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	request *RegisterRequest,
+) (data_ *RegisterResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Register",
+		Query:  Register_Operation,
+		Variables: &__RegisterInput{
+			Request: request,
+		},
+	}
+
+	data_ = &RegisterResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RegisterSlashCommand.
+const RegisterSlashCommand_Operation = `
+mutation RegisterSlashCommand ($spaceID: ID!, $request: SlashCommandRequest!) {
+	registerSlashCommand(spaceID: $spaceID, request: $request) {
+		command {
+			... SlashCommandFields
+		}
+		secret
+	}
+}
+fragment SlashCommandFields on SlashCommand {
+	id
+	spaceID
+	name
+	description
+	url
+	builtin
+}
+`
+
+func RegisterSlashCommand(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	request *SlashCommandRequest,
+) (data_ *RegisterSlashCommandResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RegisterSlashCommand",
+		Query:  RegisterSlashCommand_Operation,
+		Variables: &__RegisterSlashCommandInput{
+			SpaceID: spaceID,
+			Request: request,
+		},
+	}
+
+	data_ = &RegisterSlashCommandResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RevokeIncomingWebhook.
+const RevokeIncomingWebhook_Operation = `
+mutation RevokeIncomingWebhook ($id: ID!) {
+	revokeIncomingWebhook(id: $id)
+}
+`
+
+func RevokeIncomingWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *RevokeIncomingWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RevokeIncomingWebhook",
+		Query:  RevokeIncomingWebhook_Operation,
+		Variables: &__RevokeIncomingWebhookInput{
+			Id: id,
+		},
+	}
+
+	data_ = &RevokeIncomingWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RotateBotToken.
+const RotateBotToken_Operation = `
+mutation RotateBotToken ($spaceID: ID!, $botID: ID!) {
+	rotateBotToken(spaceID: $spaceID, botID: $botID) {
+		bot {
+			... UserFields
+		}
+		token
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func RotateBotToken(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	botID string,
+) (data_ *RotateBotTokenResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RotateBotToken",
+		Query:  RotateBotToken_Operation,
+		Variables: &__RotateBotTokenInput{
+			SpaceID: spaceID,
+			BotID:   botID,
+		},
+	}
+
+	data_ = &RotateBotTokenResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by SaveMessage.
+const SaveMessage_Operation = `
+mutation SaveMessage ($messageID: ID!, $note: String, $remindAt: Time) {
+	saveMessage(messageID: $messageID, note: $note, remindAt: $remindAt) {
+		... SavedMessageFields
+	}
+}
+fragment SavedMessageFields on SavedMessage {
+	id
+	message {
+		... MessageFields
+	}
+	note
+	remindAt
+	createdAt
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func SaveMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	messageID string,
+	note *string,
+	remindAt *time.Time,
+) (data_ *SaveMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SaveMessage",
+		Query:  SaveMessage_Operation,
+		Variables: &__SaveMessageInput{
+			MessageID: messageID,
+			Note:      note,
+			RemindAt:  remindAt,
+		},
+	}
+
+	data_ = &SaveMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SavedMessages.
+const SavedMessages_Operation = `
+query SavedMessages ($first: Int, $after: String) {
+	savedMessages(first: $first, after: $after) {
+		edges {
+			... SavedMessageFields
+		}
+		pageInfo {
+			... PageInfoFields
+		}
+	}
+}
+fragment SavedMessageFields on SavedMessage {
+	id
+	message {
+		... MessageFields
+	}
+	note
+	remindAt
+	createdAt
+}
+fragment PageInfoFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func SavedMessages(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first *int,
+	after *string,
+) (data_ *SavedMessagesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SavedMessages",
+		Query:  SavedMessages_Operation,
+		Variables: &__SavedMessagesInput{
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &SavedMessagesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by ScheduleMessage.
+const ScheduleMessage_Operation = `
+mutation ScheduleMessage ($spaceID: ID!, $content: String!, $format: MessageFormat, $sendAt: Time!) {
+	scheduleMessage(spaceID: $spaceID, content: $content, format: $format, sendAt: $sendAt) {
+		... ScheduledMessageFields
+	}
+}
+fragment ScheduledMessageFields on ScheduledMessage {
+	id
+	spaceID
+	content
+	format
+	ephemeral
+	sendAt
+	status
+	createdAt
+}
+`
+
+func ScheduleMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	content string,
+	format *MessageFormat,
+	sendAt time.Time,
+) (data_ *ScheduleMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ScheduleMessage",
+		Query:  ScheduleMessage_Operation,
+		Variables: &__ScheduleMessageInput{
+			SpaceID: spaceID,
+			Content: content,
+			Format:  format,
+			SendAt:  sendAt,
+		},
+	}
+
+	data_ = &ScheduleMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ScheduledMessages.
+const ScheduledMessages_Operation = `
+query ScheduledMessages ($spaceID: ID) {
+	scheduledMessages(spaceID: $spaceID) {
+		... ScheduledMessageFields
+	}
+}
+fragment ScheduledMessageFields on ScheduledMessage {
+	id
+	spaceID
+	content
+	format
+	ephemeral
+	sendAt
+	status
+	createdAt
+}
+`
+
+func ScheduledMessages(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID *string,
+) (data_ *ScheduledMessagesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ScheduledMessages",
+		Query:  ScheduledMessages_Operation,
+		Variables: &__ScheduledMessagesInput{
+			SpaceID: spaceID,
+		},
+	}
+
+	data_ = &ScheduledMessagesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SearchMessages.
+const SearchMessages_Operation = `
+query SearchMessages ($query: String!, $filter: MessageSearchFilter, $first: Int, $after: String) {
+	searchMessages(query: $query, filter: $filter, first: $first, after: $after) {
+		edges {
+			message {
+				... MessageFields
+			}
+			snippet
+			rank
+		}
+		pageInfo {
+			... PageInfoFields
+		}
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment PageInfoFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func SearchMessages(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	query string,
+	filter *MessageSearchFilter,
+	first *int,
+	after *string,
+) (data_ *SearchMessagesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SearchMessages",
+		Query:  SearchMessages_Operation,
+		Variables: &__SearchMessagesInput{
+			Query:  query,
+			Filter: filter,
+			First:  first,
+			After:  after,
+		},
+	}
+
+	data_ = &SearchMessagesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SearchSpaces.
+const SearchSpaces_Operation = `
+query SearchSpaces ($query: String, $first: Int, $after: String, $sort: SpaceSort) {
+	searchSpaces(query: $query, first: $first, after: $after, sort: $sort) {
+		nodes {
+			... SpaceFields
+		}
+		pageInfo {
+			... PageInfoFields
+		}
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+fragment PageInfoFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func SearchSpaces(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	query *string,
+	first *int,
+	after *string,
+	sort *SpaceSort,
+) (data_ *SearchSpacesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SearchSpaces",
+		Query:  SearchSpaces_Operation,
+		Variables: &__SearchSpacesInput{
+			Query: query,
+			First: first,
+			After: after,
+			Sort:  sort,
+		},
+	}
+
+	data_ = &SearchSpacesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SearchUsers.
+const SearchUsers_Operation = `
+query SearchUsers ($query: String!, $first: Int, $after: String) {
+	searchUsers(query: $query, first: $first, after: $after) {
+		edges {
+			user {
+				... UserFields
+			}
+			sharedSpaceCount
+		}
+		pageInfo {
+			... PageInfoFields
+		}
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment PageInfoFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func SearchUsers(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	query string,
+	first *int,
+	after *string,
+) (data_ *SearchUsersResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SearchUsers",
+		Query:  SearchUsers_Operation,
+		Variables: &__SearchUsersInput{
+			Query: query,
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &SearchUsersResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by SendMessage.
+const SendMessage_Operation = `
+mutation SendMessage ($spaceID: ID!, $content: String!, $format: MessageFormat, $attachmentIDs: [ID!], $expiresIn: Int, $clientMessageID: String) {
+	sendMessage(spaceID: $spaceID, content: $content, format: $format, attachmentIDs: $attachmentIDs, expiresIn: $expiresIn, clientMessageID: $clientMessageID) {
+		... MessageFields
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func SendMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	content string,
+	format *MessageFormat,
+	attachmentIDs []string,
+	expiresIn *int,
+	clientMessageID *string,
+) (data_ *SendMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SendMessage",
+		Query:  SendMessage_Operation,
+		Variables: &__SendMessageInput{
+			SpaceID:         spaceID,
+			Content:         content,
+			Format:          format,
+			AttachmentIDs:   attachmentIDs,
+			ExpiresIn:       expiresIn,
+			ClientMessageID: clientMessageID,
+		},
+	}
+
+	data_ = &SendMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SlashCommands.
+const SlashCommands_Operation = `
+query SlashCommands ($spaceID: ID!) {
+	slashCommands(spaceID: $spaceID) {
+		... SlashCommandFields
+	}
+}
+fragment SlashCommandFields on SlashCommand {
+	id
+	spaceID
+	name
+	description
+	url
+	builtin
+}
+`
+
+func SlashCommands(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+) (data_ *SlashCommandsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SlashCommands",
+		Query:  SlashCommands_Operation,
+		Variables: &__SlashCommandsInput{
+			SpaceID: spaceID,
+		},
+	}
+
+	data_ = &SlashCommandsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by Space.
+const Space_Operation = `
+query Space ($id: ID!) {
+	space(id: $id) {
+		... SpaceFields
+		members {
+			... UserFields
+		}
+		admins {
+			... UserFields
+		}
+		pinnedMessages {
+			... MessageFields
+		}
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+func Space(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *SpaceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Space",
+		Query:  Space_Operation,
+		Variables: &__SpaceInput{
+			Id: id,
+		},
+	}
+
+	data_ = &SpaceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The subscription executed by SpaceEvents.
+const SpaceEvents_Operation = `
+subscription SpaceEvents ($spaceID: ID!, $since: Cursor) {
+	spaceEvents(spaceID: $spaceID, since: $since) {
+		type
+		spaceID
+		cursor
+		message {
+			... MessageFields
+		}
+		messageID
+		poll {
+			... PollFields
+		}
+		user {
+			... UserFields
+		}
+	}
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+`
+
+// To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
+func SpaceEvents(
+	ctx_ context.Context,
+	client_ graphql.WebSocketClient,
+	spaceID string,
+	since *string,
+) (dataChan_ chan SpaceEventsWsResponse, subscriptionID_ string, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SpaceEvents",
+		Query:  SpaceEvents_Operation,
+		Variables: &__SpaceEventsInput{
+			SpaceID: spaceID,
+			Since:   since,
+		},
+	}
+
+	dataChan_ = make(chan SpaceEventsWsResponse)
+	subscriptionID_, err_ = client_.Subscribe(req_, dataChan_, SpaceEventsForwardData)
+
+	return dataChan_, subscriptionID_, err_
+}
+
+type SpaceEventsWsResponse graphql.BaseResponse[*SpaceEventsResponse]
+
+func SpaceEventsForwardData(interfaceChan interface{}, jsonRawMsg json.RawMessage) error {
+	var gqlResp graphql.Response
+	var wsResp SpaceEventsWsResponse
+	err := json.Unmarshal(jsonRawMsg, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if len(gqlResp.Errors) == 0 {
+		err = json.Unmarshal(jsonRawMsg, &wsResp)
+		if err != nil {
+			return err
+		}
+	} else {
+		wsResp.Errors = gqlResp.Errors
+	}
+	dataChan_, ok := interfaceChan.(chan SpaceEventsWsResponse)
+	if !ok {
+		return errors.New("failed to cast interface into 'chan SpaceEventsWsResponse'")
+	}
+	dataChan_ <- wsResp
+	return nil
+}
+
+// The query executed by Spaces.
+const Spaces_Operation = `
+query Spaces {
+	spaces {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func Spaces(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *SpacesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Spaces",
+		Query:  Spaces_Operation,
+	}
+
+	data_ = &SpacesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UnblockUser.
+const UnblockUser_Operation = `
+mutation UnblockUser ($userID: ID!) {
+	unblockUser(userID: $userID)
+}
+`
+
+func UnblockUser(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	userID string,
+) (data_ *UnblockUserResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UnblockUser",
+		Query:  UnblockUser_Operation,
+		Variables: &__UnblockUserInput{
+			UserID: userID,
+		},
+	}
+
+	data_ = &UnblockUserResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UnpinMessage.
+const UnpinMessage_Operation = `
+mutation UnpinMessage ($messageID: ID!) {
+	unpinMessage(messageID: $messageID)
+}
+`
+
+func UnpinMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	messageID string,
+) (data_ *UnpinMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UnpinMessage",
+		Query:  UnpinMessage_Operation,
+		Variables: &__UnpinMessageInput{
+			MessageID: messageID,
+		},
+	}
+
+	data_ = &UnpinMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UnsaveMessage.
+const UnsaveMessage_Operation = `
+mutation UnsaveMessage ($messageID: ID!) {
+	unsaveMessage(messageID: $messageID)
+}
+`
+
+func UnsaveMessage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	messageID string,
+) (data_ *UnsaveMessageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UnsaveMessage",
+		Query:  UnsaveMessage_Operation,
+		Variables: &__UnsaveMessageInput{
+			MessageID: messageID,
+		},
+	}
+
+	data_ = &UnsaveMessageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateAttachmentPolicy.
+const UpdateAttachmentPolicy_Operation = `
+mutation UpdateAttachmentPolicy ($spaceID: ID!, $request: AttachmentPolicyRequest!) {
+	updateAttachmentPolicy(spaceID: $spaceID, request: $request) {
+		maxSize
+		mimeTypes
+	}
+}
+`
+
+func UpdateAttachmentPolicy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	request *AttachmentPolicyRequest,
+) (data_ *UpdateAttachmentPolicyResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateAttachmentPolicy",
+		Query:  UpdateAttachmentPolicy_Operation,
+		Variables: &__UpdateAttachmentPolicyInput{
+			SpaceID: spaceID,
+			Request: request,
+		},
+	}
+
+	data_ = &UpdateAttachmentPolicyResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateRetentionPolicy.
+const UpdateRetentionPolicy_Operation = `
+mutation UpdateRetentionPolicy ($spaceID: ID!, $request: RetentionPolicyRequest!) {
+	updateRetentionPolicy(spaceID: $spaceID, request: $request) {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func UpdateRetentionPolicy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	request *RetentionPolicyRequest,
+) (data_ *UpdateRetentionPolicyResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateRetentionPolicy",
+		Query:  UpdateRetentionPolicy_Operation,
+		Variables: &__UpdateRetentionPolicyInput{
+			SpaceID: spaceID,
+			Request: request,
+		},
+	}
+
+	data_ = &UpdateRetentionPolicyResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateSpaceMessageTTL.
+const UpdateSpaceMessageTTL_Operation = `
+mutation UpdateSpaceMessageTTL ($spaceID: ID!, $ttl: Int) {
+	updateSpaceMessageTTL(spaceID: $spaceID, ttl: $ttl) {
+		... SpaceFields
+	}
+}
+fragment SpaceFields on Space {
+	id
+	name
+	description
+	memberCount
+	lastActivityAt
+	isMember
+	lastReadSeq
+	unreadCount
+	messageTTL
+	retentionPolicy {
+		mode
+		value
+		inherited
+	}
+}
+`
+
+func UpdateSpaceMessageTTL(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	ttl *int,
+) (data_ *UpdateSpaceMessageTTLResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateSpaceMessageTTL",
+		Query:  UpdateSpaceMessageTTL_Operation,
+		Variables: &__UpdateSpaceMessageTTLInput{
+			SpaceID: spaceID,
+			Ttl:     ttl,
+		},
+	}
+
+	data_ = &UpdateSpaceMessageTTLResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UploadAttachment.
+const UploadAttachment_Operation = `
+mutation UploadAttachment ($spaceID: ID!, $file: Upload!) {
+	uploadAttachment(spaceID: $spaceID, file: $file) {
+		... AttachmentFields
+	}
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+`
+
+func UploadAttachment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+	file Upload,
+) (data_ *UploadAttachmentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UploadAttachment",
+		Query:  UploadAttachment_Operation,
+		Variables: &__UploadAttachmentInput{
+			SpaceID: spaceID,
+			File:    file,
+		},
+	}
+
+	data_ = &UploadAttachmentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The subscription executed by UserEvents.
+const UserEvents_Operation = `
+subscription UserEvents {
+	userEvents {
+		type
+		savedMessage {
+			... SavedMessageFields
+		}
+		message {
+			... MessageFields
+		}
+	}
+}
+fragment SavedMessageFields on SavedMessage {
+	id
+	message {
+		... MessageFields
+	}
+	note
+	remindAt
+	createdAt
+}
+fragment MessageFields on Message {
+	id
+	content
+	format
+	html
+	user {
+		... UserFields
+	}
+	space {
+		id
+		name
+	}
+	createdAt
+	expiresAt
+	ephemeral
+	fromBlockedUser
+	clientMessageID
+	seq
+	attachments {
+		... AttachmentFields
+	}
+	linkPreviews {
+		url
+		title
+		description
+		imageURL
+		siteName
+	}
+	poll {
+		... PollFields
+	}
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+fragment AttachmentFields on Attachment {
+	id
+	filename
+	contentType
+	size
+	url
+	status
+	width
+	height
+	blurhash
+	thumbnails {
+		width
+		height
+		contentType
+		url
+	}
+	createdAt
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+`
+
+// To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
+func UserEvents(
+	ctx_ context.Context,
+	client_ graphql.WebSocketClient,
+) (dataChan_ chan UserEventsWsResponse, subscriptionID_ string, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UserEvents",
+		Query:  UserEvents_Operation,
+	}
+
+	dataChan_ = make(chan UserEventsWsResponse)
+	subscriptionID_, err_ = client_.Subscribe(req_, dataChan_, UserEventsForwardData)
+
+	return dataChan_, subscriptionID_, err_
+}
+
+type UserEventsWsResponse graphql.BaseResponse[*UserEventsResponse]
+
+func UserEventsForwardData(interfaceChan interface{}, jsonRawMsg json.RawMessage) error {
+	var gqlResp graphql.Response
+	var wsResp UserEventsWsResponse
+	err := json.Unmarshal(jsonRawMsg, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if len(gqlResp.Errors) == 0 {
+		err = json.Unmarshal(jsonRawMsg, &wsResp)
+		if err != nil {
+			return err
+		}
+	} else {
+		wsResp.Errors = gqlResp.Errors
+	}
+	dataChan_, ok := interfaceChan.(chan UserEventsWsResponse)
+	if !ok {
+		return errors.New("failed to cast interface into 'chan UserEventsWsResponse'")
+	}
+	dataChan_ <- wsResp
+	return nil
+}
+
+// The mutation executed by VotePoll.
+const VotePoll_Operation = `
+mutation VotePoll ($pollID: ID!, $optionIDs: [ID!]!) {
+	votePoll(pollID: $pollID, optionIDs: $optionIDs) {
+		... PollFields
+	}
+}
+fragment PollFields on Poll {
+	id
+	question
+	options {
+		id
+		text
+		voteCount
+		voters {
+			... UserFields
+		}
+	}
+	multiChoice
+	anonymous
+	closesAt
+	closed
+	totalVoters
+	myVotes
+}
+fragment UserFields on User {
+	id
+	email
+	name
+	bot
+	avatarURL
+}
+`
+
+func VotePoll(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pollID string,
+	optionIDs []string,
+) (data_ *VotePollResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "VotePoll",
+		Query:  VotePoll_Operation,
+		Variables: &__VotePollInput{
+			PollID:    pollID,
+			OptionIDs: optionIDs,
+		},
+	}
+
+	data_ = &VotePollResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by WebhookDeliveries.
+const WebhookDeliveries_Operation = `
+query WebhookDeliveries ($webhookID: ID!, $first: Int, $after: String) {
+	webhookDeliveries(webhookID: $webhookID, first: $first, after: $after) {
+		edges {
+			... WebhookDeliveryFields
+		}
+		pageInfo {
+			... PageInfoFields
+		}
+	}
+}
+fragment WebhookDeliveryFields on WebhookDelivery {
+	id
+	webhookID
+	eventType
+	payload
+	status
+	attempts
+	nextAttemptAt
+	responseStatus
+	error
+	deliveredAt
+	redeliveryOf
+	createdAt
+}
+fragment PageInfoFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func WebhookDeliveries(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	webhookID string,
+	first *int,
+	after *string,
+) (data_ *WebhookDeliveriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "WebhookDeliveries",
+		Query:  WebhookDeliveries_Operation,
+		Variables: &__WebhookDeliveriesInput{
+			WebhookID: webhookID,
+			First:     first,
+			After:     after,
+		},
+	}
+
+	data_ = &WebhookDeliveriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by Webhooks.
+const Webhooks_Operation = `
+query Webhooks ($spaceID: ID!) {
+	webhooks(spaceID: $spaceID) {
+		... WebhookFields
+	}
+}
+fragment WebhookFields on Webhook {
+	id
+	spaceID
+	url
+	events
+	createdAt
+}
+`
+
+func Webhooks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	spaceID string,
+) (data_ *WebhooksResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "Webhooks",
+		Query:  Webhooks_Operation,
+		Variables: &__WebhooksInput{
+			SpaceID: spaceID,
+		},
+	}
+
+	data_ = &WebhooksResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
@@ -0,0 +1,16 @@
+package chatclient
+
+import "io"
+
+// Upload is a file sent with the UploadAttachment mutation. It is encoded
+// as a multipart request following the GraphQL multipart request spec.
+type Upload struct {
+	Filename string
+	Body     io.Reader
+}
+
+// MarshalJSON encodes the variable as null; the file itself travels in its
+// own multipart part.
+func (Upload) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
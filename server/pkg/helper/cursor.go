@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"chatspace-server/constant"
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+const cursorPrefix = "offset:"
+
+func EncodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func DecodeCursor(cursor *string) (int, error) {
+	if cursor == nil || *cursor == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(*cursor)
+	if err != nil {
+		return 0, constant.ErrInvalidCursor
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, constant.ErrInvalidCursor
+	}
+
+	return offset, nil
+}
+
+func PageSize(first *int32, def, max int) int {
+	if first == nil || *first <= 0 {
+		return def
+	}
+
+	if int(*first) > max {
+		return max
+	}
+
+	return int(*first)
+}
@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"chatspace-server/constant"
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, offset := range []int{0, 1, 20, 1 << 30} {
+		cursor := EncodeCursor(offset)
+
+		got, err := DecodeCursor(&cursor)
+		if err != nil {
+			t.Fatalf("DecodeCursor(EncodeCursor(%d)) error = %v", offset, err)
+		}
+
+		if got != offset {
+			t.Fatalf("DecodeCursor(EncodeCursor(%d)) = %d", offset, got)
+		}
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	str := func(s string) *string {
+		return &s
+	}
+	encode := func(s string) *string {
+		return str(base64.StdEncoding.EncodeToString([]byte(s)))
+	}
+
+	tests := []struct {
+		name    string
+		cursor  *string
+		want    int
+		wantErr error
+	}{
+		{"nil", nil, 0, nil},
+		{"empty", str(""), 0, nil},
+		{"valid", encode("offset:40"), 40, nil},
+		{"not base64", str("!!!"), 0, constant.ErrInvalidCursor},
+		{"not a number", encode("offset:abc"), 0, constant.ErrInvalidCursor},
+		{"negative", encode("offset:-5"), 0, constant.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCursor(tt.cursor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeCursor() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Fatalf("DecodeCursor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	n := func(v int32) *int32 {
+		return &v
+	}
+
+	tests := []struct {
+		name  string
+		first *int32
+		want  int
+	}{
+		{"nil", nil, 20},
+		{"zero", n(0), 20},
+		{"negative", n(-1), 20},
+		{"within", n(5), 5},
+		{"at max", n(100), 100},
+		{"above max", n(101), 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PageSize(tt.first, 20, 100); got != tt.want {
+				t.Errorf("PageSize() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
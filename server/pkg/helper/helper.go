@@ -1,6 +1,8 @@
 package helper
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
 )
 
@@ -12,3 +14,23 @@ func StrToUUID(str string) (*uuid.UUID, error) {
 
 	return &id, nil
 }
+
+func StrPtrToUUID(str *string) (*uuid.UUID, error) {
+	if str == nil || *str == "" {
+		return nil, nil
+	}
+
+	return StrToUUID(*str)
+}
+
+func EscapeLike(str string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(str)
+}
+
+func NilIfEmpty(str string) *string {
+	if str == "" {
+		return nil
+	}
+
+	return &str
+}
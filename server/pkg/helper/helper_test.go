@@ -0,0 +1,69 @@
+package helper
+
+import "testing"
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", "plain"},
+		{"100%", `100\%`},
+		{"snake_case", `snake\_case`},
+		{`C:\path`, `C:\\path`},
+		{`\%_`, `\\\%\_`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := EscapeLike(tt.in); got != tt.want {
+				t.Errorf("EscapeLike(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrPtrToUUID(t *testing.T) {
+	empty := ""
+	valid := "7f9c24e8-3b12-4c1a-9a3e-2f0d1c5b6a7e"
+	invalid := "not-a-uuid"
+
+	tests := []struct {
+		name    string
+		in      *string
+		wantNil bool
+		wantErr bool
+	}{
+		{"nil", nil, true, false},
+		{"empty", &empty, true, false},
+		{"valid", &valid, false, false},
+		{"invalid", &invalid, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StrPtrToUUID(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StrPtrToUUID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("StrPtrToUUID() = %v, want nil %v", got, tt.wantNil)
+			}
+
+			if got != nil && got.String() != *tt.in {
+				t.Fatalf("StrPtrToUUID() = %s, want %s", got, *tt.in)
+			}
+		})
+	}
+}
+
+func TestNilIfEmpty(t *testing.T) {
+	if NilIfEmpty("") != nil {
+		t.Error("NilIfEmpty(\"\") != nil")
+	}
+
+	if got := NilIfEmpty("x"); got == nil || *got != "x" {
+		t.Errorf("NilIfEmpty(\"x\") = %v, want a pointer to \"x\"", got)
+	}
+}
@@ -0,0 +1,146 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/buckket/go-blurhash"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// MaxPixels guards against decompression bombs: images whose header claims
+// more pixels than this are rejected before being decoded.
+const MaxPixels = 50_000_000
+
+var ErrTooLarge = errors.New("image dimensions exceed limit")
+
+type Variant struct {
+	Width       int
+	Height      int
+	ContentType string
+	Data        []byte
+}
+
+type Result struct {
+	Width    int
+	Height   int
+	BlurHash string
+	// Original is the source re-encoded without metadata. It is nil for
+	// formats that cannot be rewritten losslessly (GIF animations, WebP),
+	// in which case the stored blob should be left as is.
+	Original   *Variant
+	Thumbnails []Variant
+}
+
+// Process decodes an image, applies its EXIF orientation, strips metadata
+// by re-encoding it and renders thumbnails whose longest edge matches each
+// of the given sizes. Sizes larger than the source are skipped.
+func Process(r io.Reader, sizes []int) (*Result, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(raw))
+	}
+
+	bounds := img.Bounds()
+	res := &Result{
+		Width:  bounds.Dx(),
+		Height: bounds.Dy(),
+	}
+
+	if format == "jpeg" || format == "png" {
+		original, err := encode(img, format)
+		if err != nil {
+			return nil, err
+		}
+		res.Original = &original
+	}
+
+	for _, size := range sizes {
+		if size >= res.Width && size >= res.Height {
+			continue
+		}
+
+		thumb, err := encode(resize(img, size), format)
+		if err != nil {
+			return nil, err
+		}
+		res.Thumbnails = append(res.Thumbnails, thumb)
+	}
+
+	res.BlurHash, err = blurhash.Encode(4, 3, resize(img, 32))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode blurhash: %w", err)
+	}
+
+	return res, nil
+}
+
+func resize(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	if w >= h {
+		h = max(1, h*size/w)
+		w = size
+	} else {
+		w = max(1, w*size/h)
+		h = size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+// encode keeps JPEG sources as JPEG and writes everything else as PNG so
+// transparency survives.
+func encode(img image.Image, format string) (Variant, error) {
+	var buf bytes.Buffer
+	variant := Variant{
+		Width:  img.Bounds().Dx(),
+		Height: img.Bounds().Dy(),
+	}
+
+	switch format {
+	case "jpeg":
+		variant.ContentType = "image/jpeg"
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+			return variant, err
+		}
+	default:
+		variant.ContentType = "image/png"
+		if err := png.Encode(&buf, img); err != nil {
+			return variant, err
+		}
+	}
+
+	variant.Data = buf.Bytes()
+
+	return variant, nil
+}
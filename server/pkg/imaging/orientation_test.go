@@ -0,0 +1,84 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, img, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+// exifSegment builds an APP1 segment holding a little-endian TIFF header
+// with a single orientation entry.
+func exifSegment(orientation uint16) []byte {
+	tiff := []byte{
+		'I', 'I', 0x2A, 0x00, 0x08, 0x00, 0x00, 0x00,
+		0x01, 0x00,
+		0x12, 0x01, 0x03, 0x00, 0x01, 0x00, 0x00, 0x00, byte(orientation), byte(orientation >> 8), 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	length := len(payload) + 2
+
+	return append([]byte{0xFF, 0xE1, byte(length >> 8), byte(length)}, payload...)
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	body := testJPEG(t)[2:]
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "empty", data: nil, want: 1},
+		{name: "not a jpeg", data: []byte("GIF89a"), want: 1},
+		{name: "no exif", data: append([]byte{0xFF, 0xD8}, body...), want: 1},
+		{name: "rotated", data: append(append([]byte{0xFF, 0xD8}, exifSegment(6)...), body...), want: 6},
+		{name: "invalid tag value", data: append(append([]byte{0xFF, 0xD8}, exifSegment(9)...), body...), want: 1},
+		{name: "segment length below 2", data: append([]byte{0xFF, 0xD8, 0xFF, 0xD0, 0x00, 0x01}, body...), want: 1},
+		{name: "segment length 0", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x00}, want: 1},
+		{name: "truncated segment", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x40, 'E', 'x'}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := jpegOrientation(tt.data)
+			if got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// A segment length below 2 used to slice with inverted bounds and panic
+// in the worker, taking the server down.
+func TestProcessShortSegmentLength(t *testing.T) {
+	data := append([]byte{0xFF, 0xD8, 0xFF, 0xD0, 0x00, 0x01}, testJPEG(t)[2:]...)
+
+	res, err := Process(bytes.NewReader(data), []int{2})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	if res.Width != 4 || res.Height != 2 {
+		t.Errorf("Process() size = %dx%d, want 4x2", res.Width, res.Height)
+	}
+}
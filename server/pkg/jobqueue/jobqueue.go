@@ -0,0 +1,100 @@
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+var ErrQueueFull = errors.New("job queue is full")
+
+type Handler func(ctx context.Context, payload string) error
+
+// Queue is a bounded in-process work queue served by a fixed pool of
+// workers. Jobs are not persisted, so callers should keep enough state in
+// the database to re-enqueue unfinished work on startup.
+type Queue struct {
+	name    string
+	jobs    chan string
+	workers int
+	zlog    zerolog.Logger
+	wg      sync.WaitGroup
+}
+
+func New(name string, size, workers int, zlog zerolog.Logger) *Queue {
+	if size <= 0 {
+		size = 100
+	}
+
+	if workers <= 0 {
+		workers = 1
+	}
+
+	return &Queue{
+		name:    name,
+		jobs:    make(chan string, size),
+		workers: workers,
+		zlog:    zlog,
+	}
+}
+
+func (q *Queue) Enqueue(payload string) error {
+	select {
+	case q.jobs <- payload:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// EnqueueWait blocks until there is room in the queue or ctx is done.
+func (q *Queue) EnqueueWait(ctx context.Context, payload string) error {
+	select {
+	case q.jobs <- payload:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Start launches the workers. They stop once ctx is cancelled; Wait blocks
+// until they have finished their current job.
+func (q *Queue) Start(ctx context.Context, handler Handler) {
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case payload := <-q.jobs:
+					if err := run(ctx, handler, payload); err != nil {
+						q.zlog.Error().Err(err).Str("queue", q.name).Str("payload", payload).Msg("job failed")
+					}
+				}
+			}
+		}()
+	}
+}
+
+// run calls handler, turning a panic into an error so a bad job fails on
+// its own instead of taking the process down.
+func run(ctx context.Context, handler Handler, payload string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return handler(ctx, payload)
+}
+
+func (q *Queue) Wait() {
+	q.wg.Wait()
+}
@@ -0,0 +1,68 @@
+package jobqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestQueueSurvivesPanickingJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := New("test", 2, 1, zerolog.Nop())
+	done := make(chan string, 1)
+
+	q.Start(ctx, func(ctx context.Context, payload string) error {
+		if payload == "bad" {
+			var m map[string]int
+			m["boom"]++
+		}
+		done <- payload
+		return nil
+	})
+
+	for _, payload := range []string{"bad", "good"} {
+		err := q.Enqueue(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case got := <-done:
+		if got != "good" {
+			t.Fatalf("handled %q, want %q", got, "good")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("worker stopped after a panicking job")
+	}
+
+	cancel()
+	q.Wait()
+}
+
+func TestRunRecoversPanic(t *testing.T) {
+	err := run(context.Background(), func(ctx context.Context, payload string) error {
+		panic("boom")
+	}, "x")
+	if err == nil {
+		t.Fatal("run() error = nil, want the recovered panic")
+	}
+}
+
+func TestEnqueueFull(t *testing.T) {
+	q := New("test", 1, 1, zerolog.Nop())
+
+	err := q.Enqueue("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = q.Enqueue("b")
+	if err != ErrQueueFull {
+		t.Fatalf("Enqueue() error = %v, want %v", err, ErrQueueFull)
+	}
+}
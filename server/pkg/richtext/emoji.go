@@ -0,0 +1,65 @@
+package richtext
+
+var emojiShortcodes = map[string]string{
+	"smile":            "😄",
+	"smiley":           "😃",
+	"grin":             "😁",
+	"joy":              "😂",
+	"rofl":             "🤣",
+	"wink":             "😉",
+	"blush":            "😊",
+	"heart_eyes":       "😍",
+	"thinking":         "🤔",
+	"neutral_face":     "😐",
+	"expressionless":   "😑",
+	"unamused":         "😒",
+	"sweat_smile":      "😅",
+	"cry":              "😢",
+	"sob":              "😭",
+	"angry":            "😠",
+	"rage":             "😡",
+	"scream":           "😱",
+	"sunglasses":       "😎",
+	"sleeping":         "😴",
+	"shrug":            "🤷",
+	"facepalm":         "🤦",
+	"thumbsup":         "👍",
+	"+1":               "👍",
+	"thumbsdown":       "👎",
+	"-1":               "👎",
+	"ok_hand":          "👌",
+	"clap":             "👏",
+	"wave":             "👋",
+	"pray":             "🙏",
+	"muscle":           "💪",
+	"eyes":             "👀",
+	"raised_hands":     "🙌",
+	"heart":            "❤️",
+	"broken_heart":     "💔",
+	"fire":             "🔥",
+	"sparkles":         "✨",
+	"star":             "⭐",
+	"tada":             "🎉",
+	"rocket":           "🚀",
+	"100":              "💯",
+	"white_check_mark": "✅",
+	"x":                "❌",
+	"warning":          "⚠️",
+	"question":         "❓",
+	"exclamation":      "❗",
+	"bulb":             "💡",
+	"bug":              "🐛",
+	"coffee":           "☕",
+	"pizza":            "🍕",
+	"beers":            "🍻",
+	"zap":              "⚡",
+	"memo":             "📝",
+	"calendar":         "📅",
+	"lock":             "🔒",
+	"link":             "🔗",
+}
+
+func LookupEmoji(shortcode string) (string, bool) {
+	emoji, ok := emojiShortcodes[shortcode]
+	return emoji, ok
+}
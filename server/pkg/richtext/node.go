@@ -0,0 +1,79 @@
+package richtext
+
+const (
+	FormatPlain    = "plain"
+	FormatMarkdown = "markdown"
+)
+
+const (
+	BlockParagraph = "paragraph"
+	BlockCode      = "code_block"
+	BlockQuote     = "quote"
+)
+
+const (
+	InlineText    = "text"
+	InlineBold    = "bold"
+	InlineItalic  = "italic"
+	InlineStrike  = "strike"
+	InlineCode    = "code"
+	InlineLink    = "link"
+	InlineMention = "mention"
+	InlineEmoji   = "emoji"
+)
+
+// Node is a single element of a parsed message. Block nodes hold inline
+// nodes in Children; a code block keeps its raw source in Text instead.
+// The tree is stored as JSON alongside the message.
+type Node struct {
+	Type     string  `json:"type"`
+	Text     string  `json:"text,omitempty"`
+	Language string  `json:"language,omitempty"`
+	URL      string  `json:"url,omitempty"`
+	UserID   string  `json:"userId,omitempty"`
+	Emoji    string  `json:"emoji,omitempty"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+// Walk calls fn for every node in depth-first order.
+func Walk(nodes []*Node, fn func(n *Node)) {
+	for _, n := range nodes {
+		fn(n)
+		Walk(n.Children, fn)
+	}
+}
+
+// Mentions returns the distinct user IDs mentioned in blocks.
+func Mentions(blocks []*Node) []string {
+	seen := map[string]bool{}
+	var ids []string
+
+	Walk(blocks, func(n *Node) {
+		if n.Type == InlineMention && !seen[n.UserID] {
+			seen[n.UserID] = true
+			ids = append(ids, n.UserID)
+		}
+	})
+
+	return ids
+}
+
+// ResolveMentions fills in the display text of mention nodes. Mentions of
+// unknown users are turned back into the literal text that was typed.
+func ResolveMentions(blocks []*Node, lookup func(userID string) (string, bool)) {
+	Walk(blocks, func(n *Node) {
+		if n.Type != InlineMention {
+			return
+		}
+
+		name, ok := lookup(n.UserID)
+		if !ok {
+			n.Type = InlineText
+			n.Text = "<@" + n.UserID + ">"
+			n.UserID = ""
+			return
+		}
+
+		n.Text = "@" + name
+	})
+}
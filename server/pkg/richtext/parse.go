@@ -0,0 +1,283 @@
+package richtext
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxDepth bounds inline nesting so crafted input cannot recurse deeply.
+const maxDepth = 6
+
+// Parse turns message content into blocks. Plain content is split into
+// paragraphs of text only; markdown content supports bold, italic, strike,
+// inline code, code blocks, quotes, links, mentions (<@userID>) and emoji
+// shortcodes (:smile:). Raw HTML is never passed through.
+func Parse(src, format string) []*Node {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+
+	if format != FormatMarkdown {
+		return parsePlain(src)
+	}
+
+	return parseMarkdown(src)
+}
+
+func parsePlain(src string) []*Node {
+	blocks := []*Node{}
+	for _, p := range splitParagraphs(strings.Split(src, "\n")) {
+		blocks = append(blocks, &Node{
+			Type:     BlockParagraph,
+			Children: []*Node{{Type: InlineText, Text: p}},
+		})
+	}
+
+	return blocks
+}
+
+func parseMarkdown(src string) []*Node {
+	blocks := []*Node{}
+	lines := strings.Split(src, "\n")
+
+	var para []string
+	flush := func() {
+		for _, p := range splitParagraphs(para) {
+			blocks = append(blocks, &Node{Type: BlockParagraph, Children: parseInline(p, 0)})
+		}
+		para = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+
+			var code []string
+			j := i + 1
+			for ; j < len(lines) && strings.TrimSpace(lines[j]) != "```"; j++ {
+				code = append(code, lines[j])
+			}
+
+			blocks = append(blocks, &Node{
+				Type:     BlockCode,
+				Language: sanitizeLanguage(strings.TrimPrefix(trimmed, "```")),
+				Text:     strings.Join(code, "\n"),
+			})
+			i = j
+		case strings.HasPrefix(trimmed, ">"):
+			flush()
+
+			var quote []string
+			j := i
+			for ; j < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[j]), ">"); j++ {
+				q := strings.TrimPrefix(strings.TrimSpace(lines[j]), ">")
+				quote = append(quote, strings.TrimPrefix(q, " "))
+			}
+
+			blocks = append(blocks, &Node{Type: BlockQuote, Children: parseInline(strings.Join(quote, "\n"), 0)})
+			i = j - 1
+		default:
+			para = append(para, line)
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// splitParagraphs groups lines into paragraphs separated by blank lines.
+func splitParagraphs(lines []string) []string {
+	var paragraphs []string
+	var current []string
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				paragraphs = append(paragraphs, strings.Join(current, "\n"))
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+
+	if len(current) > 0 {
+		paragraphs = append(paragraphs, strings.Join(current, "\n"))
+	}
+
+	return paragraphs
+}
+
+func parseInline(s string, depth int) []*Node {
+	nodes := []*Node{}
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, &Node{Type: InlineText, Text: text.String()})
+			text.Reset()
+		}
+	}
+
+	emit := func(n *Node) {
+		flush()
+		nodes = append(nodes, n)
+	}
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+
+		if depth < maxDepth {
+			if n, size := matchInline(s, i, depth); n != nil {
+				emit(n)
+				i += size
+				continue
+			}
+		}
+
+		if rest[0] == '\\' && len(rest) > 1 && strings.ContainsRune("\\`*_~[]<:>", rune(rest[1])) {
+			text.WriteByte(rest[1])
+			i += 2
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(rest)
+		text.WriteRune(r)
+		i += size
+	}
+	flush()
+
+	return nodes
+}
+
+// matchInline tries every inline construct at s[i:] and returns the node
+// together with the number of bytes it consumed.
+func matchInline(s string, i, depth int) (*Node, int) {
+	rest := s[i:]
+
+	switch {
+	case rest[0] == '`':
+		if end := strings.IndexByte(rest[1:], '`'); end > 0 {
+			return &Node{Type: InlineCode, Text: rest[1 : end+1]}, end + 2
+		}
+	case strings.HasPrefix(rest, "**"):
+		if inner, size, ok := delimited(rest, "**"); ok {
+			return &Node{Type: InlineBold, Children: parseInline(inner, depth+1)}, size
+		}
+	case strings.HasPrefix(rest, "~~"):
+		if inner, size, ok := delimited(rest, "~~"); ok {
+			return &Node{Type: InlineStrike, Children: parseInline(inner, depth+1)}, size
+		}
+	case rest[0] == '*' || (rest[0] == '_' && atWordStart(s, i)):
+		if inner, size, ok := delimited(rest, rest[:1]); ok {
+			return &Node{Type: InlineItalic, Children: parseInline(inner, depth+1)}, size
+		}
+	case rest[0] == '[':
+		return matchLink(rest, depth)
+	case strings.HasPrefix(rest, "<@"):
+		end := strings.IndexByte(rest, '>')
+		if end > 2 {
+			if id, err := uuid.Parse(rest[2:end]); err == nil {
+				return &Node{Type: InlineMention, UserID: id.String()}, end + 1
+			}
+		}
+	case rest[0] == ':':
+		end := strings.IndexByte(rest[1:], ':')
+		if end > 0 && end <= 32 {
+			if emoji, ok := LookupEmoji(rest[1 : end+1]); ok {
+				return &Node{Type: InlineEmoji, Text: rest[:end+2], Emoji: emoji}, end + 2
+			}
+		}
+	case (strings.HasPrefix(rest, "http://") || strings.HasPrefix(rest, "https://")) && atWordStart(s, i):
+		end := strings.IndexFunc(rest, unicode.IsSpace)
+		if end < 0 {
+			end = len(rest)
+		}
+
+		link := strings.TrimRight(rest[:end], ".,;:!?)]}'\"")
+		if safeURL(link) {
+			return &Node{Type: InlineLink, URL: link, Children: []*Node{{Type: InlineText, Text: link}}}, len(link)
+		}
+	}
+
+	return nil, 0
+}
+
+func matchLink(rest string, depth int) (*Node, int) {
+	closeText := strings.Index(rest, "](")
+	if closeText < 1 {
+		return nil, 0
+	}
+
+	closeURL := strings.IndexByte(rest[closeText+2:], ')')
+	if closeURL < 1 {
+		return nil, 0
+	}
+
+	label := rest[1:closeText]
+	link := strings.TrimSpace(rest[closeText+2 : closeText+2+closeURL])
+	if !safeURL(link) {
+		return nil, 0
+	}
+
+	return &Node{Type: InlineLink, URL: link, Children: parseInline(label, depth+1)}, closeText + 3 + closeURL
+}
+
+// delimited matches an opening delimiter at the start of s and its closing
+// counterpart, returning the non-empty content between them.
+func delimited(s, delim string) (string, int, bool) {
+	body := s[len(delim):]
+	end := strings.Index(body, delim)
+	if end <= 0 {
+		return "", 0, false
+	}
+
+	inner := body[:end]
+	if strings.TrimSpace(inner) != inner {
+		return "", 0, false
+	}
+
+	return inner, len(delim)*2 + end, true
+}
+
+func atWordStart(s string, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func safeURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return u.Host != ""
+	case "mailto":
+		return u.Opaque != ""
+	default:
+		return false
+	}
+}
+
+func sanitizeLanguage(lang string) string {
+	lang = strings.TrimSpace(lang)
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '+' || r == '-' || r == '#' {
+			return r
+		}
+		return -1
+	}, lang)
+}
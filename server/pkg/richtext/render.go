@@ -0,0 +1,71 @@
+package richtext
+
+import (
+	"html"
+	"strings"
+)
+
+// RenderHTML renders blocks to HTML. Every piece of text is escaped and
+// only the tags produced here can appear, so the output is safe to embed
+// as long as the blocks came from Parse.
+func RenderHTML(blocks []*Node) string {
+	var b strings.Builder
+
+	for _, n := range blocks {
+		switch n.Type {
+		case BlockCode:
+			b.WriteString("<pre><code")
+			if n.Language != "" {
+				b.WriteString(` class="language-` + html.EscapeString(n.Language) + `"`)
+			}
+			b.WriteString(">")
+			b.WriteString(html.EscapeString(n.Text))
+			b.WriteString("</code></pre>")
+		case BlockQuote:
+			b.WriteString("<blockquote>")
+			renderInline(&b, n.Children)
+			b.WriteString("</blockquote>")
+		default:
+			b.WriteString("<p>")
+			renderInline(&b, n.Children)
+			b.WriteString("</p>")
+		}
+	}
+
+	return b.String()
+}
+
+func renderInline(b *strings.Builder, nodes []*Node) {
+	for _, n := range nodes {
+		switch n.Type {
+		case InlineBold:
+			wrap(b, "strong", n.Children)
+		case InlineItalic:
+			wrap(b, "em", n.Children)
+		case InlineStrike:
+			wrap(b, "del", n.Children)
+		case InlineCode:
+			b.WriteString("<code>" + html.EscapeString(n.Text) + "</code>")
+		case InlineLink:
+			b.WriteString(`<a href="` + html.EscapeString(n.URL) + `" rel="nofollow noopener noreferrer" target="_blank">`)
+			renderInline(b, n.Children)
+			b.WriteString("</a>")
+		case InlineMention:
+			b.WriteString(`<span class="mention" data-user-id="` + html.EscapeString(n.UserID) + `">`)
+			b.WriteString(html.EscapeString(n.Text))
+			b.WriteString("</span>")
+		case InlineEmoji:
+			b.WriteString(`<span class="emoji" title="` + html.EscapeString(n.Text) + `">`)
+			b.WriteString(html.EscapeString(n.Emoji))
+			b.WriteString("</span>")
+		default:
+			b.WriteString(strings.ReplaceAll(html.EscapeString(n.Text), "\n", "<br>"))
+		}
+	}
+}
+
+func wrap(b *strings.Builder, tag string, children []*Node) {
+	b.WriteString("<" + tag + ">")
+	renderInline(b, children)
+	b.WriteString("</" + tag + ">")
+}
@@ -0,0 +1,105 @@
+// Package safehttp builds HTTP clients for calling user-supplied URLs
+// without letting them reach loopback, private or link-local addresses.
+package safehttp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var ErrBlockedAddress = errors.New("destination address is not allowed")
+
+type Options struct {
+	Timeout      time.Duration
+	MaxRedirects int
+	// AllowPrivate disables the SSRF guard. It exists for local development
+	// and tests against loopback servers and must stay off in production.
+	AllowPrivate bool
+
+	// allowAddr exempts individual dial addresses from the guard. Tests use
+	// it to reach one loopback listener while the rest stay blocked.
+	allowAddr func(address string) bool
+}
+
+func NewClient(opts Options) *http.Client {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = 3
+	}
+
+	dialer := &net.Dialer{
+		Timeout: opts.Timeout,
+		// Control runs after DNS resolution, so the check applies to the
+		// address actually dialed and cannot be bypassed by DNS rebinding.
+		Control: func(network, address string, _ syscall.RawConn) error {
+			if opts.AllowPrivate || (opts.allowAddr != nil && opts.allowAddr(address)) {
+				return nil
+			}
+
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !IsPublicIP(net.ParseIP(host)) {
+				return ErrBlockedAddress
+			}
+
+			return nil
+		},
+	}
+
+	transport := &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   opts.Timeout,
+		ResponseHeaderTimeout: opts.Timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= opts.MaxRedirects {
+				return fmt.Errorf("stopped after %d redirects", opts.MaxRedirects)
+			}
+
+			if !IsHTTP(req.URL) {
+				return ErrBlockedAddress
+			}
+
+			return nil
+		},
+	}
+}
+
+func IsHTTP(u *url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!sharedAddressSpace.Contains(ip)
+}
@@ -0,0 +1,147 @@
+package safehttp
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1::", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := IsPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("IsPublicIP(%q) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsHTTP(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{"http://example.com", true},
+		{"https://example.com/path", true},
+		{"ftp://example.com", false},
+		{"file:///etc/passwd", false},
+		{"http://", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			u, err := url.Parse(tt.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := IsHTTP(u); got != tt.want {
+				t.Errorf("IsHTTP(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached the loopback server")
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(Options{}).Get(srv.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("Get() error = %v, want %v", err, ErrBlockedAddress)
+	}
+}
+
+func TestClientAllowPrivate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	resp, err := NewClient(Options{AllowPrivate: true}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientRefusesRedirectToInternalHost(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("redirect reached the internal server")
+	}))
+	defer internal.Close()
+
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer public.Close()
+
+	publicAddr := public.Listener.Addr().String()
+	client := NewClient(Options{
+		allowAddr: func(address string) bool { return address == publicAddr },
+	})
+
+	_, err := client.Get(public.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("Get() error = %v, want %v", err, ErrBlockedAddress)
+	}
+}
+
+func TestClientRefusesRedirectToOtherScheme(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(Options{AllowPrivate: true}).Get(srv.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("Get() error = %v, want %v", err, ErrBlockedAddress)
+	}
+}
+
+func TestClientStopsAfterMaxRedirects(t *testing.T) {
+	hops := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hops++
+		http.Redirect(w, r, "/next", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(Options{AllowPrivate: true, MaxRedirects: 2}).Get(srv.URL)
+	if err == nil {
+		t.Fatal("Get() error = nil, want a redirect limit error")
+	}
+
+	if hops != 2 {
+		t.Fatalf("server saw %d requests, want 2", hops)
+	}
+}
@@ -0,0 +1,76 @@
+package signer
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		parts  []string
+		want   string
+	}{
+		// Reference values from: printf '<parts joined by \n>' | openssl dgst -sha256 -hmac <secret>
+		{"two parts", "secret", []string{"a", "b"}, "26e7f5daecb7e04d0a1181dfc06ea4011dd156da92add1b3f4a3c7bd172c2fa3"},
+		{"one part", "secret", []string{"a\nb"}, "26e7f5daecb7e04d0a1181dfc06ea4011dd156da92add1b3f4a3c7bd172c2fa3"},
+		{"no parts", "secret", nil, "f9e66e179b6747ae54108f82f8ade8b3c25d76fd30afde6c395822c530196169"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.parts...); got != tt.want {
+				t.Errorf("Sign() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	signature := Sign("secret", "id", "variant", "1700000000")
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+		parts     []string
+		want      bool
+	}{
+		{"valid", "secret", signature, []string{"id", "variant", "1700000000"}, true},
+		{"wrong secret", "other", signature, []string{"id", "variant", "1700000000"}, false},
+		{"changed part", "secret", signature, []string{"id", "variant", "1700000001"}, false},
+		{"empty signature", "secret", "", []string{"id", "variant", "1700000000"}, false},
+		{"uppercase hex", "secret", strings.ToUpper(signature), []string{"id", "variant", "1700000000"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.signature, tt.parts...); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	a, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := hex.DecodeString(a)
+	if err != nil || len(raw) != 32 {
+		t.Fatalf("NewSecret() = %q, want 32 hex encoded bytes", a)
+	}
+
+	if a == b {
+		t.Fatal("NewSecret() returned the same secret twice")
+	}
+}
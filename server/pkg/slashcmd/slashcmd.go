@@ -0,0 +1,194 @@
+// Package slashcmd parses slash commands typed into the composer and calls
+// externally registered command endpoints.
+package slashcmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"chatspace-server/pkg/signer"
+)
+
+const (
+	ResponseEphemeral = "ephemeral"
+	ResponseInChannel = "in_channel"
+
+	FormatPlain    = "plain"
+	FormatMarkdown = "markdown"
+
+	HeaderTimestamp = "X-ChatSpace-Timestamp"
+	HeaderSignature = "X-ChatSpace-Signature"
+)
+
+var ErrResponseTooLarge = errors.New("command response is too large")
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// Request is the JSON body posted to an external command endpoint.
+type Request struct {
+	Command  string `json:"command"`
+	Text     string `json:"text"`
+	SpaceID  string `json:"spaceId"`
+	UserID   string `json:"userId"`
+	UserName string `json:"userName"`
+}
+
+// Response is what a command produces. Ephemeral responses are shown only
+// to the invoker; in-channel responses are posted as the invoker.
+type Response struct {
+	Text         string `json:"text"`
+	ResponseType string `json:"responseType"`
+	Format       string `json:"format"`
+}
+
+func Ephemeral(format string, args ...any) *Response {
+	return &Response{Text: fmt.Sprintf(format, args...), ResponseType: ResponseEphemeral, Format: FormatPlain}
+}
+
+func InChannel(text, format string) *Response {
+	return &Response{Text: text, ResponseType: ResponseInChannel, Format: format}
+}
+
+func (r *Response) IsEphemeral() bool {
+	return r.ResponseType != ResponseInChannel
+}
+
+// Parse splits "/name args" into its parts. Content starting with "//" is
+// an escaped literal slash and is not a command.
+func Parse(content string) (name, args string, ok bool) {
+	if !strings.HasPrefix(content, "/") || strings.HasPrefix(content, "//") {
+		return "", "", false
+	}
+
+	name = content[1:]
+	if i := strings.IndexFunc(name, unicode.IsSpace); i >= 0 {
+		name, args = name[:i], name[i:]
+	}
+
+	name = strings.ToLower(name)
+	if !ValidName(name) {
+		return "", "", false
+	}
+
+	return name, strings.TrimSpace(args), true
+}
+
+func ValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// ParseDuration accepts Go durations plus a "d" suffix for days, e.g.
+// "2h", "90m" or "1d12h".
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	var days time.Duration
+	if i := strings.Index(s, "d"); i > 0 {
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, err
+		}
+		days = time.Duration(n) * 24 * time.Hour
+		s = s[i+1:]
+	}
+
+	if s == "" {
+		return days, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return days + d, nil
+}
+
+// EscapeMarkdown escapes characters that would otherwise start inline
+// formatting. Mentions and emoji shortcodes are left intact.
+func EscapeMarkdown(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune("\\`*_~[]", r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+type Client struct {
+	http     *http.Client
+	maxBytes int64
+}
+
+func NewClient(httpClient *http.Client, maxBytes int64) *Client {
+	return &Client{
+		http:     httpClient,
+		maxBytes: maxBytes,
+	}
+}
+
+// Invoke posts the request to an external command. The body is signed
+// with HMAC-SHA256 over the timestamp and body so the endpoint can verify
+// it came from this server.
+func (c *Client) Invoke(ctx context.Context, url, secret string, request *Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+signer.Sign(secret, timestamp, string(body)))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, c.maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > c.maxBytes {
+		return nil, ErrResponseTooLarge
+	}
+
+	var response Response
+	if len(bytes.TrimSpace(data)) > 0 {
+		err = json.Unmarshal(data, &response)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if response.Format != FormatMarkdown {
+		response.Format = FormatPlain
+	}
+
+	return &response, nil
+}
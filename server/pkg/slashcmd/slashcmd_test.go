@@ -0,0 +1,189 @@
+package slashcmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"chatspace-server/pkg/signer"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		content  string
+		wantName string
+		wantArgs string
+		wantOK   bool
+	}{
+		{"/me waves", "me", "waves", true},
+		{"/shrug", "shrug", "", true},
+		{"/TOPIC  Release day ", "topic", "Release day", true},
+		{"/topic\tnew topic", "topic", "new topic", true},
+		{"/deploy-app now", "deploy-app", "now", true},
+		{"//me escaped", "", "", false},
+		{"hello /me", "", "", false},
+		{"/", "", "", false},
+		{"/ me", "", "", false},
+		{"/bad!name", "", "", false},
+		{"/a/b", "", "", false},
+		{"/" + strings.Repeat("a", 33), "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.content, func(t *testing.T) {
+			name, args, ok := Parse(tt.content)
+			if name != tt.wantName || args != tt.wantArgs || ok != tt.wantOK {
+				t.Errorf("Parse(%q) = %q, %q, %v, want %q, %q, %v",
+					tt.content, name, args, ok, tt.wantName, tt.wantArgs, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"2h", 2 * time.Hour, false},
+		{"90m", 90 * time.Minute, false},
+		{"1d", 24 * time.Hour, false},
+		{"1d12h", 36 * time.Hour, false},
+		{" 2D ", 48 * time.Hour, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"", 0, false},
+		{"d", 0, true},
+		{"xd", 0, true},
+		{"1.5d", 0, true},
+		{"1dfoo", 0, true},
+		{"soon", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Fatalf("ParseDuration(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain text", "plain text"},
+		{"*bold* _it_ ~s~", `\*bold\* \_it\_ \~s\~`},
+		{"`code` [link]", "\\`code\\` \\[link\\]"},
+		{`back\slash`, `back\\slash`},
+		{"<@user> :tada:", "<@user> :tada:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := EscapeMarkdown(tt.in); got != tt.want {
+				t.Errorf("EscapeMarkdown(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	const secret = "command-secret"
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    *Response
+		wantErr error
+	}{
+		{
+			name:   "in channel markdown",
+			status: http.StatusOK,
+			body:   `{"text":"*done*","responseType":"in_channel","format":"markdown"}`,
+			want:   &Response{Text: "*done*", ResponseType: ResponseInChannel, Format: FormatMarkdown},
+		},
+		{
+			name:   "unknown format is plain",
+			status: http.StatusOK,
+			body:   `{"text":"hi","format":"html"}`,
+			want:   &Response{Text: "hi", Format: FormatPlain},
+		},
+		{
+			name:   "empty body",
+			status: http.StatusOK,
+			body:   "",
+			want:   &Response{Format: FormatPlain},
+		},
+		{
+			name:    "too large",
+			status:  http.StatusOK,
+			body:    `{"text":"` + strings.Repeat("x", 100) + `"}`,
+			wantErr: ErrResponseTooLarge,
+		},
+		{
+			name:   "bad status",
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body := new(strings.Builder)
+				_, _ = io.Copy(body, r.Body)
+
+				timestamp := r.Header.Get(HeaderTimestamp)
+				signature := strings.TrimPrefix(r.Header.Get(HeaderSignature), "sha256=")
+				if !signer.Verify(secret, signature, timestamp, body.String()) {
+					t.Error("request signature does not verify")
+				}
+
+				var req Request
+				err := json.Unmarshal([]byte(body.String()), &req)
+				if err != nil || req.Command != "deploy" || req.Text != "now" {
+					t.Errorf("request = %+v, %v", req, err)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			client := NewClient(srv.Client(), 80)
+			got, err := client.Invoke(context.Background(), srv.URL, secret, &Request{Command: "deploy", Text: "now"})
+
+			if tt.want == nil {
+				if err == nil {
+					t.Fatal("Invoke() error = nil, want an error")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Invoke() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if *got != *tt.want {
+				t.Fatalf("Invoke() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"chatspace-server/pkg/safehttp"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	ErrBlockedAddress = safehttp.ErrBlockedAddress
+	ErrNotHTML        = errors.New("response is not an html document")
+)
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `]+`)
+
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+type Options struct {
+	Timeout      time.Duration
+	MaxBytes     int64
+	MaxRedirects int
+	UserAgent    string
+	// AllowPrivate disables the SSRF guard. It exists for local development
+	// and tests against loopback servers and must stay off in production.
+	AllowPrivate bool
+}
+
+type Fetcher struct {
+	client *http.Client
+	opts   Options
+}
+
+func NewFetcher(opts Options) *Fetcher {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = 1 << 20
+	}
+
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = 3
+	}
+
+	if opts.UserAgent == "" {
+		opts.UserAgent = "ChatSpaceBot/1.0 (+link preview)"
+	}
+
+	client := safehttp.NewClient(safehttp.Options{
+		Timeout:      opts.Timeout,
+		MaxRedirects: opts.MaxRedirects,
+		AllowPrivate: opts.AllowPrivate,
+	})
+
+	return &Fetcher{
+		client: client,
+		opts:   opts,
+	}
+}
+
+// ExtractURLs returns up to limit distinct http(s) URLs found in text, in
+// order of appearance.
+func ExtractURLs(text string, limit int) []string {
+	seen := map[string]bool{}
+	var urls []string
+
+	for _, match := range urlPattern.FindAllString(text, -1) {
+		match = strings.TrimRight(match, ".,;:!?)]}")
+
+		u, err := url.Parse(match)
+		if err != nil || u.Host == "" || seen[match] {
+			continue
+		}
+
+		seen[match] = true
+		urls = append(urls, match)
+
+		if len(urls) == limit {
+			break
+		}
+	}
+
+	return urls
+}
+
+// Fetch downloads rawURL and extracts OpenGraph, Twitter card and plain
+// HTML metadata from its head.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if !safehttp.IsHTTP(u) {
+		return nil, ErrBlockedAddress
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, ErrNotHTML
+	}
+
+	meta := parseHead(io.LimitReader(resp.Body, f.opts.MaxBytes))
+
+	preview := &Preview{
+		URL:         rawURL,
+		Title:       truncate(first(meta["og:title"], meta["twitter:title"], meta["title"]), 300),
+		Description: truncate(first(meta["og:description"], meta["twitter:description"], meta["description"]), 1000),
+		SiteName:    truncate(first(meta["og:site_name"], resp.Request.URL.Hostname()), 100),
+	}
+
+	image := first(meta["og:image"], meta["og:image:url"], meta["twitter:image"], meta["twitter:image:src"])
+	if image != "" {
+		if ref, err := resp.Request.URL.Parse(image); err == nil && safehttp.IsHTTP(ref) {
+			preview.ImageURL = ref.String()
+		}
+	}
+
+	return preview, nil
+}
+
+func parseHead(r io.Reader) map[string]string {
+	meta := map[string]string{}
+	z := html.NewTokenizer(r)
+	inTitle := false
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return meta
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			switch tok.Data {
+			case "body":
+				return meta
+			case "title":
+				inTitle = true
+			case "meta":
+				var key, content string
+				for _, attr := range tok.Attr {
+					switch strings.ToLower(attr.Key) {
+					case "property", "name":
+						key = strings.ToLower(strings.TrimSpace(attr.Val))
+					case "content":
+						content = strings.TrimSpace(attr.Val)
+					}
+				}
+
+				if key != "" && content != "" && meta[key] == "" {
+					meta[key] = content
+				}
+			}
+		case html.TextToken:
+			if inTitle && meta["title"] == "" {
+				meta["title"] = strings.TrimSpace(string(z.Text()))
+			}
+		case html.EndTagToken:
+			tok := z.Token()
+			switch tok.Data {
+			case "title":
+				inTitle = false
+			case "head":
+				return meta
+			}
+		}
+	}
+}
+
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n])
+}
@@ -0,0 +1,187 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func serveHTML(t *testing.T, contentType, body string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestFetch(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want Preview
+	}{
+		{
+			name: "opengraph",
+			body: `<html><head>
+				<title>Plain title</title>
+				<meta property="og:title" content="OG title">
+				<meta property="og:description" content="OG description">
+				<meta property="og:site_name" content="Example">
+				<meta property="og:image" content="/cover.png">
+				</head><body></body></html>`,
+			want: Preview{
+				Title:       "OG title",
+				Description: "OG description",
+				SiteName:    "Example",
+				ImageURL:    "/cover.png",
+			},
+		},
+		{
+			name: "twitter card",
+			body: `<head>
+				<meta name="twitter:title" content="Card title">
+				<meta name="twitter:description" content="Card description">
+				</head>`,
+			want: Preview{
+				Title:       "Card title",
+				Description: "Card description",
+			},
+		},
+		{
+			name: "title fallback",
+			body: `<html><head><title>  Plain title </title>
+				<meta name="description" content="Plain description">
+				</head></html>`,
+			want: Preview{
+				Title:       "Plain title",
+				Description: "Plain description",
+			},
+		},
+		{
+			name: "ignores body",
+			body: `<html><head></head><body><title>Not a title</title></body></html>`,
+			want: Preview{},
+		},
+		{
+			name: "non http image",
+			body: `<head><meta property="og:image" content="javascript:alert(1)"></head>`,
+			want: Preview{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := serveHTML(t, "text/html; charset=utf-8", tt.body)
+
+			got, err := NewFetcher(Options{AllowPrivate: true}).Fetch(context.Background(), srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := tt.want
+			want.URL = srv.URL
+			if want.SiteName == "" {
+				want.SiteName = "127.0.0.1"
+			}
+			if strings.HasPrefix(want.ImageURL, "/") {
+				want.ImageURL = srv.URL + want.ImageURL
+			}
+
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("Fetch() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestFetchTruncatesBody(t *testing.T) {
+	body := "<html><head><!--" + strings.Repeat("x", 4096) + `-->
+		<meta property="og:title" content="Past the limit">
+		</head></html>`
+	srv := serveHTML(t, "text/html", body)
+
+	preview, err := NewFetcher(Options{AllowPrivate: true, MaxBytes: 1024}).Fetch(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Title != "" {
+		t.Fatalf("Title = %q, want metadata past MaxBytes to be ignored", preview.Title)
+	}
+}
+
+func TestFetchTruncatesFields(t *testing.T) {
+	srv := serveHTML(t, "text/html", `<head><title>`+strings.Repeat("é", 500)+`</title></head>`)
+
+	preview, err := NewFetcher(Options{AllowPrivate: true}).Fetch(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len([]rune(preview.Title)); n != 300 {
+		t.Fatalf("Title has %d runes, want 300", n)
+	}
+}
+
+func TestFetchErrors(t *testing.T) {
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+
+	jsonSrv := serveHTML(t, "application/json", `{"title":"x"}`)
+
+	tests := []struct {
+		name    string
+		opts    Options
+		url     string
+		wantErr error
+	}{
+		{"loopback refused at dial", Options{}, jsonSrv.URL, ErrBlockedAddress},
+		{"non http scheme", Options{AllowPrivate: true}, "file:///etc/passwd", ErrBlockedAddress},
+		{"not html", Options{AllowPrivate: true}, jsonSrv.URL, ErrNotHTML},
+		{"bad status", Options{AllowPrivate: true}, notFound.URL, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFetcher(tt.opts).Fetch(context.Background(), tt.url)
+			if err == nil {
+				t.Fatal("Fetch() error = nil, want an error")
+			}
+
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Fetch() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtractURLs(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  []string
+	}{
+		{"none", "no links here", 3, nil},
+		{"trailing punctuation", "see https://example.com/a.", 3, []string{"https://example.com/a"}},
+		{"deduplicated", "http://a.test http://a.test http://b.test", 3, []string{"http://a.test", "http://b.test"}},
+		{"limit", "http://a.test http://b.test http://c.test", 2, []string{"http://a.test", "http://b.test"}},
+		{"in parentheses", "(https://example.com/x)", 3, []string{"https://example.com/x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractURLs(tt.text, tt.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractURLs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Package webhook delivers signed event payloads to subscriber endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"chatspace-server/pkg/signer"
+)
+
+const (
+	HeaderEvent     = "X-ChatSpace-Event"
+	HeaderDelivery  = "X-ChatSpace-Delivery"
+	HeaderTimestamp = "X-ChatSpace-Timestamp"
+	HeaderSignature = "X-ChatSpace-Signature"
+)
+
+// StatusError is returned when the endpoint answers with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.StatusCode)
+}
+
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		http: httpClient,
+	}
+}
+
+// Deliver posts body to url. The signature is HMAC-SHA256 over the
+// timestamp and body, sent as "sha256=<hex>". It returns the response
+// status code, or 0 when no response was received.
+func (c *Client) Deliver(ctx context.Context, url, secret, deliveryID, event string, body []byte) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+signer.Sign(secret, timestamp, string(body)))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Backoff returns the wait before retry number attempt (starting at 1):
+// base doubled per attempt, capped at max, with up to 20% jitter so
+// failing endpoints are not retried in lockstep.
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	d := base
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+
+	if d > max {
+		d = max
+	}
+
+	return d + time.Duration(rand.Int63n(int64(d)/5+1))
+}
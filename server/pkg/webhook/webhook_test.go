@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"chatspace-server/pkg/signer"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt int
+		base    time.Duration
+		max     time.Duration
+		want    time.Duration
+	}{
+		{"first attempt", 1, time.Second, time.Hour, time.Second},
+		{"zero attempt", 0, time.Second, time.Hour, time.Second},
+		{"doubles", 2, time.Second, time.Hour, 2 * time.Second},
+		{"doubles again", 4, time.Second, time.Hour, 8 * time.Second},
+		{"capped", 10, time.Second, time.Minute, time.Minute},
+		{"base above max", 1, time.Hour, time.Minute, time.Minute},
+		{"huge attempt", 1 << 20, time.Second, time.Minute, time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Jitter adds up to 20%, so check the whole range over several
+			// draws.
+			for range 50 {
+				got := Backoff(tt.attempt, tt.base, tt.max)
+				if got < tt.want || got > tt.want+tt.want/5 {
+					t.Fatalf("Backoff(%d, %s, %s) = %s, want within [%s, %s]",
+						tt.attempt, tt.base, tt.max, got, tt.want, tt.want+tt.want/5)
+				}
+			}
+		})
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	const secret = "webhook-secret"
+	body := []byte(`{"type":"MESSAGE_CREATED"}`)
+
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+		wantErr    bool
+	}{
+		{"ok", http.StatusOK, http.StatusOK, false},
+		{"accepted", http.StatusAccepted, http.StatusAccepted, false},
+		{"server error", http.StatusBadGateway, http.StatusBadGateway, true},
+		{"not modified", http.StatusNotModified, http.StatusNotModified, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ := io.ReadAll(r.Body)
+				if string(got) != string(body) {
+					t.Errorf("body = %s, want %s", got, body)
+				}
+
+				if r.Header.Get(HeaderEvent) != "message.created" || r.Header.Get(HeaderDelivery) != "delivery-1" {
+					t.Errorf("headers = %v", r.Header)
+				}
+
+				signature := strings.TrimPrefix(r.Header.Get(HeaderSignature), "sha256=")
+				if !signer.Verify(secret, signature, r.Header.Get(HeaderTimestamp), string(got)) {
+					t.Error("request signature does not verify")
+				}
+
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			status, err := NewClient(srv.Client()).Deliver(context.Background(), srv.URL, secret, "delivery-1", "message.created", body)
+			if status != tt.wantStatus {
+				t.Fatalf("Deliver() status = %d, want %d", status, tt.wantStatus)
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Deliver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var statusErr *StatusError
+			if tt.wantErr && (!errors.As(err, &statusErr) || statusErr.StatusCode != tt.status) {
+				t.Fatalf("Deliver() error = %v, want a StatusError for %d", err, tt.status)
+			}
+		})
+	}
+}
+
+func TestDeliverUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	status, err := NewClient(http.DefaultClient).Deliver(context.Background(), url, "s", "d", "e", nil)
+	if err == nil || status != 0 {
+		t.Fatalf("Deliver() = %d, %v, want 0 and an error", status, err)
+	}
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type RepoAttachment struct {
+	db *sqlx.DB
+}
+
+func NewAttachmentRepository(db *sqlx.DB) *RepoAttachment {
+	return &RepoAttachment{
+		db: db,
+	}
+}
+
+func (r *RepoAttachment) Create(ctx context.Context, attachment *model.AttachmentDB) (*string, error) {
+	attachment.ID = uuid.New()
+	now := time.Now()
+
+	query := `
+		INSERT INTO attachments (id, space_id, user_id, storage_key, filename, content_type, size, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, attachment.ID, attachment.SpaceID, attachment.UserID,
+		attachment.StorageKey, attachment.Filename, attachment.ContentType, attachment.Size, attachment.Status, now)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment.CreatedAt = now
+	idStr := attachment.ID.String()
+
+	return &idStr, nil
+}
+
+func (r *RepoAttachment) GetByID(ctx context.Context, id string) (*model.AttachmentDB, error) {
+	const query = `
+		SELECT id, space_id, user_id, message_id, storage_key, filename, content_type, size, status, width, height, blurhash, created_at
+		FROM attachments
+		WHERE id = $1
+	`
+
+	var attachment model.AttachmentDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &attachment, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+func (r *RepoAttachment) GetByMessageID(ctx context.Context, messageID string) ([]*model.AttachmentDB, error) {
+	const query = `
+		SELECT id, space_id, user_id, message_id, storage_key, filename, content_type, size, status, width, height, blurhash, created_at
+		FROM attachments
+		WHERE message_id = $1
+		ORDER BY created_at ASC
+	`
+
+	var attachments []*model.AttachmentDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &attachments, query, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// LinkToMessage attaches pending uploads to a message. Only uploads made by
+// the same user in the same space that are not yet linked are claimed.
+func (r *RepoAttachment) LinkToMessage(ctx context.Context, messageID, userID, spaceID uuid.UUID, ids []uuid.UUID) ([]*model.AttachmentDB, error) {
+	const query = `
+		UPDATE attachments
+		SET message_id = $1
+		WHERE id = ANY($2) AND user_id = $3 AND space_id = $4 AND message_id IS NULL
+		RETURNING id, space_id, user_id, message_id, storage_key, filename, content_type, size, status, width, height, blurhash, created_at
+	`
+
+	var attachments []*model.AttachmentDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &attachments, query, messageID, pq.Array(ids), userID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *RepoAttachment) GetPendingIDs(ctx context.Context) ([]string, error) {
+	const query = `
+		SELECT id
+		FROM attachments
+		WHERE status = 'pending'
+		ORDER BY created_at ASC
+	`
+
+	var ids []string
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &ids, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *RepoAttachment) UpdateStatus(ctx context.Context, id, status string) error {
+	query := `
+		UPDATE attachments
+		SET status = $2
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, status)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoAttachment) UpdateImageMetadata(ctx context.Context, attachment *model.AttachmentDB) error {
+	query := `
+		UPDATE attachments
+		SET size = $2, width = $3, height = $4, blurhash = $5, status = $6
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, attachment.ID, attachment.Size, attachment.Width,
+		attachment.Height, attachment.BlurHash, attachment.Status)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoAttachment) CreateThumbnail(ctx context.Context, thumbnail *model.AttachmentThumbnailDB) error {
+	thumbnail.ID = uuid.New()
+	now := time.Now()
+
+	query := `
+		INSERT INTO attachment_thumbnails (id, attachment_id, width, height, storage_key, content_type, size, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, thumbnail.ID, thumbnail.AttachmentID, thumbnail.Width,
+		thumbnail.Height, thumbnail.StorageKey, thumbnail.ContentType, thumbnail.Size, now)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoAttachment) GetThumbnails(ctx context.Context, attachmentID string) ([]*model.AttachmentThumbnailDB, error) {
+	const query = `
+		SELECT id, attachment_id, width, height, storage_key, content_type, size, created_at
+		FROM attachment_thumbnails
+		WHERE attachment_id = $1
+		ORDER BY width ASC
+	`
+
+	var thumbnails []*model.AttachmentThumbnailDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &thumbnails, query, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return thumbnails, nil
+}
+
+func (r *RepoAttachment) GetThumbnail(ctx context.Context, attachmentID string, width int) (*model.AttachmentThumbnailDB, error) {
+	const query = `
+		SELECT id, attachment_id, width, height, storage_key, content_type, size, created_at
+		FROM attachment_thumbnails
+		WHERE attachment_id = $1 AND width = $2
+	`
+
+	var thumbnail model.AttachmentThumbnailDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &thumbnail, query, attachmentID, width)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &thumbnail, nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const incomingWebhookColumns = `w.id, w.space_id, w.bot_user_id, w.token_hash, w.created_by, w.created_at, w.revoked_at,
+	u.name, u.avatar_url`
+
+type RepoIncomingWebhook struct {
+	db  *sqlx.DB
+	rdb *redis.Client
+
+	// hits counts requests per window in process when no Redis is
+	// configured.
+	mu   sync.Mutex
+	hits map[string]int64
+}
+
+// NewIncomingWebhookRepository creates the repository. rdb may be nil, in
+// which case rate limits are tracked per process.
+func NewIncomingWebhookRepository(db *sqlx.DB, rdb *redis.Client) *RepoIncomingWebhook {
+	return &RepoIncomingWebhook{
+		db:   db,
+		rdb:  rdb,
+		hits: map[string]int64{},
+	}
+}
+
+// Create stores the webhook together with its bot user and adds the bot to
+// the space, all in one transaction.
+func (r *RepoIncomingWebhook) Create(ctx context.Context, webhook *model.IncomingWebhookDB, bot *model.UserDB) error {
+	now := time.Now()
+	webhook.ID = uuid.New()
+	webhook.CreatedAt = now
+	bot.ID = uuid.New()
+	bot.Bot = true
+	webhook.BotUserID = bot.ID
+
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const userQuery = `
+		INSERT INTO users (id, email, name, password, bot, avatar_url, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, TRUE, $5, $6, $6)
+	`
+
+	_, err = tx.ExecContext(ctx, userQuery, bot.ID, bot.Email, bot.Name, bot.Password, bot.AvatarURL, now)
+	if err != nil {
+		return err
+	}
+
+	const memberQuery = `
+		INSERT INTO space_members (id, user_id, space_id, role, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err = tx.ExecContext(ctx, memberQuery, uuid.New(), bot.ID, webhook.SpaceID, constant.ROLE_MEMBER, now)
+	if err != nil {
+		return err
+	}
+
+	const webhookQuery = `
+		INSERT INTO incoming_webhooks (id, space_id, bot_user_id, token_hash, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = tx.ExecContext(ctx, webhookQuery, webhook.ID, webhook.SpaceID, webhook.BotUserID, webhook.TokenHash,
+		webhook.CreatedBy, webhook.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Revoke marks the webhook revoked and removes its bot from the space. The
+// bot user is kept so its past messages still have an author.
+func (r *RepoIncomingWebhook) Revoke(ctx context.Context, webhook *model.IncomingWebhookDB) error {
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const revokeQuery = `
+		UPDATE incoming_webhooks
+		SET revoked_at = $2
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	_, err = tx.ExecContext(ctx, revokeQuery, webhook.ID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	const memberQuery = `
+		DELETE FROM space_members
+		WHERE user_id = $1 AND space_id = $2
+	`
+
+	_, err = tx.ExecContext(ctx, memberQuery, webhook.BotUserID, webhook.SpaceID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *RepoIncomingWebhook) GetByID(ctx context.Context, id string) (*model.IncomingWebhookDB, error) {
+	const query = `
+		SELECT ` + incomingWebhookColumns + `
+		FROM incoming_webhooks w
+		JOIN users u ON u.id = w.bot_user_id
+		WHERE w.id = $1
+	`
+
+	var webhook model.IncomingWebhookDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &webhook, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (r *RepoIncomingWebhook) GetBySpaceID(ctx context.Context, spaceID string) ([]*model.IncomingWebhookDB, error) {
+	const query = `
+		SELECT ` + incomingWebhookColumns + `
+		FROM incoming_webhooks w
+		JOIN users u ON u.id = w.bot_user_id
+		WHERE w.space_id = $1
+		ORDER BY w.created_at ASC
+	`
+
+	var webhooks []*model.IncomingWebhookDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &webhooks, query, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// HitRateLimit counts a request against the webhook's fixed window and
+// returns the count so far in the current window. The counter lives in
+// Redis when available so the limit holds across replicas.
+func (r *RepoIncomingWebhook) HitRateLimit(ctx context.Context, id string, window time.Duration) (int64, error) {
+	bucket := time.Now().UnixNano() / int64(window)
+	key := "ratelimit:incoming_webhook:" + id + ":" + strconv.FormatInt(bucket, 10)
+
+	if r.rdb == nil {
+		return r.hitLocal(key, bucket), nil
+	}
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+func (r *RepoIncomingWebhook) hitLocal(key string, bucket int64) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Keys of earlier windows are dropped once the map grows, which keeps
+	// it bounded by the number of webhooks hit within one window.
+	if len(r.hits) > 1024 {
+		current := ":" + strconv.FormatInt(bucket, 10)
+		for k := range r.hits {
+			if !strings.HasSuffix(k, current) {
+				delete(r.hits, k)
+			}
+		}
+	}
+
+	r.hits[key]++
+
+	return r.hits[key]
+}
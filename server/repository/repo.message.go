@@ -2,54 +2,139 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"chatspace-server/constant"
 	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/broker"
 	"time"
 
-	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type RepoMessage struct {
-	db  *sqlx.DB
-	rdb *redis.Client
+	db     *sqlx.DB
+	broker broker.Broker
 }
 
-func NewMessageRepository(db *sqlx.DB, rdb *redis.Client) *RepoMessage {
+func NewMessageRepository(db *sqlx.DB, broker broker.Broker) *RepoMessage {
 	return &RepoMessage{
-		db:  db,
-		rdb: rdb,
+		db:     db,
+		broker: broker,
 	}
 }
 
-func (r *RepoMessage) Create(ctx context.Context, message *modelDB.MessageDB) (*string, error) {
-	message.ID = uuid.New()
+// Create stores a message together with the outbox entry announcing it,
+// so the event is published if and only if the message is committed. An
+// ID already set on message is kept. The message takes the next seq of
+// its space; the space row stays locked until commit, so seqs are gap-free
+// and commit in order. announce builds the outbox entry once the seq is
+// known. The sender's read marker moves past their own message. It
+// reports false, storing nothing, when the sender already sent a message
+// with the same client message ID to the space.
+func (r *RepoMessage) Create(ctx context.Context, message *modelDB.MessageDB, announce func(message *modelDB.MessageDB) (*modelDB.OutboxDB, error)) (bool, error) {
+	if message.ID == uuid.Nil {
+		message.ID = uuid.New()
+	}
 	now := time.Now()
 
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const seqQuery = `
+		UPDATE spaces
+		SET last_message_seq = last_message_seq + 1
+		WHERE id = $1
+		RETURNING last_message_seq
+	`
+
+	var seq int64
+	err = sqlx.GetContext(ctx, tx, &seq, seqQuery, message.SpaceID)
+	if err != nil {
+		return false, err
+	}
+
 	query := `
-		INSERT INTO messages (id, content, space_id, user_id, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO messages (id, content, format, blocks, html, space_id, user_id, created_at, expires_at, client_message_id, seq)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (user_id, space_id, client_message_id) WHERE client_message_id IS NOT NULL DO NOTHING
 	`
 
-	_, err := r.db.ExecContext(ctx, query, message.ID, message.Content, message.SpaceID, message.UserID, now)
+	res, err := tx.ExecContext(ctx, query, message.ID, message.Content, message.Format, message.Blocks, message.HTML,
+		message.SpaceID, message.UserID, now, message.ExpiresAt, message.ClientMessageID, seq)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	idStr := message.ID.String()
+	// Rolling back also returns the seq, keeping the sequence gap-free.
+	inserted, err := res.RowsAffected()
+	if err != nil || inserted == 0 {
+		return false, err
+	}
 
-	return &idStr, nil
+	const readQuery = `
+		UPDATE space_members
+		SET last_read_seq = $3
+		WHERE space_id = $1 AND user_id = $2 AND last_read_seq < $3
+	`
+
+	_, err = tx.ExecContext(ctx, readQuery, message.SpaceID, message.UserID, seq)
+	if err != nil {
+		return false, err
+	}
+
+	message.Seq = seq
+	message.CreatedAt = now
+
+	event, err := announce(message)
+	if err != nil {
+		return false, err
+	}
+
+	err = insertOutbox(ctx, tx, event)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
-func (r *RepoMessage) GetMessages(ctx context.Context) ([]*modelDB.MessageDB, error) {
-	const query = `
-		SELECT id, content, space_id, user_id, created_at
-		FROM messages
-		ORDER BY created_at DESC
+// GetMessages returns a page of a space's live messages, newest first.
+func (r *RepoMessage) GetMessages(ctx context.Context, params *modelDB.MessageListParams) ([]*modelDB.MessageDB, error) {
+	order := "DESC"
+	if params.After != nil {
+		order = "ASC"
+	}
+
+	query := `
+		SELECT id, content, format, blocks, html, space_id, user_id, created_at, expires_at, client_message_id, seq
+		FROM (
+			SELECT id, content, format, blocks, html, space_id, user_id, created_at, expires_at, client_message_id, seq
+			FROM messages
+			WHERE space_id = $1
+				AND (expires_at IS NULL OR expires_at > NOW())
+				AND ($2::bigint IS NULL OR seq < $2)
+				AND ($3::bigint IS NULL OR seq > $3)
+			ORDER BY seq ` + order + `
+			LIMIT $4
+		) page
+		ORDER BY seq DESC
 	`
 
 	var messages []*modelDB.MessageDB
-	err := r.db.SelectContext(ctx, &messages, query)
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &messages, query, params.SpaceID, params.Before, params.After, params.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +143,7 @@ func (r *RepoMessage) GetMessages(ctx context.Context) ([]*modelDB.MessageDB, er
 }
 
 func (r *RepoMessage) PublishMessage(ctx context.Context, spaceID string, data []byte) error {
-	err := r.rdb.Publish(ctx, spaceID, data).Err()
+	err := r.broker.Publish(ctx, spaceID, data)
 	if err != nil {
 		return err
 	}
@@ -66,6 +151,431 @@ func (r *RepoMessage) PublishMessage(ctx context.Context, spaceID string, data [
 	return nil
 }
 
-func (r *RepoMessage) SubscribeMessage(ctx context.Context, spaceID string) *redis.PubSub {
-	return r.rdb.Subscribe(ctx, spaceID)
+// PublishMessageOnce publishes data unless dedupID was already published to
+// the channel.
+func (r *RepoMessage) PublishMessageOnce(ctx context.Context, spaceID, dedupID string, data []byte) error {
+	return r.broker.PublishOnce(ctx, spaceID, dedupID, data)
+}
+
+// SubscribeMessage follows the channel's stream. A non-empty since replays
+// the entries after it first.
+func (r *RepoMessage) SubscribeMessage(ctx context.Context, spaceID, since string) (<-chan broker.Message, func() error, error) {
+	return r.broker.Subscribe(ctx, spaceID, since)
+}
+
+func (r *RepoMessage) SearchMessages(ctx context.Context, params *modelDB.MessageSearchParams) ([]*modelDB.MessageSearchDB, error) {
+	const query = `
+		SELECT m.id, m.content, m.format, m.blocks, m.html, m.space_id, m.user_id, m.created_at, m.expires_at, m.client_message_id, m.seq,
+			ts_headline('simple', translate(m.content, $10 || $11, ''), q,
+				'StartSel="' || $10 || '", StopSel="' || $11 || '", MaxFragments=2') AS snippet,
+			ts_rank(m.content_tsv, q) AS rank
+		FROM messages m
+		JOIN space_members sm ON sm.space_id = m.space_id AND sm.user_id = $1
+		CROSS JOIN websearch_to_tsquery('simple', $2) q
+		WHERE m.content_tsv @@ q
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+			AND ($3::uuid IS NULL OR m.space_id = $3)
+			AND ($4::uuid IS NULL OR m.user_id = $4)
+			AND ($5::timestamptz IS NULL OR m.created_at < $5)
+			AND ($6::timestamptz IS NULL OR m.created_at > $6)
+			AND ($7::boolean IS NULL OR EXISTS (SELECT 1 FROM attachments a WHERE a.message_id = m.id) = $7)
+		ORDER BY rank DESC, m.created_at DESC
+		LIMIT $8 OFFSET $9
+	`
+
+	var messages []*modelDB.MessageSearchDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &messages, query,
+		params.UserID, params.Query, params.SpaceID, params.FromUserID,
+		params.Before, params.After, params.HasAttachment, params.Limit, params.Offset,
+		constant.SEARCH_SNIPPET_START, constant.SEARCH_SNIPPET_STOP)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *RepoMessage) GetMessageByID(ctx context.Context, id string) (*modelDB.MessageDB, error) {
+	const query = `
+		SELECT id, content, format, blocks, html, space_id, user_id, created_at, expires_at, client_message_id, seq
+		FROM messages
+		WHERE id = $1
+	`
+
+	var message modelDB.MessageDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &message, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+// GetByClientMessageID returns the message a user sent to a space with the
+// given client message ID.
+func (r *RepoMessage) GetByClientMessageID(ctx context.Context, userID, spaceID, clientMessageID string) (*modelDB.MessageDB, error) {
+	const query = `
+		SELECT id, content, format, blocks, html, space_id, user_id, created_at, expires_at, client_message_id, seq
+		FROM messages
+		WHERE user_id = $1 AND space_id = $2 AND client_message_id = $3
+	`
+
+	var message modelDB.MessageDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &message, query, userID, spaceID, clientMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (r *RepoMessage) GetLinkPreview(ctx context.Context, url string) (*modelDB.LinkPreviewDB, error) {
+	const query = `
+		SELECT url, status, COALESCE(title, '') AS title, COALESCE(description, '') AS description,
+			COALESCE(image_url, '') AS image_url, COALESCE(site_name, '') AS site_name, fetched_at
+		FROM link_previews
+		WHERE url = $1
+	`
+
+	var preview modelDB.LinkPreviewDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &preview, query, url)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &preview, nil
+}
+
+func (r *RepoMessage) UpsertLinkPreview(ctx context.Context, preview *modelDB.LinkPreviewDB) error {
+	preview.FetchedAt = time.Now()
+
+	query := `
+		INSERT INTO link_previews (url, status, title, description, image_url, site_name, fetched_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (url) DO UPDATE
+		SET status = EXCLUDED.status, title = EXCLUDED.title, description = EXCLUDED.description,
+			image_url = EXCLUDED.image_url, site_name = EXCLUDED.site_name, fetched_at = EXCLUDED.fetched_at
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, preview.URL, preview.Status, preview.Title,
+		preview.Description, preview.ImageURL, preview.SiteName, preview.FetchedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoMessage) LinkMessagePreviews(ctx context.Context, messageID string, urls []string) error {
+	query := `
+		INSERT INTO message_link_previews (message_id, url, position)
+		SELECT $1, u.url, u.position - 1
+		FROM unnest($2::text[]) WITH ORDINALITY AS u(url, position)
+		ON CONFLICT (message_id, url) DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, messageID, pq.Array(urls))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoMessage) GetMessageLinkPreviews(ctx context.Context, messageID string) ([]*modelDB.LinkPreviewDB, error) {
+	const query = `
+		SELECT lp.url, lp.status, COALESCE(lp.title, '') AS title, COALESCE(lp.description, '') AS description,
+			COALESCE(lp.image_url, '') AS image_url, COALESCE(lp.site_name, '') AS site_name, lp.fetched_at
+		FROM message_link_previews mlp
+		JOIN link_previews lp ON lp.url = mlp.url
+		WHERE mlp.message_id = $1 AND lp.status = 'ok'
+		ORDER BY mlp.position ASC
+	`
+
+	var previews []*modelDB.LinkPreviewDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &previews, query, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return previews, nil
+}
+
+// PinMessage pins a message unless the space already has limit pins. It
+// reports whether a new pin was stored. The space row is locked while the
+// pins are counted, so concurrent pins cannot both take the last slot.
+func (r *RepoMessage) PinMessage(ctx context.Context, pin *modelDB.PinnedMessageDB, limit int) (bool, error) {
+	pin.PinnedAt = time.Now()
+
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const lockQuery = `
+		SELECT 1 FROM spaces WHERE id = $1 FOR UPDATE
+	`
+
+	_, err = tx.ExecContext(ctx, lockQuery, pin.SpaceID)
+	if err != nil {
+		return false, err
+	}
+
+	query := `
+		INSERT INTO pinned_messages (message_id, space_id, pinned_by, pinned_at)
+		SELECT $1, $2, $3, $4
+		WHERE (SELECT COUNT(*) FROM pinned_messages WHERE space_id = $2) < $5
+		ON CONFLICT (message_id) DO NOTHING
+	`
+
+	res, err := tx.ExecContext(ctx, query, pin.MessageID, pin.SpaceID, pin.PinnedBy, pin.PinnedAt, limit)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RepoMessage) UnpinMessage(ctx context.Context, messageID string) (bool, error) {
+	query := `
+		DELETE FROM pinned_messages
+		WHERE message_id = $1
+	`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, messageID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RepoMessage) IsPinned(ctx context.Context, messageID string) (bool, error) {
+	const query = `
+		SELECT EXISTS (SELECT 1 FROM pinned_messages WHERE message_id = $1)
+	`
+
+	var pinned bool
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &pinned, query, messageID)
+	if err != nil {
+		return false, err
+	}
+
+	return pinned, nil
+}
+
+// AddReaction stores a reaction and reports whether it is new.
+func (r *RepoMessage) AddReaction(ctx context.Context, reaction *modelDB.ReactionDB) (bool, error) {
+	reaction.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO message_reactions (message_id, user_id, emoji, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (message_id, user_id, emoji) DO NOTHING
+	`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, reaction.MessageID, reaction.UserID, reaction.Emoji, reaction.CreatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RepoMessage) RemoveReaction(ctx context.Context, messageID, userID, emoji string) (bool, error) {
+	query := `
+		DELETE FROM message_reactions
+		WHERE message_id = $1 AND user_id = $2 AND emoji = $3
+	`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, messageID, userID, emoji)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// GetReactions counts a message's reactions per emoji, in the order each
+// emoji was first used. viewerID may be empty for anonymous viewers.
+func (r *RepoMessage) GetReactions(ctx context.Context, messageID, viewerID string) ([]*modelDB.ReactionCountDB, error) {
+	const query = `
+		SELECT emoji, COUNT(*) AS count, COALESCE(BOOL_OR(user_id::text = $2), FALSE) AS reacted
+		FROM message_reactions
+		WHERE message_id = $1
+		GROUP BY emoji
+		ORDER BY MIN(created_at), emoji
+	`
+
+	var reactions []*modelDB.ReactionCountDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &reactions, query, messageID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reactions, nil
+}
+
+func (r *RepoMessage) GetPinnedMessages(ctx context.Context, spaceID string) ([]*modelDB.MessageDB, error) {
+	const query = `
+		SELECT m.id, m.content, m.format, m.blocks, m.html, m.space_id, m.user_id, m.created_at, m.expires_at, m.client_message_id, m.seq
+		FROM pinned_messages pm
+		JOIN messages m ON m.id = pm.message_id
+		WHERE pm.space_id = $1 AND (m.expires_at IS NULL OR m.expires_at > NOW())
+		ORDER BY pm.pinned_at DESC
+	`
+
+	var messages []*modelDB.MessageDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &messages, query, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// Announce records a publish in the outbox, in the caller's unit of work
+// when ctx carries one, so it is relayed only if that commits.
+func (r *RepoMessage) Announce(ctx context.Context, entry *modelDB.OutboxDB) error {
+	return insertOutbox(ctx, conn(ctx, r.db), entry)
+}
+
+// deleteMessagesQuery deletes the messages whose id is returned by pick
+// and returns them with the storage keys of their attachments and
+// thumbnails. The outer SELECT reads the snapshot taken before the delete,
+// so it still sees the attachment rows the cascade removes.
+func deleteMessagesQuery(pick string) string {
+	return `
+		WITH deleted AS (
+			DELETE FROM messages
+			WHERE id IN (` + pick + `)
+			RETURNING id, space_id, user_id
+		)
+		SELECT d.id, d.space_id, d.user_id, ARRAY(
+			SELECT a.storage_key FROM attachments a WHERE a.message_id = d.id
+			UNION ALL
+			SELECT t.storage_key
+			FROM attachment_thumbnails t
+			JOIN attachments a ON a.id = t.attachment_id
+			WHERE a.message_id = d.id
+		) AS storage_keys
+		FROM deleted d
+	`
+}
+
+// DeleteExpired hard-deletes up to limit messages whose lifetime ended
+// before now and returns what was removed. Rows locked by another reaper
+// are skipped.
+func (r *RepoMessage) DeleteExpired(ctx context.Context, now time.Time, limit int) ([]*modelDB.DeletedMessageDB, error) {
+	query := deleteMessagesQuery(`
+		SELECT id
+		FROM messages
+		WHERE expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`)
+
+	var messages []*modelDB.DeletedMessageDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &messages, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// PurgeOlderThan deletes up to limit messages of a space created before
+// the given time and returns what was removed.
+func (r *RepoMessage) PurgeOlderThan(ctx context.Context, spaceID string, before time.Time, limit int) ([]*modelDB.DeletedMessageDB, error) {
+	query := deleteMessagesQuery(`
+		SELECT id
+		FROM messages
+		WHERE space_id = $1 AND created_at < $2
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`)
+
+	var messages []*modelDB.DeletedMessageDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &messages, query, spaceID, before, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// PurgeBeyondCount deletes up to limit messages of a space that are older
+// than its newest keep messages and returns what was removed. The cutoff is
+// read without skipping locked rows, so a message another transaction holds
+// cannot shift the window and get a newer one deleted in its place.
+func (r *RepoMessage) PurgeBeyondCount(ctx context.Context, spaceID string, keep, limit int) ([]*modelDB.DeletedMessageDB, error) {
+	const cutoffQuery = `
+		SELECT seq
+		FROM messages
+		WHERE space_id = $1
+		ORDER BY seq DESC
+		OFFSET $2
+		LIMIT 1
+	`
+
+	var cutoff int64
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &cutoff, cutoffQuery, spaceID, keep)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	query := deleteMessagesQuery(`
+		SELECT id
+		FROM messages
+		WHERE space_id = $1 AND seq <= $2
+		ORDER BY seq
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`)
+
+	var messages []*modelDB.DeletedMessageDB
+	err = sqlx.SelectContext(ctx, conn(ctx, r.db), &messages, query, spaceID, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
 }
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"chatspace-server/constant"
+	"chatspace-server/model"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// testSpace creates a space with the given number of members and removes
+// it, its members and the outbox entries of its messages after the test.
+func testSpace(t *testing.T, db *sqlx.DB, members int) (uuid.UUID, []uuid.UUID) {
+	t.Helper()
+
+	spaceID := uuid.New()
+	_, err := db.Exec(`INSERT INTO spaces (id, name) VALUES ($1, $2)`, spaceID, "test-"+spaceID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userIDs := make([]uuid.UUID, members)
+	for i := range userIDs {
+		userIDs[i] = uuid.New()
+
+		_, err = db.Exec(`INSERT INTO users (id, email, name, password) VALUES ($1, $2, $3, '')`,
+			userIDs[i], userIDs[i].String()+"@example.com", fmt.Sprintf("user %d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = db.Exec(`INSERT INTO space_members (id, user_id, space_id) VALUES ($1, $2, $3)`,
+			uuid.New(), userIDs[i], spaceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Cleanup(func() {
+		_, _ = db.Exec(`DELETE FROM outbox WHERE channel = $1`, testChannel(spaceID))
+		_, _ = db.Exec(`DELETE FROM spaces WHERE id = $1`, spaceID)
+		for _, id := range userIDs {
+			_, _ = db.Exec(`DELETE FROM users WHERE id = $1`, id)
+		}
+	})
+
+	return spaceID, userIDs
+}
+
+func testChannel(spaceID uuid.UUID) string {
+	return "test:" + spaceID.String()
+}
+
+// testMessage stores a message from userID, expiring at expiresAt unless
+// it is nil.
+func testMessage(ctx context.Context, r *RepoMessage, spaceID, userID uuid.UUID, expiresAt *time.Time) (*model.MessageDB, error) {
+	message := &model.MessageDB{
+		Content:   "hello",
+		Format:    "plain",
+		Blocks:    []byte("[]"),
+		SpaceID:   spaceID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}
+
+	_, err := r.Create(ctx, message, func(message *model.MessageDB) (*model.OutboxDB, error) {
+		return &model.OutboxDB{DedupID: uuid.New(), Channel: testChannel(spaceID), Payload: []byte("{}")}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func TestCreateConcurrentSeq(t *testing.T) {
+	db := testDB(t)
+	r := NewMessageRepository(db, nil)
+	ctx := context.Background()
+
+	const senders, perSender = 8, 25
+	spaceID, userIDs := testSpace(t, db, senders)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, senders)
+	for _, userID := range userIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for range perSender {
+				_, err := testMessage(ctx, r, spaceID, userID, nil)
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	var seqs []int64
+	err := db.Select(&seqs, `SELECT seq FROM messages WHERE space_id = $1 ORDER BY seq`, spaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seqs) != senders*perSender {
+		t.Fatalf("stored %d messages, want %d", len(seqs), senders*perSender)
+	}
+
+	for i, seq := range seqs {
+		if seq != int64(i+1) {
+			t.Fatalf("seq at position %d = %d, want %d", i, seq, i+1)
+		}
+	}
+
+	var last int64
+	err = db.Get(&last, `SELECT last_message_seq FROM spaces WHERE id = $1`, spaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last != int64(len(seqs)) {
+		t.Fatalf("last_message_seq = %d, want %d", last, len(seqs))
+	}
+}
+
+func TestSearchMessagesSnippet(t *testing.T) {
+	db := testDB(t)
+	r := NewMessageRepository(db, nil)
+	ctx := context.Background()
+
+	spaceID, userIDs := testSpace(t, db, 1)
+
+	message := &model.MessageDB{
+		Content: "<script>alert(1)</script> needle \x02stray\x03",
+		Format:  "plain",
+		Blocks:  []byte("[]"),
+		SpaceID: spaceID,
+		UserID:  userIDs[0],
+	}
+
+	_, err := r.Create(ctx, message, func(message *model.MessageDB) (*model.OutboxDB, error) {
+		return &model.OutboxDB{DedupID: uuid.New(), Channel: testChannel(spaceID), Payload: []byte("{}")}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := r.SearchMessages(ctx, &model.MessageSearchParams{UserID: userIDs[0], Query: "needle", SpaceID: &spaceID, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("SearchMessages() returned %d results, want 1", len(results))
+	}
+
+	snippet := results[0].Snippet
+	if !strings.Contains(snippet, constant.SEARCH_SNIPPET_START+"needle"+constant.SEARCH_SNIPPET_STOP) {
+		t.Fatalf("SearchMessages() snippet = %q, want the match between the sentinels", snippet)
+	}
+
+	// Sentinels typed by the sender are dropped, so only real matches
+	// end up marked.
+	if strings.Count(snippet, constant.SEARCH_SNIPPET_START) != 1 || strings.Count(snippet, constant.SEARCH_SNIPPET_STOP) != 1 {
+		t.Fatalf("SearchMessages() snippet = %q, want sentinels around the match only", snippet)
+	}
+
+	if strings.Contains(snippet, "<mark>") {
+		t.Fatalf("SearchMessages() snippet = %q, want no HTML added", snippet)
+	}
+}
+
+func TestPinMessageConcurrentLimit(t *testing.T) {
+	db := testDB(t)
+	r := NewMessageRepository(db, nil)
+	ctx := context.Background()
+
+	const limit, attempts = 3, 12
+	spaceID, userIDs := testSpace(t, db, 1)
+
+	messageIDs := make([]uuid.UUID, attempts)
+	for i := range messageIDs {
+		message, err := testMessage(ctx, r, spaceID, userIDs[0], nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		messageIDs[i] = message.ID
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, attempts)
+	for _, id := range messageIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := r.PinMessage(ctx, &model.PinnedMessageDB{SpaceID: spaceID, MessageID: id, PinnedBy: userIDs[0]}, limit)
+			if err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	var pinned int
+	err := db.Get(&pinned, `SELECT COUNT(*) FROM pinned_messages WHERE space_id = $1`, spaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pinned != limit {
+		t.Fatalf("%d messages pinned concurrently, want the limit of %d", pinned, limit)
+	}
+}
+
+func TestPurgeBeyondCount(t *testing.T) {
+	db := testDB(t)
+	r := NewMessageRepository(db, nil)
+	ctx := context.Background()
+
+	const keep = 3
+	spaceID, userIDs := testSpace(t, db, 1)
+
+	messages := make([]*model.MessageDB, 6)
+	for i := range messages {
+		message, err := testMessage(ctx, r, spaceID, userIDs[0], nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		messages[i] = message
+	}
+
+	attachmentID := uuid.New()
+	_, err := db.Exec(`INSERT INTO attachments (id, space_id, user_id, message_id, storage_key, filename, content_type, size)
+		VALUES ($1, $2, $3, $4, 'attachments/a/original', 'a.png', 'image/png', 1)`,
+		attachmentID, spaceID, userIDs[0], messages[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec(`INSERT INTO attachment_thumbnails (id, attachment_id, width, height, storage_key, content_type, size)
+		VALUES ($1, $2, 1, 1, 'attachments/a/thumb', 'image/png', 1)`, uuid.New(), attachmentID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another transaction holds the oldest message. Skipping it must not
+	// pull the newest of the purgeable messages into the batch.
+	tx, err := db.Beginx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`SELECT 1 FROM messages WHERE id = $1 FOR UPDATE`, messages[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := r.PurgeBeyondCount(ctx, spaceID.String(), keep, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deleted) != 2 {
+		t.Fatalf("PurgeBeyondCount() with the oldest message locked removed %d messages, want 2", len(deleted))
+	}
+
+	err = tx.Rollback()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err = r.PurgeBeyondCount(ctx, spaceID.String(), keep, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deleted) != 1 || deleted[0].ID != messages[0].ID {
+		t.Fatalf("PurgeBeyondCount() after the lock was released = %v, want only %s", deleted, messages[0].ID)
+	}
+
+	keys := strings.Join(deleted[0].StorageKeys, ",")
+	if keys != "attachments/a/original,attachments/a/thumb" {
+		t.Fatalf("PurgeBeyondCount() storage keys = %q, want the attachment and its thumbnail", keys)
+	}
+
+	var seqs []int64
+	err = db.Select(&seqs, `SELECT seq FROM messages WHERE space_id = $1 ORDER BY seq`, spaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seqs) != keep || seqs[0] != messages[len(messages)-keep].Seq {
+		t.Fatalf("kept seqs %v, want the newest %d", seqs, keep)
+	}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/model"
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// outboxLockKey is the advisory lock held by the relay that is currently
+// publishing. One relay at a time keeps the rows of a channel in order
+// across replicas.
+const outboxLockKey = 0x6f7574626f78
+
+type RepoOutbox struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) *RepoOutbox {
+	return &RepoOutbox{
+		db: db,
+	}
+}
+
+// insertOutbox records a publish in the caller's transaction.
+func insertOutbox(ctx context.Context, tx sqlx.ExtContext, entry *model.OutboxDB) error {
+	query := `
+		INSERT INTO outbox (dedup_id, channel, payload)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	return tx.QueryRowxContext(ctx, query, entry.DedupID, entry.Channel, entry.Payload).Scan(&entry.ID, &entry.CreatedAt)
+}
+
+// Relay hands up to limit rows to publish, oldest first, and deletes the
+// ones it accepted. Rows of a channel go out in order: after a failure
+// the rest of that channel waits for a later run while other channels
+// carry on. A failed row records the attempt and holds its channel back
+// for OUTBOX_RETRY_WAIT; after OUTBOX_MAX_ATTEMPTS it is parked so the
+// channel can move on. Rows stay locked until publishing is done, so a
+// relay that dies only causes them to be published again. locked is false
+// when another relay is busy. The error is the first publish failure.
+func (r *RepoOutbox) Relay(ctx context.Context, limit int, publish func(ctx context.Context, entry *model.OutboxDB) error) (int, bool, error) {
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var locked bool
+	err = tx.QueryRowxContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, outboxLockKey).Scan(&locked)
+	if err != nil || !locked {
+		return 0, false, err
+	}
+
+	// Only the oldest unparked row of a channel is ever attempted, so a
+	// recent attempt in a channel means its head is waiting to retry.
+	query := `
+		SELECT id, dedup_id, channel, payload, attempts, last_error, attempted_at, parked_at, created_at
+		FROM outbox o
+		WHERE parked_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1
+			FROM outbox f
+			WHERE f.channel = o.channel
+			AND f.parked_at IS NULL
+			AND f.attempted_at > NOW() - make_interval(secs => $2)
+		)
+		ORDER BY id
+		LIMIT $1
+	`
+
+	var entries []*model.OutboxDB
+	err = sqlx.SelectContext(ctx, tx, &entries, query, limit, constant.OUTBOX_RETRY_WAIT.Seconds())
+	if err != nil {
+		return 0, true, err
+	}
+
+	failed := map[string]bool{}
+	var published []int64
+	var publishErr error
+	for _, entry := range entries {
+		if failed[entry.Channel] {
+			continue
+		}
+
+		failure := publish(ctx, entry)
+		if failure != nil {
+			failed[entry.Channel] = true
+			if publishErr == nil {
+				publishErr = failure
+			}
+
+			_, err = tx.ExecContext(ctx, `
+				UPDATE outbox
+				SET attempts = attempts + 1,
+					last_error = $2,
+					attempted_at = NOW(),
+					parked_at = CASE WHEN attempts + 1 >= $3 THEN NOW() END
+				WHERE id = $1
+			`, entry.ID, failure.Error(), constant.OUTBOX_MAX_ATTEMPTS)
+			if err != nil {
+				return 0, true, err
+			}
+			continue
+		}
+
+		published = append(published, entry.ID)
+	}
+
+	if len(published) > 0 {
+		_, err = tx.ExecContext(ctx, `DELETE FROM outbox WHERE id = ANY($1)`, pq.Array(published))
+		if err != nil {
+			return 0, true, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, true, err
+	}
+
+	return len(published), true, publishErr
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"chatspace-server/constant"
+	"chatspace-server/model"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestRelayOrdersPerChannel(t *testing.T) {
+	db := testDB(t)
+	repo := NewOutboxRepository(db)
+	ctx := context.Background()
+
+	failing := "test:outbox-failing:" + uuid.NewString()
+	healthy := "test:outbox-healthy:" + uuid.NewString()
+	t.Cleanup(func() {
+		_, _ = db.Exec(`DELETE FROM outbox WHERE channel = ANY($1)`, pq.Array([]string{failing, healthy}))
+	})
+
+	add := func(t *testing.T, channel string) int64 {
+		t.Helper()
+
+		entry := &model.OutboxDB{DedupID: uuid.New(), Channel: channel, Payload: []byte(`{}`)}
+		err := insertOutbox(ctx, db, entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return entry.ID
+	}
+
+	get := func(t *testing.T, id int64) *model.OutboxDB {
+		t.Helper()
+
+		var entry model.OutboxDB
+		err := db.Get(&entry, `SELECT id, dedup_id, channel, payload, attempts, last_error, attempted_at, parked_at, created_at FROM outbox WHERE id = $1`, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &entry
+	}
+
+	// relay publishes every channel except failing and returns the rows
+	// of the test channels it was handed. Rows left by other tests are
+	// published too, so they cannot hold the relay up.
+	relay := func(t *testing.T, want ...int64) {
+		t.Helper()
+
+		var got []int64
+		_, locked, err := repo.Relay(ctx, 1000, func(ctx context.Context, entry *model.OutboxDB) error {
+			switch entry.Channel {
+			case failing:
+				got = append(got, entry.ID)
+				return errors.New("broker down")
+			case healthy:
+				got = append(got, entry.ID)
+			}
+			return nil
+		})
+		if !locked {
+			t.Fatal("Relay() did not get the relay lock")
+		}
+		if err != nil && err.Error() != "broker down" {
+			t.Fatal(err)
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("Relay() handed rows %v, want %v", got, want)
+		}
+	}
+
+	failed1 := add(t, failing)
+	failed2 := add(t, failing)
+	healthy1 := add(t, healthy)
+
+	// The failing channel stops at its first row; the healthy one is not
+	// held up by it.
+	relay(t, failed1, healthy1)
+
+	if get(t, healthy1) != nil {
+		t.Fatal("published row was not deleted")
+	}
+
+	entry := get(t, failed1)
+	if entry.Attempts != 1 || entry.LastError == nil || *entry.LastError != "broker down" || entry.ParkedAt != nil {
+		t.Fatalf("failed row = %d attempts, error %v, parked %v, want 1 attempt of broker down, not parked", entry.Attempts, entry.LastError, entry.ParkedAt)
+	}
+
+	if entry := get(t, failed2); entry.Attempts != 0 {
+		t.Fatalf("row behind the failure was attempted %d times, want 0", entry.Attempts)
+	}
+
+	// The failing channel waits OUTBOX_RETRY_WAIT before its next attempt.
+	healthy2 := add(t, healthy)
+	relay(t, healthy2)
+
+	// Its last attempt parks the row, and the channel moves on.
+	_, err := db.Exec(`UPDATE outbox SET attempts = $2, attempted_at = NOW() - INTERVAL '1 hour' WHERE id = $1`,
+		failed1, constant.OUTBOX_MAX_ATTEMPTS-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relay(t, failed1)
+
+	entry = get(t, failed1)
+	if entry.Attempts != constant.OUTBOX_MAX_ATTEMPTS || entry.ParkedAt == nil {
+		t.Fatalf("row = %d attempts, parked %v, want %d attempts and parked", entry.Attempts, entry.ParkedAt, constant.OUTBOX_MAX_ATTEMPTS)
+	}
+
+	relay(t, failed2)
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type RepoPoll struct {
+	db *sqlx.DB
+}
+
+func NewPollRepository(db *sqlx.DB) *RepoPoll {
+	return &RepoPoll{
+		db: db,
+	}
+}
+
+// Create stores a poll together with its options in one transaction.
+func (r *RepoPoll) Create(ctx context.Context, poll *model.PollDB, options []string) error {
+	poll.ID = uuid.New()
+	poll.CreatedAt = time.Now()
+
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		INSERT INTO polls (id, message_id, space_id, question, multi_choice, anonymous, closes_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err = tx.ExecContext(ctx, query, poll.ID, poll.MessageID, poll.SpaceID, poll.Question,
+		poll.MultiChoice, poll.Anonymous, poll.ClosesAt, poll.CreatedBy, poll.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	optionQuery := `
+		INSERT INTO poll_options (id, poll_id, position, text)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for i, text := range options {
+		_, err = tx.ExecContext(ctx, optionQuery, uuid.New(), poll.ID, i, text)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *RepoPoll) GetByID(ctx context.Context, id string) (*model.PollDB, error) {
+	const query = `
+		SELECT id, message_id, space_id, question, multi_choice, anonymous, closes_at, closed_at, created_by, created_at
+		FROM polls
+		WHERE id = $1
+	`
+
+	var poll model.PollDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &poll, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &poll, nil
+}
+
+func (r *RepoPoll) GetByMessageID(ctx context.Context, messageID string) (*model.PollDB, error) {
+	const query = `
+		SELECT id, message_id, space_id, question, multi_choice, anonymous, closes_at, closed_at, created_by, created_at
+		FROM polls
+		WHERE message_id = $1
+	`
+
+	var poll model.PollDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &poll, query, messageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &poll, nil
+}
+
+// GetOptions returns the options of a poll in order, with vote counts.
+func (r *RepoPoll) GetOptions(ctx context.Context, pollID string) ([]*model.PollOptionDB, error) {
+	const query = `
+		SELECT o.id, o.poll_id, o.position, o.text, COUNT(v.user_id) AS vote_count
+		FROM poll_options o
+		LEFT JOIN poll_votes v ON v.option_id = o.id
+		WHERE o.poll_id = $1
+		GROUP BY o.id
+		ORDER BY o.position ASC
+	`
+
+	var options []*model.PollOptionDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &options, query, pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func (r *RepoPoll) CountVoters(ctx context.Context, pollID string) (int, error) {
+	const query = `
+		SELECT COUNT(DISTINCT user_id)
+		FROM poll_votes
+		WHERE poll_id = $1
+	`
+
+	var count int
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &count, query, pollID)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *RepoPoll) GetOptionVoters(ctx context.Context, optionID string) ([]*model.UserDB, error) {
+	const query = `
+		SELECT u.id, u.email, u.name, u.created_at, u.updated_at
+		FROM poll_votes v
+		JOIN users u ON u.id = v.user_id
+		WHERE v.option_id = $1
+		ORDER BY v.created_at ASC
+	`
+
+	var users []*model.UserDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &users, query, optionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *RepoPoll) GetUserVotes(ctx context.Context, pollID, userID string) ([]string, error) {
+	const query = `
+		SELECT option_id
+		FROM poll_votes
+		WHERE poll_id = $1 AND user_id = $2
+	`
+
+	var optionIDs []string
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &optionIDs, query, pollID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return optionIDs, nil
+}
+
+// ReplaceVotes swaps a user's votes on a poll for the given options. The
+// poll row is locked so a concurrent close cannot interleave, and nothing
+// is written once the poll is closed; that case reports false.
+func (r *RepoPoll) ReplaceVotes(ctx context.Context, pollID, userID string, optionIDs []uuid.UUID) (bool, error) {
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const lockQuery = `
+		SELECT closed_at IS NULL AND (closes_at IS NULL OR closes_at > NOW())
+		FROM polls
+		WHERE id = $1
+		FOR SHARE
+	`
+
+	var open bool
+	err = sqlx.GetContext(ctx, tx, &open, lockQuery, pollID)
+	if err != nil {
+		return false, err
+	}
+
+	if !open {
+		return false, nil
+	}
+
+	deleteQuery := `
+		DELETE FROM poll_votes
+		WHERE poll_id = $1 AND user_id = $2
+	`
+
+	_, err = tx.ExecContext(ctx, deleteQuery, pollID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	insertQuery := `
+		INSERT INTO poll_votes (poll_id, option_id, user_id, created_at)
+		SELECT $1, o.id, $2, $3
+		FROM poll_options o
+		WHERE o.poll_id = $1 AND o.id = ANY($4::uuid[])
+	`
+
+	_, err = tx.ExecContext(ctx, insertQuery, pollID, userID, time.Now(), pq.Array(optionIDs))
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *RepoPoll) Close(ctx context.Context, pollID string) error {
+	query := `
+		UPDATE polls
+		SET closed_at = $2
+		WHERE id = $1 AND closed_at IS NULL
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, pollID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// savedMessageColumns selects a saved message joined with its message as
+// the nested model.SavedMessageDB.Message struct.
+const savedMessageColumns = `
+	s.id, s.user_id, s.message_id, s.note, s.remind_at, s.reminded_at, s.created_at,
+	m.id AS "message.id", m.content AS "message.content", m.format AS "message.format",
+	m.blocks AS "message.blocks", m.html AS "message.html", m.space_id AS "message.space_id",
+	m.user_id AS "message.user_id", m.created_at AS "message.created_at", m.expires_at AS "message.expires_at",
+	m.client_message_id AS "message.client_message_id", m.seq AS "message.seq"
+`
+
+type RepoSavedMessage struct {
+	db *sqlx.DB
+}
+
+func NewSavedMessageRepository(db *sqlx.DB) *RepoSavedMessage {
+	return &RepoSavedMessage{
+		db: db,
+	}
+}
+
+// Save bookmarks a message, or updates the note and reminder of an
+// existing bookmark. Changing the reminder re-arms it.
+func (r *RepoSavedMessage) Save(ctx context.Context, saved *model.SavedMessageDB) error {
+	query := `
+		INSERT INTO saved_messages (id, user_id, message_id, note, remind_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, message_id) DO UPDATE
+		SET note = EXCLUDED.note, remind_at = EXCLUDED.remind_at, reminded_at = NULL
+		RETURNING id, created_at
+	`
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, uuid.New(), saved.UserID, saved.MessageID,
+		saved.Note, saved.RemindAt, time.Now()).Scan(&saved.ID, &saved.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoSavedMessage) Delete(ctx context.Context, userID, messageID string) error {
+	query := `
+		DELETE FROM saved_messages
+		WHERE user_id = $1 AND message_id = $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, userID, messageID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoSavedMessage) GetByID(ctx context.Context, id string) (*model.SavedMessageDB, error) {
+	query := `
+		SELECT ` + savedMessageColumns + `
+		FROM saved_messages s
+		JOIN messages m ON m.id = s.message_id
+		WHERE s.id = $1
+	`
+
+	var saved model.SavedMessageDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &saved, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+// GetByUserID lists a user's bookmarks, newest first, leaving out messages
+// from spaces the user is no longer a member of.
+func (r *RepoSavedMessage) GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.SavedMessageDB, error) {
+	query := `
+		SELECT ` + savedMessageColumns + `
+		FROM saved_messages s
+		JOIN messages m ON m.id = s.message_id
+		JOIN space_members sm ON sm.space_id = m.space_id AND sm.user_id = s.user_id
+		WHERE s.user_id = $1 AND (m.expires_at IS NULL OR m.expires_at > NOW())
+		ORDER BY s.created_at DESC, s.id
+		LIMIT $2 OFFSET $3
+	`
+
+	var saved []*model.SavedMessageDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &saved, query, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// ClaimDueReminders marks up to limit due reminders as sent and returns
+// them. SKIP LOCKED lets several replicas poll without double-sending.
+// Reminders for spaces the user has left are not claimed.
+func (r *RepoSavedMessage) ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	query := `
+		UPDATE saved_messages
+		SET reminded_at = $1
+		WHERE id IN (
+			SELECT s.id
+			FROM saved_messages s
+			JOIN messages m ON m.id = s.message_id
+			JOIN space_members sm ON sm.space_id = m.space_id AND sm.user_id = s.user_id
+			WHERE s.remind_at <= $1 AND s.reminded_at IS NULL
+			ORDER BY s.remind_at
+			LIMIT $2
+			FOR UPDATE OF s SKIP LOCKED
+		)
+		RETURNING id
+	`
+
+	var ids []string
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &ids, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
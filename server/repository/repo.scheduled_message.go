@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type RepoScheduledMessage struct {
+	db *sqlx.DB
+}
+
+func NewScheduledMessageRepository(db *sqlx.DB) *RepoScheduledMessage {
+	return &RepoScheduledMessage{
+		db: db,
+	}
+}
+
+func (r *RepoScheduledMessage) Create(ctx context.Context, scheduled *model.ScheduledMessageDB) error {
+	scheduled.ID = uuid.New()
+	scheduled.Status = constant.SCHEDULED_MESSAGE_STATUS_PENDING
+	scheduled.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO scheduled_messages (id, user_id, space_id, content, format, ephemeral, send_at, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, scheduled.ID, scheduled.UserID, scheduled.SpaceID, scheduled.Content,
+		scheduled.Format, scheduled.Ephemeral, scheduled.SendAt, scheduled.Status, scheduled.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Cancel cancels a pending scheduled message owned by userID. It reports
+// false when there is nothing left to cancel.
+func (r *RepoScheduledMessage) Cancel(ctx context.Context, id, userID string) (bool, error) {
+	query := `
+		UPDATE scheduled_messages
+		SET status = 'canceled'
+		WHERE id = $1 AND user_id = $2 AND status = 'pending'
+	`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RepoScheduledMessage) GetPendingByUserID(ctx context.Context, userID string, spaceID *uuid.UUID) ([]*model.ScheduledMessageDB, error) {
+	const query = `
+		SELECT id, user_id, space_id, content, format, ephemeral, send_at, status, message_id, last_error, created_at
+		FROM scheduled_messages
+		WHERE user_id = $1 AND status = 'pending'
+			AND ($2::uuid IS NULL OR space_id = $2)
+		ORDER BY send_at ASC
+	`
+
+	var scheduled []*model.ScheduledMessageDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &scheduled, query, userID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// DeliverNext locks the oldest due message with FOR UPDATE SKIP LOCKED,
+// hands it to deliver and records the outcome in the same transaction, so
+// replicas polling concurrently never pick the same row. It reports false
+// when nothing is due. A crash before commit leaves the row pending, so
+// deliver runs again for it and must be idempotent.
+func (r *RepoScheduledMessage) DeliverNext(ctx context.Context, now time.Time, deliver func(ctx context.Context, scheduled *model.ScheduledMessageDB) (*string, error)) (bool, error) {
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const selectQuery = `
+		SELECT id, user_id, space_id, content, format, ephemeral, send_at, status, message_id, last_error, created_at
+		FROM scheduled_messages
+		WHERE status = 'pending' AND send_at <= $1
+		ORDER BY send_at ASC
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	var scheduled model.ScheduledMessageDB
+	err = sqlx.GetContext(ctx, tx, &scheduled, selectQuery, now)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	status := constant.SCHEDULED_MESSAGE_STATUS_SENT
+	var lastError *string
+
+	messageID, deliverErr := deliver(ctx, &scheduled)
+	if deliverErr != nil {
+		status = constant.SCHEDULED_MESSAGE_STATUS_FAILED
+		msg := deliverErr.Error()
+		lastError = &msg
+	}
+
+	const updateQuery = `
+		UPDATE scheduled_messages
+		SET status = $2, message_id = $3, last_error = $4
+		WHERE id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, updateQuery, scheduled.ID, status, messageID, lastError)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type RepoSlashCommand struct {
+	db *sqlx.DB
+}
+
+func NewSlashCommandRepository(db *sqlx.DB) *RepoSlashCommand {
+	return &RepoSlashCommand{
+		db: db,
+	}
+}
+
+// Create registers a command unless the space already has limit commands
+// or one with the same name. It reports whether the command was stored.
+func (r *RepoSlashCommand) Create(ctx context.Context, command *model.SlashCommandDB, limit int) (bool, error) {
+	command.ID = uuid.New()
+	command.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO slash_commands (id, space_id, name, url, secret, description, created_by, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		WHERE (SELECT COUNT(*) FROM slash_commands WHERE space_id = $2) < $9
+		ON CONFLICT (space_id, name) DO NOTHING
+	`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, command.ID, command.SpaceID, command.Name, command.URL, command.Secret,
+		command.Description, command.CreatedBy, command.CreatedAt, limit)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RepoSlashCommand) Delete(ctx context.Context, id string) error {
+	query := `
+		DELETE FROM slash_commands
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoSlashCommand) GetByID(ctx context.Context, id string) (*model.SlashCommandDB, error) {
+	const query = `
+		SELECT id, space_id, name, url, secret, description, created_by, created_at
+		FROM slash_commands
+		WHERE id = $1
+	`
+
+	var command model.SlashCommandDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &command, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &command, nil
+}
+
+func (r *RepoSlashCommand) GetByName(ctx context.Context, spaceID, name string) (*model.SlashCommandDB, error) {
+	const query = `
+		SELECT id, space_id, name, url, secret, description, created_by, created_at
+		FROM slash_commands
+		WHERE space_id = $1 AND name = $2
+	`
+
+	var command model.SlashCommandDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &command, query, spaceID, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &command, nil
+}
+
+func (r *RepoSlashCommand) GetBySpaceID(ctx context.Context, spaceID string) ([]*model.SlashCommandDB, error) {
+	const query = `
+		SELECT id, space_id, name, url, secret, description, created_by, created_at
+		FROM slash_commands
+		WHERE space_id = $1
+		ORDER BY name ASC
+	`
+
+	var commands []*model.SlashCommandDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &commands, query, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return commands, nil
+}
@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"chatspace-server/constant"
 	"context"
 	"database/sql"
 	"errors"
 	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/helper"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,7 +32,7 @@ func (r *RepoSpace) Create(ctx context.Context, space *modelDB.SpaceDB) (*string
 		VALUES ($1, $2, $3, $4, $5)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, space.ID, space.Name, space.Description, now, now)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, space.ID, space.Name, space.Description, now, now)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +50,7 @@ func (r *RepoSpace) GetSpaceByID(ctx context.Context, id string) (*modelDB.Space
 	`
 
 	var space modelDB.SpaceDB
-	err := r.db.GetContext(ctx, &space, query, id)
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &space, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
@@ -67,7 +69,7 @@ func (r *RepoSpace) GetSpaces(ctx context.Context) ([]*modelDB.SpaceDB, error) {
 	`
 
 	var spaces []*modelDB.SpaceDB
-	err := r.db.SelectContext(ctx, &spaces, query)
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &spaces, query)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +85,7 @@ func (r *RepoSpace) CreateSpaceMember(ctx context.Context, spaceMember *modelDB.
 		INSERT INTO space_members (id, user_id, space_id, role, created_at)
 		VALUES ($1, $2, $3, $4, $5)
 	`
-	_, err := r.db.ExecContext(ctx, query, spaceMember.ID, spaceMember.UserID, spaceMember.SpaceID, spaceMember.Role, now)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, spaceMember.ID, spaceMember.UserID, spaceMember.SpaceID, spaceMember.Role, now)
 	if err != nil {
 		return err
 	}
@@ -100,7 +102,7 @@ func (r *RepoSpace) GetSpaceMember(ctx context.Context, spaceID string) ([]*mode
 	`
 
 	var members []*modelDB.SpaceMemberDB
-	err := r.db.SelectContext(ctx, &members, query, spaceID)
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &members, query, spaceID)
 	if err != nil {
 		return nil, err
 	}
@@ -118,10 +120,267 @@ func (r *RepoSpace) GetMemberBySpaceID(ctx context.Context, spaceID, role string
 	`
 
 	var members []*modelDB.UserDB
-	err := r.db.SelectContext(ctx, &members, query, spaceID, role)
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &members, query, spaceID, role)
 	if err != nil {
 		return nil, err
 	}
 
 	return members, nil
 }
+
+func (r *RepoSpace) GetSpacesByUserID(ctx context.Context, userID string) ([]*modelDB.SpaceDB, error) {
+	const query = `
+		SELECT s.id, s.name, COALESCE(s.description, '') AS description, s.created_at, s.updated_at
+		FROM spaces s
+		JOIN space_members sm ON s.id = sm.space_id
+		WHERE sm.user_id = $1
+		ORDER BY sm.created_at DESC
+	`
+
+	var spaces []*modelDB.SpaceDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &spaces, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return spaces, nil
+}
+
+func (r *RepoSpace) GetSpaceStats(ctx context.Context, spaceID, userID string) (*modelDB.SpaceStatsDB, error) {
+	const query = `
+		SELECT
+			(SELECT COUNT(*) FROM space_members WHERE space_id = $1) AS member_count,
+			(SELECT MAX(created_at) FROM messages WHERE space_id = $1) AS last_activity_at,
+			EXISTS (SELECT 1 FROM space_members WHERE space_id = $1 AND user_id = $2) AS is_member,
+			COALESCE(sm.last_read_seq, 0) AS last_read_seq,
+			(SELECT COUNT(*) FROM messages m
+				WHERE m.space_id = s.id AND m.seq > sm.last_read_seq
+					AND (m.expires_at IS NULL OR m.expires_at > NOW())) AS unread_count
+		FROM spaces s
+		LEFT JOIN space_members sm ON sm.space_id = s.id AND sm.user_id = $2
+		WHERE s.id = $1
+	`
+
+	var stats modelDB.SpaceStatsDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &stats, query, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+var spaceSortOrder = map[string]string{
+	constant.SPACE_SORT_RELEVANCE: "score DESC, member_count DESC",
+	constant.SPACE_SORT_MEMBERS:   "member_count DESC, s.created_at DESC",
+	constant.SPACE_SORT_ACTIVITY:  "last_activity_at DESC NULLS LAST, s.created_at DESC",
+	constant.SPACE_SORT_CREATED:   "s.created_at DESC",
+}
+
+func (r *RepoSpace) SearchSpaces(ctx context.Context, params *modelDB.SpaceSearchParams) ([]*modelDB.SpaceSearchDB, error) {
+	order, ok := spaceSortOrder[params.Sort]
+	if !ok {
+		order = spaceSortOrder[constant.SPACE_SORT_CREATED]
+	}
+
+	query := `
+		SELECT s.id, s.name, COALESCE(s.description, '') AS description, s.created_at, s.updated_at,
+			(SELECT COUNT(*) FROM space_members sm WHERE sm.space_id = s.id) AS member_count,
+			(SELECT MAX(m.created_at) FROM messages m WHERE m.space_id = s.id) AS last_activity_at,
+			EXISTS (SELECT 1 FROM space_members sm WHERE sm.space_id = s.id AND sm.user_id = $1) AS is_member,
+			COALESCE(me.last_read_seq, 0) AS last_read_seq,
+			(SELECT COUNT(*) FROM messages m
+				WHERE m.space_id = s.id AND m.seq > me.last_read_seq
+					AND (m.expires_at IS NULL OR m.expires_at > NOW())) AS unread_count,
+			CASE WHEN $2 = '' THEN 0
+				ELSE GREATEST(similarity(s.name, $2), similarity(COALESCE(s.description, ''), $2))
+			END AS score
+		FROM spaces s
+		LEFT JOIN space_members me ON me.space_id = s.id AND me.user_id = $1
+		WHERE $2 = ''
+			OR s.name ILIKE '%' || $5 || '%'
+			OR s.description ILIKE '%' || $5 || '%'
+			OR s.name % $2
+		ORDER BY ` + order + `
+		LIMIT $3 OFFSET $4
+	`
+
+	var spaces []*modelDB.SpaceSearchDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &spaces, query, params.UserID, params.Query, params.Limit, params.Offset,
+		helper.EscapeLike(params.Query))
+	if err != nil {
+		return nil, err
+	}
+
+	return spaces, nil
+}
+
+func (r *RepoSpace) GetMemberRole(ctx context.Context, spaceID, userID string) (string, error) {
+	const query = `
+		SELECT role
+		FROM space_members
+		WHERE space_id = $1 AND user_id = $2
+	`
+
+	var role string
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &role, query, spaceID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", sql.ErrNoRows
+		}
+		return "", err
+	}
+
+	return role, nil
+}
+
+func (r *RepoSpace) GetAttachmentPolicy(ctx context.Context, spaceID string) (*modelDB.AttachmentPolicyDB, error) {
+	const query = `
+		SELECT attachment_max_size, attachment_mime_types
+		FROM spaces
+		WHERE id = $1
+	`
+
+	var policy modelDB.AttachmentPolicyDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &policy, query, spaceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *RepoSpace) UpdateAttachmentPolicy(ctx context.Context, spaceID string, policy *modelDB.AttachmentPolicyDB) error {
+	query := `
+		UPDATE spaces
+		SET attachment_max_size = $2, attachment_mime_types = $3, updated_at = $4
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, spaceID, policy.MaxSize, policy.MimeTypes, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoSpace) GetMessageTTL(ctx context.Context, spaceID string) (*int, error) {
+	const query = `
+		SELECT message_ttl
+		FROM spaces
+		WHERE id = $1
+	`
+
+	var ttl *int
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &ttl, query, spaceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return ttl, nil
+}
+
+func (r *RepoSpace) UpdateMessageTTL(ctx context.Context, spaceID string, ttl *int) error {
+	query := `
+		UPDATE spaces
+		SET message_ttl = $2, updated_at = $3
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, spaceID, ttl, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoSpace) GetRetentionPolicy(ctx context.Context, spaceID string) (*modelDB.RetentionPolicyDB, error) {
+	const query = `
+		SELECT id, retention_mode, retention_value
+		FROM spaces
+		WHERE id = $1
+	`
+
+	var policy modelDB.RetentionPolicyDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &policy, query, spaceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *RepoSpace) GetRetentionPolicies(ctx context.Context) ([]*modelDB.RetentionPolicyDB, error) {
+	const query = `
+		SELECT id, retention_mode, retention_value
+		FROM spaces
+	`
+
+	var policies []*modelDB.RetentionPolicyDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &policies, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (r *RepoSpace) UpdateRetentionPolicy(ctx context.Context, policy *modelDB.RetentionPolicyDB) error {
+	query := `
+		UPDATE spaces
+		SET retention_mode = $2, retention_value = $3, updated_at = $4
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, policy.SpaceID, policy.Mode, policy.Value, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoSpace) UpdateDescription(ctx context.Context, spaceID, description string) error {
+	query := `
+		UPDATE spaces
+		SET description = $2, updated_at = $3
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, spaceID, description, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateLastReadSeq moves a member's read marker forward to seq, capped at
+// the newest message of the space. It never moves the marker back.
+func (r *RepoSpace) UpdateLastReadSeq(ctx context.Context, spaceID, userID string, seq int64) error {
+	query := `
+		UPDATE space_members sm
+		SET last_read_seq = LEAST($3, s.last_message_seq)
+		FROM spaces s
+		WHERE s.id = sm.space_id AND sm.space_id = $1 AND sm.user_id = $2
+			AND sm.last_read_seq < LEAST($3, s.last_message_seq)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, spaceID, userID, seq)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
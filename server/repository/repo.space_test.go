@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetSpaceStatsUnreadCount(t *testing.T) {
+	db := testDB(t)
+	messages := NewMessageRepository(db, nil)
+	spaces := NewSpaceRepository(db)
+	ctx := context.Background()
+
+	spaceID, userIDs := testSpace(t, db, 2)
+	sender, reader := userIDs[0], userIDs[1]
+
+	expired := time.Now().Add(-time.Minute)
+	later := time.Now().Add(time.Hour)
+	for _, expiresAt := range []*time.Time{nil, &expired, &later, &expired, nil} {
+		_, err := testMessage(ctx, messages, spaceID, sender, expiresAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   int64
+	}{
+		{name: "reader skips expired messages", userID: reader.String(), want: 3},
+		{name: "sender has read their own", userID: sender.String(), want: 0},
+		{name: "non-member", userID: "00000000-0000-0000-0000-000000000000", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := spaces.GetSpaceStats(ctx, spaceID.String(), tt.userID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if stats.UnreadCount != tt.want {
+				t.Fatalf("GetSpaceStats().UnreadCount = %d, want %d", stats.UnreadCount, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"chatspace-server/model"
+	"chatspace-server/pkg/helper"
 	"time"
 
 	"github.com/google/uuid"
@@ -29,7 +30,7 @@ func (r *RepoUser) Create(ctx context.Context, user *model.UserDB) (*string, err
 		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, user.ID, user.Email, user.Name, user.Password, now, now)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, user.ID, user.Email, user.Name, user.Password, now, now)
 	if err != nil {
 		return nil, err
 	}
@@ -39,14 +40,51 @@ func (r *RepoUser) Create(ctx context.Context, user *model.UserDB) (*string, err
 	return &idStr, nil
 }
 
+// CreateBot stores a bot user and adds it to a space in one transaction.
+func (r *RepoUser) CreateBot(ctx context.Context, user *model.UserDB, spaceID uuid.UUID) error {
+	user.ID = uuid.New()
+	user.Bot = true
+	now := time.Now()
+
+	_, tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const userQuery = `
+		INSERT INTO users (id, email, name, password, bot, avatar_url, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, TRUE, $5, $6, $6)
+	`
+
+	_, err = tx.ExecContext(ctx, userQuery, user.ID, user.Email, user.Name, user.Password, user.AvatarURL, now)
+	if err != nil {
+		return err
+	}
+
+	const memberQuery = `
+		INSERT INTO space_members (id, user_id, space_id, role, created_at)
+		VALUES ($1, $2, $3, 'member', $4)
+	`
+
+	_, err = tx.ExecContext(ctx, memberQuery, uuid.New(), user.ID, spaceID, now)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *RepoUser) GetByID(ctx context.Context, id string) (*model.UserDB, error) {
 	var user model.UserDB
 	query := `
-		SELECT id, email, name, password, created_at, updated_at
+		SELECT id, email, name, password, bot, avatar_url, token_version, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
-	err := r.db.GetContext(ctx, &user, query, id)
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &user, query, id)
 	if err != nil {
 		return nil, sql.ErrNoRows
 	}
@@ -54,6 +92,27 @@ func (r *RepoUser) GetByID(ctx context.Context, id string) (*model.UserDB, error
 	return &user, nil
 }
 
+// RotateBotToken bumps the token version of a bot that is a member of the
+// space, which revokes every token issued before, and returns the bot.
+func (r *RepoUser) RotateBotToken(ctx context.Context, botID, spaceID string) (*model.UserDB, error) {
+	query := `
+		UPDATE users u
+		SET token_version = u.token_version + 1, updated_at = $3
+		WHERE u.id = $1 AND u.bot AND EXISTS (
+			SELECT 1 FROM space_members sm WHERE sm.user_id = u.id AND sm.space_id = $2
+		)
+		RETURNING id, email, name, password, bot, avatar_url, token_version, created_at, updated_at
+	`
+
+	var user model.UserDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &user, query, botID, spaceID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func (r *RepoUser) GetByEmail(ctx context.Context, email string) (*model.UserDB, error) {
 	var user model.UserDB
 	query := `
@@ -61,10 +120,103 @@ func (r *RepoUser) GetByEmail(ctx context.Context, email string) (*model.UserDB,
 		FROM users
 		WHERE email = $1
 	`
-	err := r.db.GetContext(ctx, &user, query, email)
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &user, query, email)
 	if err != nil {
 		return nil, sql.ErrNoRows
 	}
 
 	return &user, nil
 }
+
+func (r *RepoUser) BlockUser(ctx context.Context, block *model.UserBlockDB) error {
+	block.ID = uuid.New()
+	now := time.Now()
+
+	query := `
+		INSERT INTO user_blocks (id, blocker_id, blocked_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, block.ID, block.BlockerID, block.BlockedID, now)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoUser) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	query := `
+		DELETE FROM user_blocks
+		WHERE blocker_id = $1 AND blocked_id = $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, blockerID, blockedID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoUser) GetBlockedUsers(ctx context.Context, blockerID string) ([]*model.UserDB, error) {
+	const query = `
+		SELECT u.id, u.email, u.name, u.created_at, u.updated_at
+		FROM users u
+		JOIN user_blocks ub ON u.id = ub.blocked_id
+		WHERE ub.blocker_id = $1
+		ORDER BY ub.created_at DESC
+	`
+
+	var users []*model.UserDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &users, query, blockerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *RepoUser) GetBlockedUserIDs(ctx context.Context, blockerID string) ([]uuid.UUID, error) {
+	const query = `
+		SELECT blocked_id
+		FROM user_blocks
+		WHERE blocker_id = $1
+	`
+
+	var ids []uuid.UUID
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &ids, query, blockerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *RepoUser) SearchUsers(ctx context.Context, params *model.UserSearchParams) ([]*model.UserSearchDB, error) {
+	const query = `
+		SELECT u.id, u.email, u.name, u.created_at, u.updated_at,
+			lower(u.email) = lower($2) AS email_match,
+			(
+				SELECT COUNT(*)
+				FROM space_members a
+				JOIN space_members b ON a.space_id = b.space_id
+				WHERE a.user_id = $1 AND b.user_id = u.id
+			) AS shared_space_count
+		FROM users u
+		WHERE u.id <> $1 AND NOT u.bot
+			AND (lower(u.name) LIKE lower($3) || '%' OR lower(u.email) = lower($2))
+		ORDER BY email_match DESC, shared_space_count DESC, u.name ASC
+		LIMIT $4 OFFSET $5
+	`
+
+	var users []*model.UserSearchDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &users, query,
+		params.UserID, params.Query, helper.EscapeLike(params.Query), params.Limit, params.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
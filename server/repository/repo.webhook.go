@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/model"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const webhookDeliveryColumns = `id, webhook_id, event_type, payload, status, attempts, next_attempt_at,
+	response_status, last_error, delivered_at, redelivery_of, created_at`
+
+type RepoWebhook struct {
+	db *sqlx.DB
+}
+
+func NewWebhookRepository(db *sqlx.DB) *RepoWebhook {
+	return &RepoWebhook{
+		db: db,
+	}
+}
+
+// Create stores a webhook unless the space already has limit webhooks. It
+// reports whether the webhook was stored.
+func (r *RepoWebhook) Create(ctx context.Context, webhook *model.WebhookDB, limit int) (bool, error) {
+	webhook.ID = uuid.New()
+	webhook.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO webhooks (id, space_id, url, secret, events, created_by, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7
+		WHERE (SELECT COUNT(*) FROM webhooks WHERE space_id = $2) < $8
+	`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, webhook.ID, webhook.SpaceID, webhook.URL, webhook.Secret,
+		webhook.Events, webhook.CreatedBy, webhook.CreatedAt, limit)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RepoWebhook) Delete(ctx context.Context, id string) error {
+	query := `
+		DELETE FROM webhooks
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoWebhook) GetByID(ctx context.Context, id string) (*model.WebhookDB, error) {
+	const query = `
+		SELECT id, space_id, url, secret, events, created_by, created_at
+		FROM webhooks
+		WHERE id = $1
+	`
+
+	var webhook model.WebhookDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &webhook, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (r *RepoWebhook) GetBySpaceID(ctx context.Context, spaceID string) ([]*model.WebhookDB, error) {
+	const query = `
+		SELECT id, space_id, url, secret, events, created_by, created_at
+		FROM webhooks
+		WHERE space_id = $1
+		ORDER BY created_at ASC
+	`
+
+	var webhooks []*model.WebhookDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &webhooks, query, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// GetSubscribed returns the webhooks of a space that subscribe to
+// eventType.
+func (r *RepoWebhook) GetSubscribed(ctx context.Context, spaceID, eventType string) ([]*model.WebhookDB, error) {
+	const query = `
+		SELECT id, space_id, url, secret, events, created_by, created_at
+		FROM webhooks
+		WHERE space_id = $1 AND $2 = ANY(events)
+	`
+
+	var webhooks []*model.WebhookDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &webhooks, query, spaceID, eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *RepoWebhook) CreateDelivery(ctx context.Context, delivery *model.WebhookDeliveryDB) error {
+	delivery.ID = uuid.New()
+	delivery.Status = constant.WEBHOOK_DELIVERY_STATUS_PENDING
+	delivery.CreatedAt = time.Now()
+	delivery.NextAttemptAt = delivery.CreatedAt
+
+	query := `
+		INSERT INTO webhook_deliveries (id, webhook_id, event_type, payload, status, next_attempt_at, redelivery_of, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, delivery.ID, delivery.WebhookID, delivery.EventType, delivery.Payload,
+		delivery.Status, delivery.NextAttemptAt, delivery.RedeliveryOf, delivery.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepoWebhook) GetDeliveryByID(ctx context.Context, id string) (*model.WebhookDeliveryDB, error) {
+	const query = `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE id = $1
+	`
+
+	var delivery model.WebhookDeliveryDB
+	err := sqlx.GetContext(ctx, conn(ctx, r.db), &delivery, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// GetDeliveries lists the delivery log of a webhook, newest first.
+func (r *RepoWebhook) GetDeliveries(ctx context.Context, webhookID string, limit, offset int) ([]*model.WebhookDeliveryDB, error) {
+	const query = `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	var deliveries []*model.WebhookDeliveryDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &deliveries, query, webhookID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueDeliveries leases up to limit due deliveries by pushing their
+// next attempt past lease, so the HTTP calls happen outside any
+// transaction. SKIP LOCKED keeps replicas from claiming the same rows, and
+// a worker that dies mid-delivery only delays the retry until the lease
+// runs out.
+func (r *RepoWebhook) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookJobDB, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $2
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at,
+			d.response_status, d.last_error, d.delivered_at, d.redelivery_of, d.created_at, w.url, w.secret
+	`
+
+	var jobs []*model.WebhookJobDB
+	err := sqlx.SelectContext(ctx, conn(ctx, r.db), &jobs, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// RecordAttempt stores the outcome of a delivery attempt.
+func (r *RepoWebhook) RecordAttempt(ctx context.Context, delivery *model.WebhookDeliveryDB) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, response_status = $5, last_error = $6, delivered_at = $7
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, delivery.ID, delivery.Status, delivery.Attempts, delivery.NextAttemptAt,
+		delivery.ResponseStatus, delivery.LastError, delivery.DeliveredAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// txKey carries the transaction of the unit of work a context belongs to.
+type txKey struct{}
+
+type txState struct {
+	tx *sqlx.Tx
+	// savepoints counts the savepoints currently open, so nested units get
+	// distinct names.
+	savepoints int
+	// base is the context the outermost unit was started with. Hooks run
+	// with it, since the transaction is finished by then.
+	base        context.Context
+	afterCommit []func(ctx context.Context)
+}
+
+// TxManager runs several repository calls as one unit of work. Repository
+// methods given the context passed to fn run in its transaction.
+type TxManager struct {
+	db *sqlx.DB
+}
+
+func NewTxManager(db *sqlx.DB) *TxManager {
+	return &TxManager{
+		db: db,
+	}
+}
+
+// WithinTx runs fn in a transaction that is committed when fn returns nil
+// and rolled back otherwise. Called inside another unit of work, fn runs
+// under a savepoint instead: its failure undoes only its own writes and
+// the outer unit decides whether to commit. A unit of work must not be
+// shared between goroutines.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTx(ctx, m.db, fn)
+}
+
+func withinTx(ctx context.Context, db *sqlx.DB, fn func(ctx context.Context) error) error {
+	ctx, tx, err := begin(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = fn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if tx.savepoint == "" {
+		for _, hook := range tx.st.afterCommit {
+			hook(tx.st.base)
+		}
+	}
+
+	return nil
+}
+
+// AfterCommit runs fn once the unit of work in ctx has committed, or right
+// away when there is none. Hooks registered under a savepoint that is
+// rolled back are dropped with it. Use it for side effects such as
+// notifications that must not announce writes which may still roll back.
+func (m *TxManager) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	st, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		fn(ctx)
+		return
+	}
+
+	st.afterCommit = append(st.afterCommit, fn)
+}
+
+// unit is a transaction or, inside an existing unit of work, a savepoint
+// of its transaction. Rollback after Commit is a no-op, so it can be
+// deferred right after begin.
+type unit struct {
+	*sqlx.Tx
+	ctx       context.Context
+	st        *txState
+	savepoint string
+	// hooks is the number of after-commit hooks registered before a
+	// savepoint was taken, so rolling it back can drop the later ones.
+	hooks int
+	done  bool
+}
+
+// begin starts a unit of work, or a nested one when ctx already carries a
+// transaction. The returned context carries the transaction for the
+// repository calls made within the unit; repositories that only use the
+// unit directly keep their own ctx, so callbacks they run stay outside it.
+func begin(ctx context.Context, db *sqlx.DB) (context.Context, *unit, error) {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok {
+		name := "sp_" + strconv.Itoa(st.savepoints+1)
+
+		_, err := st.tx.ExecContext(ctx, "SAVEPOINT "+name)
+		if err != nil {
+			return ctx, nil, err
+		}
+		st.savepoints++
+
+		return ctx, &unit{Tx: st.tx, ctx: ctx, st: st, savepoint: name, hooks: len(st.afterCommit)}, nil
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	st := &txState{tx: tx, base: ctx}
+
+	return context.WithValue(ctx, txKey{}, st), &unit{Tx: tx, ctx: ctx, st: st}, nil
+}
+
+func (u *unit) Commit() error {
+	if u.done {
+		return sql.ErrTxDone
+	}
+	u.done = true
+
+	if u.savepoint == "" {
+		return u.Tx.Commit()
+	}
+
+	u.st.savepoints--
+	_, err := u.Tx.ExecContext(u.ctx, "RELEASE SAVEPOINT "+u.savepoint)
+
+	return err
+}
+
+// Rollback of a savepoint also clears an aborted statement, so the outer
+// unit can carry on.
+func (u *unit) Rollback() error {
+	if u.done {
+		return sql.ErrTxDone
+	}
+	u.done = true
+
+	if u.savepoint == "" {
+		return u.Tx.Rollback()
+	}
+
+	u.st.savepoints--
+	u.st.afterCommit = u.st.afterCommit[:u.hooks]
+	_, err := u.Tx.ExecContext(context.WithoutCancel(u.ctx), "ROLLBACK TO SAVEPOINT "+u.savepoint)
+
+	return err
+}
+
+// conn returns the transaction of the unit of work in ctx, or db when
+// there is none.
+func conn(ctx context.Context, db *sqlx.DB) sqlx.ExtContext {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok {
+		return st.tx
+	}
+
+	return db
+}
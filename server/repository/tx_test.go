@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// testDB connects to the database named by CHATSPACE_TEST_POSTGRES_DSN and
+// applies migration/init.sql, which is safe to rerun. Tests that need it
+// are skipped without one.
+func testDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	dsn := os.Getenv("CHATSPACE_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("CHATSPACE_TEST_POSTGRES_DSN is not set")
+	}
+
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	migration, err := os.ReadFile("../migration/init.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec(string(migration))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestAfterCommitWithoutTx(t *testing.T) {
+	m := NewTxManager(nil)
+
+	ran := false
+	m.AfterCommit(context.Background(), func(ctx context.Context) {
+		ran = true
+	})
+
+	if !ran {
+		t.Fatal("hook did not run outside a unit of work")
+	}
+}
+
+func TestAfterCommit(t *testing.T) {
+	m := NewTxManager(testDB(t))
+	ctx := context.Background()
+	errFail := errors.New("fail")
+
+	tests := []struct {
+		name    string
+		fn      func(ctx context.Context, hook func(string) func(context.Context)) error
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "commit",
+			fn: func(ctx context.Context, hook func(string) func(context.Context)) error {
+				m.AfterCommit(ctx, hook("outer"))
+				return nil
+			},
+			want: []string{"outer"},
+		},
+		{
+			name: "rollback",
+			fn: func(ctx context.Context, hook func(string) func(context.Context)) error {
+				m.AfterCommit(ctx, hook("outer"))
+				return errFail
+			},
+			wantErr: errFail,
+		},
+		{
+			name: "nested rollback",
+			fn: func(ctx context.Context, hook func(string) func(context.Context)) error {
+				m.AfterCommit(ctx, hook("before"))
+
+				_ = m.WithinTx(ctx, func(ctx context.Context) error {
+					m.AfterCommit(ctx, hook("nested"))
+					return errFail
+				})
+
+				m.AfterCommit(ctx, hook("after"))
+				return nil
+			},
+			want: []string{"before", "after"},
+		},
+		{
+			name: "nested commit",
+			fn: func(ctx context.Context, hook func(string) func(context.Context)) error {
+				err := m.WithinTx(ctx, func(ctx context.Context) error {
+					m.AfterCommit(ctx, hook("nested"))
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+
+				m.AfterCommit(ctx, hook("outer"))
+				return nil
+			},
+			want: []string{"nested", "outer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			hook := func(name string) func(context.Context) {
+				return func(ctx context.Context) {
+					if _, ok := ctx.Value(txKey{}).(*txState); ok {
+						t.Errorf("hook %q ran with a finished transaction in its context", name)
+					}
+					got = append(got, name)
+				}
+			}
+
+			err := m.WithinTx(ctx, func(ctx context.Context) error {
+				return tt.fn(ctx, hook)
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WithinTx() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("hooks ran = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("hooks ran = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"chatspace-server/graph/model"
+	"chatspace-server/handler/middleware"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/broker"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+)
+
+// The fakes embed the interface they stand in for, so calling a method a
+// test did not set up panics instead of silently returning zero values.
+
+func authed(userID string) context.Context {
+	return context.WithValue(context.Background(), middleware.UserCtxKey, &middleware.AuthUser{UserID: userID})
+}
+
+// resolving wraps ctx as a GraphQL resolver call with an empty selection,
+// for use cases that look at the requested fields.
+func resolving(ctx context.Context) context.Context {
+	ctx = graphql.WithOperationContext(ctx, &graphql.OperationContext{})
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{})
+}
+
+type fakeRepoUser struct {
+	repoUserInterface
+
+	mu      sync.Mutex
+	blocked map[string][]uuid.UUID
+	users   map[string]*modelDB.UserDB
+	// members records the space each bot was created in.
+	members map[string]uuid.UUID
+}
+
+func (r *fakeRepoUser) GetByID(ctx context.Context, id string) (*modelDB.UserDB, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeRepoUser) CreateBot(ctx context.Context, user *modelDB.UserDB, spaceID uuid.UUID) error {
+	if r.users == nil {
+		r.users, r.members = map[string]*modelDB.UserDB{}, map[string]uuid.UUID{}
+	}
+
+	user.ID = uuid.New()
+	user.Bot = true
+
+	copied := *user
+	r.users[user.ID.String()] = &copied
+	r.members[user.ID.String()] = spaceID
+
+	return nil
+}
+
+func (r *fakeRepoUser) RotateBotToken(ctx context.Context, botID, spaceID string) (*modelDB.UserDB, error) {
+	user, ok := r.users[botID]
+	if !ok || !user.Bot || r.members[botID].String() != spaceID {
+		return nil, sql.ErrNoRows
+	}
+
+	user.TokenVersion++
+	return r.GetByID(ctx, botID)
+}
+
+func (r *fakeRepoUser) block(blockerID string, blockedID uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.blocked == nil {
+		r.blocked = map[string][]uuid.UUID{}
+	}
+	r.blocked[blockerID] = append(r.blocked[blockerID], blockedID)
+}
+
+func (r *fakeRepoUser) GetBlockedUserIDs(ctx context.Context, blockerID string) ([]uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.blocked[blockerID], nil
+}
+
+type fakeRepoMessage struct {
+	repoMessageInterface
+
+	stream    chan broker.Message
+	messages  map[string]*modelDB.MessageDB
+	published []*model.SpaceEvent
+
+	// deleted holds the batches the hard-delete methods return, in order.
+	deleted [][]*modelDB.DeletedMessageDB
+	// announced holds the outbox entries written through Announce, and
+	// publishErr fails every direct publish.
+	announced  []*modelDB.OutboxDB
+	publishErr error
+}
+
+func (r *fakeRepoMessage) Announce(ctx context.Context, entry *modelDB.OutboxDB) error {
+	r.announced = append(r.announced, entry)
+	return nil
+}
+
+func (r *fakeRepoMessage) SubscribeMessage(ctx context.Context, spaceID, since string) (<-chan broker.Message, func() error, error) {
+	return r.stream, func() error { return nil }, nil
+}
+
+func (r *fakeRepoMessage) Create(ctx context.Context, message *modelDB.MessageDB, announce func(message *modelDB.MessageDB) (*modelDB.OutboxDB, error)) (bool, error) {
+	if r.messages == nil {
+		r.messages = map[string]*modelDB.MessageDB{}
+	}
+
+	if message.ClientMessageID != nil {
+		_, err := r.GetByClientMessageID(ctx, message.UserID.String(), message.SpaceID.String(), *message.ClientMessageID)
+		if err == nil {
+			return false, nil
+		}
+	}
+
+	message.Seq = int64(len(r.messages) + 1)
+	message.CreatedAt = time.Now()
+
+	_, err := announce(message)
+	if err != nil {
+		return false, err
+	}
+
+	r.messages[message.ID.String()] = message
+
+	return true, nil
+}
+
+func (r *fakeRepoMessage) GetMessageByID(ctx context.Context, id string) (*modelDB.MessageDB, error) {
+	message, ok := r.messages[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return message, nil
+}
+
+func (r *fakeRepoMessage) GetByClientMessageID(ctx context.Context, userID, spaceID, clientMessageID string) (*modelDB.MessageDB, error) {
+	for _, m := range r.messages {
+		if m.UserID.String() == userID && m.SpaceID.String() == spaceID && m.ClientMessageID != nil && *m.ClientMessageID == clientMessageID {
+			return m, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeRepoMessage) GetMessageLinkPreviews(ctx context.Context, messageID string) ([]*modelDB.LinkPreviewDB, error) {
+	return nil, nil
+}
+
+func (r *fakeRepoMessage) nextDeleted() []*modelDB.DeletedMessageDB {
+	if len(r.deleted) == 0 {
+		return nil
+	}
+
+	batch := r.deleted[0]
+	r.deleted = r.deleted[1:]
+
+	return batch
+}
+
+func (r *fakeRepoMessage) DeleteExpired(ctx context.Context, now time.Time, limit int) ([]*modelDB.DeletedMessageDB, error) {
+	return r.nextDeleted(), nil
+}
+
+func (r *fakeRepoMessage) PurgeOlderThan(ctx context.Context, spaceID string, before time.Time, limit int) ([]*modelDB.DeletedMessageDB, error) {
+	return r.nextDeleted(), nil
+}
+
+func (r *fakeRepoMessage) PurgeBeyondCount(ctx context.Context, spaceID string, keep, limit int) ([]*modelDB.DeletedMessageDB, error) {
+	return r.nextDeleted(), nil
+}
+
+// PublishMessage records space events; user events are dropped.
+func (r *fakeRepoMessage) PublishMessage(ctx context.Context, spaceID string, data []byte) error {
+	if r.publishErr != nil {
+		return r.publishErr
+	}
+
+	var event model.SpaceEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return err
+	}
+
+	if event.Type != "" {
+		r.published = append(r.published, &event)
+	}
+
+	return nil
+}
+
+// fakeRepoSpace holds member roles keyed by space and user ID.
+type fakeRepoSpace struct {
+	repoSpaceInterface
+
+	roles    map[[2]string]string
+	policies []*modelDB.RetentionPolicyDB
+}
+
+func (r *fakeRepoSpace) GetRetentionPolicies(ctx context.Context) ([]*modelDB.RetentionPolicyDB, error) {
+	return r.policies, nil
+}
+
+func (r *fakeRepoSpace) GetAttachmentPolicy(ctx context.Context, spaceID string) (*modelDB.AttachmentPolicyDB, error) {
+	return &modelDB.AttachmentPolicyDB{}, nil
+}
+
+func (r *fakeRepoSpace) GetMessageTTL(ctx context.Context, spaceID string) (*int, error) {
+	return nil, nil
+}
+
+func (r *fakeRepoSpace) GetMemberRole(ctx context.Context, spaceID, userID string) (string, error) {
+	role, ok := r.roles[[2]string{spaceID, userID}]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+
+	return role, nil
+}
+
+type fakeRepoAttachment struct {
+	repoAttachmentInterface
+
+	attachments map[string]*modelDB.AttachmentDB
+}
+
+func (r *fakeRepoAttachment) GetByID(ctx context.Context, id string) (*modelDB.AttachmentDB, error) {
+	attachment, ok := r.attachments[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	copied := *attachment
+	return &copied, nil
+}
+
+func (r *fakeRepoAttachment) Create(ctx context.Context, attachment *modelDB.AttachmentDB) (*string, error) {
+	if r.attachments == nil {
+		r.attachments = map[string]*modelDB.AttachmentDB{}
+	}
+
+	attachment.ID = uuid.New()
+	attachment.CreatedAt = time.Now()
+
+	copied := *attachment
+	r.attachments[attachment.ID.String()] = &copied
+
+	id := attachment.ID.String()
+	return &id, nil
+}
+
+func (r *fakeRepoAttachment) LinkToMessage(ctx context.Context, messageID, userID, spaceID uuid.UUID, ids []uuid.UUID) ([]*modelDB.AttachmentDB, error) {
+	var linked []*modelDB.AttachmentDB
+	for _, id := range ids {
+		a, ok := r.attachments[id.String()]
+		if !ok || a.UserID != userID || a.SpaceID != spaceID || a.MessageID != nil {
+			continue
+		}
+
+		a.MessageID = &messageID
+		copied := *a
+		linked = append(linked, &copied)
+	}
+
+	return linked, nil
+}
+
+func (r *fakeRepoAttachment) GetByMessageID(ctx context.Context, messageID string) ([]*modelDB.AttachmentDB, error) {
+	var attachments []*modelDB.AttachmentDB
+	for _, a := range r.attachments {
+		if a.MessageID != nil && a.MessageID.String() == messageID {
+			copied := *a
+			attachments = append(attachments, &copied)
+		}
+	}
+
+	return attachments, nil
+}
+
+func (r *fakeRepoAttachment) UpdateStatus(ctx context.Context, id, status string) error {
+	r.attachments[id].Status = status
+	return nil
+}
+
+func (r *fakeRepoAttachment) UpdateImageMetadata(ctx context.Context, attachment *modelDB.AttachmentDB) error {
+	a := r.attachments[attachment.ID.String()]
+	a.Size, a.Width, a.Height, a.BlurHash, a.Status = attachment.Size, attachment.Width, attachment.Height, attachment.BlurHash, attachment.Status
+	return nil
+}
+
+func (r *fakeRepoAttachment) CreateThumbnail(ctx context.Context, thumbnail *modelDB.AttachmentThumbnailDB) error {
+	return nil
+}
+
+// fakeRepoPoll holds a single poll. votes maps each voter to the options
+// they chose.
+type fakeRepoPoll struct {
+	repoPollInterface
+
+	poll    *modelDB.PollDB
+	options []*modelDB.PollOptionDB
+	votes   map[string][]uuid.UUID
+}
+
+func (r *fakeRepoPoll) GetByID(ctx context.Context, id string) (*modelDB.PollDB, error) {
+	if r.poll == nil || r.poll.ID.String() != id {
+		return nil, sql.ErrNoRows
+	}
+
+	copied := *r.poll
+	return &copied, nil
+}
+
+func (r *fakeRepoPoll) GetOptions(ctx context.Context, pollID string) ([]*modelDB.PollOptionDB, error) {
+	var options []*modelDB.PollOptionDB
+	for _, o := range r.options {
+		copied := *o
+		for _, ids := range r.votes {
+			for _, id := range ids {
+				if id == o.ID {
+					copied.VoteCount++
+				}
+			}
+		}
+		options = append(options, &copied)
+	}
+
+	return options, nil
+}
+
+func (r *fakeRepoPoll) CountVoters(ctx context.Context, pollID string) (int, error) {
+	return len(r.votes), nil
+}
+
+func (r *fakeRepoPoll) ReplaceVotes(ctx context.Context, pollID, userID string, optionIDs []uuid.UUID) (bool, error) {
+	if r.votes == nil {
+		r.votes = map[string][]uuid.UUID{}
+	}
+
+	delete(r.votes, userID)
+	if len(optionIDs) > 0 {
+		r.votes[userID] = optionIDs
+	}
+
+	return true, nil
+}
+
+func (r *fakeRepoPoll) GetByMessageID(ctx context.Context, messageID string) (*modelDB.PollDB, error) {
+	return nil, sql.ErrNoRows
+}
+
+// fakeTxManager runs units of work without a transaction, so hooks run
+// right away.
+type fakeTxManager struct{}
+
+func (fakeTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTxManager) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	fn(ctx)
+}
+
+type fakeQueue struct {
+	payloads []string
+}
+
+func (q *fakeQueue) Enqueue(payload string) error {
+	q.payloads = append(q.payloads, payload)
+	return nil
+}
+
+func (q *fakeQueue) EnqueueWait(ctx context.Context, payload string) error {
+	return q.Enqueue(payload)
+}
+
+type fakeOutbox struct{}
+
+func (fakeOutbox) Notify() {}
+
+type fakeWebhooks struct {
+	events []*model.SpaceEvent
+}
+
+func (w *fakeWebhooks) Dispatch(ctx context.Context, event *model.SpaceEvent) {
+	w.events = append(w.events, event)
+}
+
+type fakeRepoIncomingWebhook struct {
+	repoIncomingWebhookInterface
+
+	webhooks map[string]*modelDB.IncomingWebhookDB
+}
+
+func (r *fakeRepoIncomingWebhook) GetByID(ctx context.Context, id string) (*modelDB.IncomingWebhookDB, error) {
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return webhook, nil
+}
+
+func (r *fakeRepoIncomingWebhook) HitRateLimit(ctx context.Context, id string, window time.Duration) (int64, error) {
+	return 1, nil
+}
@@ -0,0 +1,533 @@
+package usecase
+
+import (
+	"bytes"
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/blobstore"
+	"chatspace-server/pkg/helper"
+	"chatspace-server/pkg/imaging"
+	"chatspace-server/pkg/signer"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type repoAttachmentInterface interface {
+	Create(ctx context.Context, attachment *modelDB.AttachmentDB) (*string, error)
+	GetByID(ctx context.Context, id string) (*modelDB.AttachmentDB, error)
+	GetByMessageID(ctx context.Context, messageID string) ([]*modelDB.AttachmentDB, error)
+	LinkToMessage(ctx context.Context, messageID, userID, spaceID uuid.UUID, ids []uuid.UUID) ([]*modelDB.AttachmentDB, error)
+	GetPendingIDs(ctx context.Context) ([]string, error)
+	UpdateStatus(ctx context.Context, id, status string) error
+	UpdateImageMetadata(ctx context.Context, attachment *modelDB.AttachmentDB) error
+	CreateThumbnail(ctx context.Context, thumbnail *modelDB.AttachmentThumbnailDB) error
+	GetThumbnails(ctx context.Context, attachmentID string) ([]*modelDB.AttachmentThumbnailDB, error)
+	GetThumbnail(ctx context.Context, attachmentID string, width int) (*modelDB.AttachmentThumbnailDB, error)
+}
+
+type messageUpdaterInterface interface {
+	PublishMessageUpdated(ctx context.Context, messageID string) error
+}
+
+type jobQueueInterface interface {
+	Enqueue(payload string) error
+	EnqueueWait(ctx context.Context, payload string) error
+}
+
+type UcAttachment struct {
+	cfg            *config.Config
+	repoAttachment repoAttachmentInterface
+	repoSpace      repoSpaceInterface
+	store          blobstore.BlobStore
+	imageQueue     jobQueueInterface
+	messages       messageUpdaterInterface
+	zlog           zerolog.Logger
+}
+
+func NewAttachmentUseCase(cfg *config.Config, repoAttachment repoAttachmentInterface, repoSpace repoSpaceInterface, store blobstore.BlobStore, imageQueue jobQueueInterface, messages messageUpdaterInterface, zlog zerolog.Logger) *UcAttachment {
+	return &UcAttachment{
+		cfg:            cfg,
+		repoAttachment: repoAttachment,
+		repoSpace:      repoSpace,
+		store:          store,
+		imageQueue:     imageQueue,
+		messages:       messages,
+		zlog:           zlog,
+	}
+}
+
+func (uc *UcAttachment) UploadAttachment(ctx context.Context, spaceID string, file graphql.Upload) (*model.Attachment, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.UploadAttachmentAs(ctx, userID, spaceID, file)
+}
+
+// UploadAttachmentAs stores a pending attachment on behalf of userID, who
+// must be a member of the space.
+func (uc *UcAttachment) UploadAttachmentAs(ctx context.Context, userID, spaceID string, file graphql.Upload) (*model.Attachment, error) {
+	_, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := uc.attachmentPolicy(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Size <= 0 || file.Size > int64(policy.MaxSize) {
+		return nil, constant.ErrAttachmentTooLarge
+	}
+
+	contentType, err := sniffContentType(file.File)
+	if err != nil {
+		return nil, err
+	}
+
+	if !mimeAllowed(contentType, policy.MimeTypes) {
+		return nil, constant.ErrAttachmentTypeNotAllowed
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &modelDB.AttachmentDB{
+		SpaceID:     *spaceUUID,
+		UserID:      *userUUID,
+		StorageKey:  fmt.Sprintf("spaces/%s/%s", spaceUUID, uuid.New()),
+		Filename:    sanitizeFilename(file.Filename),
+		ContentType: contentType,
+		Size:        file.Size,
+		Status:      constant.ATTACHMENT_STATUS_READY,
+	}
+
+	if strings.HasPrefix(contentType, "image/") {
+		payload.Status = constant.ATTACHMENT_STATUS_PENDING
+	}
+
+	err = uc.store.Put(ctx, payload.StorageKey, file.File, file.Size, contentType)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("attachment"), err)
+	}
+
+	_, err = uc.repoAttachment.Create(ctx, payload)
+	if err != nil {
+		if delErr := uc.store.Delete(ctx, payload.StorageKey); delErr != nil {
+			uc.zlog.Error().Err(delErr).Str("key", payload.StorageKey).Msg("failed to remove orphan attachment blob")
+		}
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("attachment"), err)
+	}
+
+	if payload.Status == constant.ATTACHMENT_STATUS_PENDING {
+		if err := uc.imageQueue.Enqueue(payload.ID.String()); err != nil {
+			// stays pending and is picked up again by EnqueuePendingImages
+			uc.zlog.Warn().Err(err).Str("attachment", payload.ID.String()).Msg("failed to enqueue image processing")
+		}
+	}
+
+	resp := toAttachmentModel(payload)
+	resp.URL = signAttachmentURL(uc.cfg, resp.ID, "", userID)
+
+	return resp, nil
+}
+
+// ProcessImage is the image queue handler. It strips metadata from the
+// original, renders thumbnails and records dimensions and a blurhash. If
+// the image was already sent, its message is announced as updated either
+// way, so clients replace the placeholder.
+func (uc *UcAttachment) ProcessImage(ctx context.Context, attachmentID string) error {
+	attachment, err := uc.repoAttachment.GetByID(ctx, attachmentID)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrGetField("attachment"), err)
+	}
+
+	if attachment.Status != constant.ATTACHMENT_STATUS_PENDING {
+		return nil
+	}
+
+	err = uc.processImage(ctx, attachment)
+	if err != nil {
+		if statusErr := uc.repoAttachment.UpdateStatus(ctx, attachmentID, constant.ATTACHMENT_STATUS_FAILED); statusErr != nil {
+			uc.zlog.Error().Err(statusErr).Str("attachment", attachmentID).Msg("failed to mark attachment as failed")
+		}
+	}
+
+	uc.announceProcessed(ctx, attachmentID)
+
+	return err
+}
+
+// announceProcessed publishes MESSAGE_UPDATED for the message the
+// attachment belongs to. The attachment is read again since it may have
+// been sent while it was processed.
+func (uc *UcAttachment) announceProcessed(ctx context.Context, attachmentID string) {
+	attachment, err := uc.repoAttachment.GetByID(ctx, attachmentID)
+	if err != nil {
+		uc.zlog.Error().Err(err).Str("attachment", attachmentID).Msg("failed to load processed attachment")
+		return
+	}
+
+	if attachment.MessageID == nil {
+		return
+	}
+
+	_ = uc.messages.PublishMessageUpdated(ctx, attachment.MessageID.String())
+}
+
+func (uc *UcAttachment) processImage(ctx context.Context, attachment *modelDB.AttachmentDB) error {
+	body, err := uc.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	result, err := imaging.Process(body, constant.THUMBNAIL_SIZES)
+	if err != nil {
+		return err
+	}
+
+	if result.Original != nil {
+		err = uc.store.Put(ctx, attachment.StorageKey, bytes.NewReader(result.Original.Data), int64(len(result.Original.Data)), result.Original.ContentType)
+		if err != nil {
+			return err
+		}
+		attachment.Size = int64(len(result.Original.Data))
+	}
+
+	for _, t := range result.Thumbnails {
+		thumbnail := &modelDB.AttachmentThumbnailDB{
+			AttachmentID: attachment.ID,
+			Width:        t.Width,
+			Height:       t.Height,
+			StorageKey:   fmt.Sprintf("%s_%dw", attachment.StorageKey, t.Width),
+			ContentType:  t.ContentType,
+			Size:         int64(len(t.Data)),
+		}
+
+		err = uc.store.Put(ctx, thumbnail.StorageKey, bytes.NewReader(t.Data), thumbnail.Size, thumbnail.ContentType)
+		if err != nil {
+			return err
+		}
+
+		err = uc.repoAttachment.CreateThumbnail(ctx, thumbnail)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrCreatingField("thumbnail"), err)
+		}
+	}
+
+	attachment.Width = &result.Width
+	attachment.Height = &result.Height
+	attachment.BlurHash = &result.BlurHash
+	attachment.Status = constant.ATTACHMENT_STATUS_READY
+
+	err = uc.repoAttachment.UpdateImageMetadata(ctx, attachment)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrUpdatingField("attachment"), err)
+	}
+
+	return nil
+}
+
+// EnqueuePendingImages re-queues images whose processing was interrupted,
+// e.g. by a restart, since the in-process queue does not survive one.
+func (uc *UcAttachment) EnqueuePendingImages(ctx context.Context) error {
+	ids, err := uc.repoAttachment.GetPendingIDs(ctx)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrGetField("pending attachments"), err)
+	}
+
+	for _, id := range ids {
+		if err := uc.imageQueue.EnqueueWait(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *UcAttachment) AttachmentPolicy(ctx context.Context, spaceID string) (*model.AttachmentPolicy, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.attachmentPolicy(ctx, spaceID)
+}
+
+// attachmentPolicy resolves the effective limits of a space, falling back
+// to the server defaults.
+func (uc *UcAttachment) attachmentPolicy(ctx context.Context, spaceID string) (*model.AttachmentPolicy, error) {
+	policy, err := uc.repoSpace.GetAttachmentPolicy(ctx, spaceID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("attachment policy"), err)
+	}
+
+	resp := &model.AttachmentPolicy{
+		MaxSize:   int(uc.serverMaxSize()),
+		MimeTypes: uc.serverMimeTypes(),
+	}
+
+	if policy.MaxSize != nil {
+		resp.MaxSize = int(*policy.MaxSize)
+	}
+
+	if len(policy.MimeTypes) > 0 {
+		resp.MimeTypes = policy.MimeTypes
+	}
+
+	return resp, nil
+}
+
+func (uc *UcAttachment) UpdateAttachmentPolicy(ctx context.Context, spaceID string, request model.AttachmentPolicyRequest) (*model.AttachmentPolicy, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	payload := &modelDB.AttachmentPolicyDB{}
+
+	if request.MaxSize != nil {
+		maxSize := int64(*request.MaxSize)
+		if maxSize <= 0 || maxSize > uc.serverMaxSize() {
+			return nil, constant.ErrAttachmentPolicyInvalid
+		}
+		payload.MaxSize = &maxSize
+	}
+
+	for _, m := range request.MimeTypes {
+		m = strings.ToLower(strings.TrimSpace(m))
+		if m != "" {
+			payload.MimeTypes = append(payload.MimeTypes, m)
+		}
+	}
+
+	err = uc.repoSpace.UpdateAttachmentPolicy(ctx, spaceID, payload)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrUpdatingField("attachment policy"), err)
+	}
+
+	return uc.AttachmentPolicy(ctx, spaceID)
+}
+
+// OpenAttachment validates a signed download link and streams the blob back.
+// Membership is checked again at download time so a link stops working once
+// the user it was issued to leaves the space.
+//
+// variant selects a thumbnail by width; an empty variant is the original.
+// The returned attachment describes the variant being streamed.
+func (uc *UcAttachment) OpenAttachment(ctx context.Context, id, variant, userID string, expires int64, signature string) (*modelDB.AttachmentDB, io.ReadCloser, error) {
+	if time.Now().Unix() > expires {
+		return nil, nil, constant.ErrInvalidSignature
+	}
+
+	if !signer.Verify(uc.cfg.Settings.JWTSecret, signature, id, variant, userID, strconv.FormatInt(expires, 10)) {
+		return nil, nil, constant.ErrInvalidSignature
+	}
+
+	attachment, err := uc.repoAttachment.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, constant.ErrAttachmentNotFound
+		}
+		return nil, nil, constant.ErrWithMsg(constant.ErrGetField("attachment"), err)
+	}
+
+	// Images are only served once processing has stripped their metadata.
+	if attachment.Status != constant.ATTACHMENT_STATUS_READY {
+		return nil, nil, constant.ErrAttachmentNotFound
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, attachment.SpaceID.String(), userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if variant != "" {
+		width, err := strconv.Atoi(variant)
+		if err != nil {
+			return nil, nil, constant.ErrAttachmentNotFound
+		}
+
+		thumbnail, err := uc.repoAttachment.GetThumbnail(ctx, id, width)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, constant.ErrAttachmentNotFound
+			}
+			return nil, nil, constant.ErrWithMsg(constant.ErrGetField("thumbnail"), err)
+		}
+
+		attachment.StorageKey = thumbnail.StorageKey
+		attachment.ContentType = thumbnail.ContentType
+		attachment.Size = thumbnail.Size
+	}
+
+	body, err := uc.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, nil, constant.ErrAttachmentNotFound
+		}
+		return nil, nil, constant.ErrWithMsg(constant.ErrGetField("attachment"), err)
+	}
+
+	return attachment, body, nil
+}
+
+func (uc *UcAttachment) serverMaxSize() int64 {
+	if uc.cfg.Storage.MaxSize > 0 {
+		return uc.cfg.Storage.MaxSize
+	}
+
+	return constant.DEFAULT_ATTACHMENT_MAX_SIZE
+}
+
+func (uc *UcAttachment) serverMimeTypes() []string {
+	var mimeTypes []string
+	for _, m := range strings.Split(uc.cfg.Storage.AllowedMimeTypes, ",") {
+		m = strings.ToLower(strings.TrimSpace(m))
+		if m != "" {
+			mimeTypes = append(mimeTypes, m)
+		}
+	}
+
+	if len(mimeTypes) == 0 {
+		return constant.DEFAULT_ATTACHMENT_MIME_TYPES
+	}
+
+	return mimeTypes
+}
+
+func toAttachmentModel(a *modelDB.AttachmentDB) *model.Attachment {
+	resp := &model.Attachment{
+		ID:          a.ID.String(),
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        int(a.Size),
+		Status:      model.AttachmentStatus(strings.ToUpper(a.Status)),
+		Blurhash:    a.BlurHash,
+		Thumbnails:  []*model.AttachmentThumbnail{},
+		CreatedAt:   a.CreatedAt,
+	}
+
+	if a.Width != nil && a.Height != nil {
+		width, height := int32(*a.Width), int32(*a.Height)
+		resp.Width = &width
+		resp.Height = &height
+	}
+
+	return resp
+}
+
+func toAttachmentThumbnailModel(t *modelDB.AttachmentThumbnailDB) *model.AttachmentThumbnail {
+	return &model.AttachmentThumbnail{
+		Width:       int32(t.Width),
+		Height:      int32(t.Height),
+		ContentType: t.ContentType,
+	}
+}
+
+func signAttachmentURL(cfg *config.Config, attachmentID, variant, userID string) *string {
+	expiry := cfg.Storage.URLExpiry
+	if expiry <= 0 {
+		expiry = constant.DEFAULT_ATTACHMENT_URL_EXPIRY
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(time.Duration(expiry)*time.Second).Unix(), 10)
+	query := url.Values{}
+	if variant != "" {
+		query.Set("thumb", variant)
+	}
+	query.Set("uid", userID)
+	query.Set("exp", expires)
+	query.Set("sig", signer.Sign(cfg.Settings.JWTSecret, attachmentID, variant, userID, expires))
+
+	link := "/attachments/" + attachmentID + "?" + query.Encode()
+
+	return &link
+}
+
+func sniffContentType(file io.ReadSeeker) (string, error) {
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	if err != nil {
+		return "application/octet-stream", nil
+	}
+
+	return mediaType, nil
+}
+
+func mimeAllowed(contentType string, allowed []string) bool {
+	for _, a := range allowed {
+		if a == "*/*" || a == contentType {
+			return true
+		}
+
+		if strings.HasSuffix(a, "/*") && strings.HasPrefix(contentType, strings.TrimSuffix(a, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sanitizeFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		return "file"
+	}
+
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+
+	return name
+}
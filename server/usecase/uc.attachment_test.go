@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/blobstore"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func TestOpenAttachmentSignedURL(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{Settings: config.Settings{JWTSecret: "secret"}}
+
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spaceID, member, outsider := uuid.New(), uuid.NewString(), uuid.NewString()
+	ready := &modelDB.AttachmentDB{ID: uuid.New(), SpaceID: spaceID, StorageKey: "attachments/ready", Status: constant.ATTACHMENT_STATUS_READY}
+	pending := &modelDB.AttachmentDB{ID: uuid.New(), SpaceID: spaceID, StorageKey: "attachments/pending", Status: constant.ATTACHMENT_STATUS_PENDING}
+
+	for _, a := range []*modelDB.AttachmentDB{ready, pending} {
+		err := store.Put(ctx, a.StorageKey, strings.NewReader("data"), 4, "text/plain")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	uc := &UcAttachment{
+		cfg: cfg,
+		repoAttachment: &fakeRepoAttachment{attachments: map[string]*modelDB.AttachmentDB{
+			ready.ID.String():   ready,
+			pending.ID.String(): pending,
+		}},
+		repoSpace: &fakeRepoSpace{roles: map[[2]string]string{{spaceID.String(), member}: constant.ROLE_MEMBER}},
+		store:     store,
+		zlog:      zerolog.Nop(),
+	}
+
+	// link signs a URL for userID and returns the parameters the download
+	// handler would pass on.
+	link := func(id, userID string) (string, int64, string) {
+		u, err := url.Parse(*signAttachmentURL(cfg, id, "", userID))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		q := u.Query()
+		expires, err := strconv.ParseInt(q.Get("exp"), 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return q.Get("uid"), expires, q.Get("sig")
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		open    func(id string) (string, int64, string)
+		wantErr error
+	}{
+		{
+			name: "valid",
+			id:   ready.ID.String(),
+			open: func(id string) (string, int64, string) { return link(id, member) },
+		},
+		{
+			name: "expired",
+			id:   ready.ID.String(),
+			open: func(id string) (string, int64, string) {
+				uid, _, sig := link(id, member)
+				return uid, 1, sig
+			},
+			wantErr: constant.ErrInvalidSignature,
+		},
+		{
+			name: "tampered signature",
+			id:   ready.ID.String(),
+			open: func(id string) (string, int64, string) {
+				uid, expires, _ := link(id, member)
+				return uid, expires, "tampered"
+			},
+			wantErr: constant.ErrInvalidSignature,
+		},
+		{
+			name: "signed for someone else",
+			id:   ready.ID.String(),
+			open: func(id string) (string, int64, string) {
+				_, expires, sig := link(id, member)
+				return outsider, expires, sig
+			},
+			wantErr: constant.ErrInvalidSignature,
+		},
+		{
+			name:    "non-member",
+			id:      ready.ID.String(),
+			open:    func(id string) (string, int64, string) { return link(id, outsider) },
+			wantErr: constant.ErrNotSpaceMember,
+		},
+		{
+			name:    "still processing",
+			id:      pending.ID.String(),
+			open:    func(id string) (string, int64, string) { return link(id, member) },
+			wantErr: constant.ErrAttachmentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid, expires, sig := tt.open(tt.id)
+
+			_, body, err := uc.OpenAttachment(ctx, tt.id, "", uid, expires, sig)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OpenAttachment() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+			defer body.Close()
+
+			data, err := io.ReadAll(body)
+			if err != nil || string(data) != "data" {
+				t.Fatalf("OpenAttachment() body = %q, %v, want %q", data, err, "data")
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"bytes"
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/helper"
+	"chatspace-server/pkg/signer"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type repoIncomingWebhookInterface interface {
+	Create(ctx context.Context, webhook *modelDB.IncomingWebhookDB, bot *modelDB.UserDB) error
+	Revoke(ctx context.Context, webhook *modelDB.IncomingWebhookDB) error
+	GetByID(ctx context.Context, id string) (*modelDB.IncomingWebhookDB, error)
+	GetBySpaceID(ctx context.Context, spaceID string) ([]*modelDB.IncomingWebhookDB, error)
+	HitRateLimit(ctx context.Context, id string, window time.Duration) (int64, error)
+}
+
+type attachmentUploaderInterface interface {
+	UploadAttachmentAs(ctx context.Context, userID, spaceID string, file graphql.Upload) (*model.Attachment, error)
+}
+
+type UcIncomingWebhook struct {
+	cfg                 *config.Config
+	repoIncomingWebhook repoIncomingWebhookInterface
+	repoSpace           repoSpaceInterface
+	ucMessage           messageSenderInterface
+	ucAttachment        attachmentUploaderInterface
+	zlog                zerolog.Logger
+}
+
+func NewIncomingWebhookUseCase(
+	cfg *config.Config,
+	repoIncomingWebhook repoIncomingWebhookInterface,
+	repoSpace repoSpaceInterface,
+	ucMessage messageSenderInterface,
+	ucAttachment attachmentUploaderInterface,
+	zlog zerolog.Logger,
+) *UcIncomingWebhook {
+	return &UcIncomingWebhook{
+		cfg:                 cfg,
+		repoIncomingWebhook: repoIncomingWebhook,
+		repoSpace:           repoSpace,
+		ucMessage:           ucMessage,
+		ucAttachment:        ucAttachment,
+		zlog:                zlog,
+	}
+}
+
+// CreateIncomingWebhook creates a bot identity for the space and returns
+// the secret URL external systems post to. The URL is only returned here.
+func (uc *UcIncomingWebhook) CreateIncomingWebhook(ctx context.Context, spaceID string, name string, avatar *string) (*model.IncomingWebhookRegistration, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	name, avatarURL, err := botProfile(name, avatar)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := signer.NewSecret()
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("incoming webhook token"), err)
+	}
+
+	webhook := &modelDB.IncomingWebhookDB{
+		SpaceID:   *spaceUUID,
+		TokenHash: hashWebhookToken(token),
+		CreatedBy: *userUUID,
+		Name:      name,
+		AvatarURL: avatarURL,
+	}
+
+	bot := &modelDB.UserDB{
+		Email:     "incoming-webhook+" + uuid.NewString() + "@" + constant.BOT_EMAIL_DOMAIN,
+		Name:      name,
+		AvatarURL: avatarURL,
+	}
+
+	err = uc.repoIncomingWebhook.Create(ctx, webhook, bot)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("incoming webhook"), err)
+	}
+
+	return &model.IncomingWebhookRegistration{
+		Webhook: toIncomingWebhookModel(webhook),
+		URL:     strings.TrimRight(uc.cfg.Server.PublicURL, "/") + constant.INCOMING_WEBHOOK_PATH + webhook.ID.String() + "/" + token,
+	}, nil
+}
+
+func (uc *UcIncomingWebhook) RevokeIncomingWebhook(ctx context.Context, id string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = helper.StrToUUID(id)
+	if err != nil {
+		return false, err
+	}
+
+	webhook, err := uc.repoIncomingWebhook.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, constant.ErrIncomingWebhookNotFound
+		}
+		return false, constant.ErrWithMsg(constant.ErrGetField("incoming webhook"), err)
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, webhook.SpaceID.String(), userID)
+	if err != nil {
+		return false, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return false, constant.ErrNotSpaceAdmin
+	}
+
+	err = uc.repoIncomingWebhook.Revoke(ctx, webhook)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrUpdatingField("incoming webhook"), err)
+	}
+
+	return true, nil
+}
+
+func (uc *UcIncomingWebhook) IncomingWebhooks(ctx context.Context, spaceID string) ([]*model.IncomingWebhook, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	webhooks, err := uc.repoIncomingWebhook.GetBySpaceID(ctx, spaceID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("incoming webhooks"), err)
+	}
+
+	resp := []*model.IncomingWebhook{}
+	for _, w := range webhooks {
+		resp = append(resp, toIncomingWebhookModel(w))
+	}
+
+	return resp, nil
+}
+
+// PostMessage is the handler behind the incoming webhook URL. It checks
+// the token and rate limit, uploads any attachments as the bot and sends
+// the message through the regular send path.
+func (uc *UcIncomingWebhook) PostMessage(ctx context.Context, id, token string, payload *modelDB.IncomingWebhookPayload) (*model.Message, error) {
+	_, err := helper.StrToUUID(id)
+	if err != nil {
+		return nil, constant.ErrIncomingWebhookNotFound
+	}
+
+	webhook, err := uc.repoIncomingWebhook.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrIncomingWebhookNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("incoming webhook"), err)
+	}
+
+	if webhook.RevokedAt != nil || subtle.ConstantTimeCompare([]byte(hashWebhookToken(token)), []byte(webhook.TokenHash)) != 1 {
+		return nil, constant.ErrIncomingWebhookNotFound
+	}
+
+	hits, err := uc.repoIncomingWebhook.HitRateLimit(ctx, id, constant.INCOMING_WEBHOOK_RATE_WINDOW)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("rate limit"), err)
+	}
+
+	if hits > constant.INCOMING_WEBHOOK_RATE_LIMIT {
+		return nil, constant.ErrRateLimited
+	}
+
+	if strings.TrimSpace(payload.Text) == "" && len(payload.Attachments) == 0 {
+		return nil, constant.ErrEmptyMessage
+	}
+
+	if len(payload.Attachments) > constant.INCOMING_WEBHOOK_MAX_ATTACHMENTS {
+		return nil, constant.ErrTooManyAttachments
+	}
+
+	botID := webhook.BotUserID.String()
+	spaceID := webhook.SpaceID.String()
+
+	attachmentIDs := []string{}
+	for _, a := range payload.Attachments {
+		attachment, err := uc.ucAttachment.UploadAttachmentAs(ctx, botID, spaceID, graphql.Upload{
+			File:     bytes.NewReader(a.Data),
+			Filename: a.Filename,
+			Size:     int64(len(a.Data)),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentIDs = append(attachmentIDs, attachment.ID)
+	}
+
+	format := model.MessageFormatPlain
+	if payload.Markdown {
+		format = model.MessageFormatMarkdown
+	}
+
+	return uc.ucMessage.SendMessageAs(ctx, botID, spaceID, payload.Text, &format, attachmentIDs, nil)
+}
+
+func hashWebhookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func toIncomingWebhookModel(w *modelDB.IncomingWebhookDB) *model.IncomingWebhook {
+	return &model.IncomingWebhook{
+		ID:        w.ID.String(),
+		SpaceID:   w.SpaceID.String(),
+		Name:      w.Name,
+		AvatarURL: w.AvatarURL,
+		Bot: &model.User{
+			ID:        w.BotUserID.String(),
+			Name:      w.Name,
+			Bot:       true,
+			AvatarURL: w.AvatarURL,
+		},
+		CreatedAt: w.CreatedAt,
+		RevokedAt: w.RevokedAt,
+	}
+}
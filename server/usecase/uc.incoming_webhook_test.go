@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/blobstore"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 80, 60))
+	for x := range 80 {
+		for y := range 60 {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestIncomingWebhookPostsImage(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{Settings: config.Settings{JWTSecret: "secret"}}
+
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spaceID, botID := uuid.New(), uuid.New()
+	webhook := &modelDB.IncomingWebhookDB{ID: uuid.New(), SpaceID: spaceID, BotUserID: botID, TokenHash: hashWebhookToken("token")}
+
+	repoSpace := &fakeRepoSpace{roles: map[[2]string]string{{spaceID.String(), botID.String()}: constant.ROLE_MEMBER}}
+	repoMessage := &fakeRepoMessage{}
+	repoAttachment := &fakeRepoAttachment{}
+	imageQueue := &fakeQueue{}
+
+	ucMessage := NewMessageUseCase(cfg, repoMessage, &fakeRepoUser{}, repoSpace, repoAttachment, &fakeRepoPoll{}, store,
+		&fakeQueue{}, nil, nil, &fakeWebhooks{}, fakeOutbox{}, fakeTxManager{}, zerolog.Nop())
+	ucAttachment := NewAttachmentUseCase(cfg, repoAttachment, repoSpace, store, imageQueue, ucMessage, zerolog.Nop())
+	uc := NewIncomingWebhookUseCase(cfg, &fakeRepoIncomingWebhook{webhooks: map[string]*modelDB.IncomingWebhookDB{
+		webhook.ID.String(): webhook,
+	}}, repoSpace, ucMessage, ucAttachment, zerolog.Nop())
+
+	message, err := uc.PostMessage(ctx, webhook.ID.String(), "token", &modelDB.IncomingWebhookPayload{
+		Text:        "deploy finished",
+		Attachments: []*modelDB.IncomingWebhookAttachment{{Filename: "graph.png", Data: testPNG(t)}},
+	})
+	if err != nil {
+		t.Fatalf("PostMessage() error = %v", err)
+	}
+
+	if len(message.Attachments) != 1 || message.Attachments[0].Status != model.AttachmentStatusPending {
+		t.Fatalf("PostMessage() attachments = %+v, want one pending placeholder", message.Attachments)
+	}
+
+	if len(imageQueue.payloads) != 1 {
+		t.Fatalf("queued %d images for processing, want 1", len(imageQueue.payloads))
+	}
+
+	err = ucAttachment.ProcessImage(ctx, imageQueue.payloads[0])
+	if err != nil {
+		t.Fatalf("ProcessImage() error = %v", err)
+	}
+
+	if len(repoMessage.published) != 1 {
+		t.Fatalf("published %d space events, want 1", len(repoMessage.published))
+	}
+
+	event := repoMessage.published[0]
+	if event.Type != model.SpaceEventTypeMessageUpdated || event.Message == nil || event.Message.ID != message.ID {
+		t.Fatalf("published %s for %+v, want MESSAGE_UPDATED for message %s", event.Type, event.Message, message.ID)
+	}
+
+	got := event.Message.Attachments
+	if len(got) != 1 || got[0].Status != model.AttachmentStatusReady || got[0].Width == nil || *got[0].Width != 80 {
+		t.Fatalf("updated attachments = %+v, want one ready 80px wide image", got)
+	}
+}
@@ -2,47 +2,258 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"html"
+	"chatspace-server/config"
 	"chatspace-server/constant"
 	"chatspace-server/graph/model"
 	modelDB "chatspace-server/model"
 	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/blobstore"
+	"chatspace-server/pkg/broker"
 	"chatspace-server/pkg/gqlhelper"
 	"chatspace-server/pkg/helper"
+	"chatspace-server/pkg/richtext"
+	"chatspace-server/pkg/slashcmd"
+	"chatspace-server/pkg/unfurl"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/go-redis/redis/v8"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 )
 
 type repoMessageInterface interface {
-	Create(ctx context.Context, message *modelDB.MessageDB) (*string, error)
-	GetMessages(ctx context.Context) ([]*modelDB.MessageDB, error)
+	Create(ctx context.Context, message *modelDB.MessageDB, announce func(message *modelDB.MessageDB) (*modelDB.OutboxDB, error)) (bool, error)
+	Announce(ctx context.Context, entry *modelDB.OutboxDB) error
+	GetByClientMessageID(ctx context.Context, userID, spaceID, clientMessageID string) (*modelDB.MessageDB, error)
+	GetMessages(ctx context.Context, params *modelDB.MessageListParams) ([]*modelDB.MessageDB, error)
 	PublishMessage(ctx context.Context, spaceID string, data []byte) error
-	SubscribeMessage(ctx context.Context, spaceID string) *redis.PubSub
+	SubscribeMessage(ctx context.Context, spaceID, since string) (<-chan broker.Message, func() error, error)
+	SearchMessages(ctx context.Context, params *modelDB.MessageSearchParams) ([]*modelDB.MessageSearchDB, error)
+	GetMessageByID(ctx context.Context, id string) (*modelDB.MessageDB, error)
+	GetLinkPreview(ctx context.Context, url string) (*modelDB.LinkPreviewDB, error)
+	UpsertLinkPreview(ctx context.Context, preview *modelDB.LinkPreviewDB) error
+	LinkMessagePreviews(ctx context.Context, messageID string, urls []string) error
+	GetMessageLinkPreviews(ctx context.Context, messageID string) ([]*modelDB.LinkPreviewDB, error)
+	PinMessage(ctx context.Context, pin *modelDB.PinnedMessageDB, limit int) (bool, error)
+	UnpinMessage(ctx context.Context, messageID string) (bool, error)
+	IsPinned(ctx context.Context, messageID string) (bool, error)
+	AddReaction(ctx context.Context, reaction *modelDB.ReactionDB) (bool, error)
+	RemoveReaction(ctx context.Context, messageID, userID, emoji string) (bool, error)
+	GetReactions(ctx context.Context, messageID, viewerID string) ([]*modelDB.ReactionCountDB, error)
+	GetPinnedMessages(ctx context.Context, spaceID string) ([]*modelDB.MessageDB, error)
+	DeleteExpired(ctx context.Context, now time.Time, limit int) ([]*modelDB.DeletedMessageDB, error)
+	PurgeOlderThan(ctx context.Context, spaceID string, before time.Time, limit int) ([]*modelDB.DeletedMessageDB, error)
+	PurgeBeyondCount(ctx context.Context, spaceID string, keep, limit int) ([]*modelDB.DeletedMessageDB, error)
+}
+
+type linkFetcherInterface interface {
+	Fetch(ctx context.Context, rawURL string) (*unfurl.Preview, error)
+}
+
+type messagePublisherInterface interface {
+	PublishMessage(ctx context.Context, spaceID string, data []byte) error
+}
+
+type webhookDispatcherInterface interface {
+	Dispatch(ctx context.Context, event *model.SpaceEvent)
+}
+
+type commandRunnerInterface interface {
+	RunCommand(ctx context.Context, userID, spaceID, name, args string) (*slashcmd.Response, error)
 }
 
 type UcMessage struct {
-	repoMessage repoMessageInterface
-	repoUser    repoUserInterface
-	repoSpace   repoSpaceInterface
-	zlog        zerolog.Logger
+	cfg            *config.Config
+	repoMessage    repoMessageInterface
+	repoUser       repoUserInterface
+	repoSpace      repoSpaceInterface
+	repoAttachment repoAttachmentInterface
+	repoPoll       repoPollInterface
+	store          blobstore.BlobStore
+	unfurlQueue    jobQueueInterface
+	linkFetcher    linkFetcherInterface
+	commandRunner  commandRunnerInterface
+	webhooks       webhookDispatcherInterface
+	outbox         outboxNotifierInterface
+	txManager      txManagerInterface
+	zlog           zerolog.Logger
 }
 
-func NewMessageUseCase(repoMessage repoMessageInterface, repoUser repoUserInterface, repoSpace repoSpaceInterface, zlog zerolog.Logger) *UcMessage {
+func NewMessageUseCase(
+	cfg *config.Config,
+	repoMessage repoMessageInterface,
+	repoUser repoUserInterface,
+	repoSpace repoSpaceInterface,
+	repoAttachment repoAttachmentInterface,
+	repoPoll repoPollInterface,
+	store blobstore.BlobStore,
+	unfurlQueue jobQueueInterface,
+	linkFetcher linkFetcherInterface,
+	commandRunner commandRunnerInterface,
+	webhooks webhookDispatcherInterface,
+	outbox outboxNotifierInterface,
+	txManager txManagerInterface,
+	zlog zerolog.Logger,
+) *UcMessage {
 	return &UcMessage{
-		repoMessage: repoMessage,
-		repoUser:    repoUser,
-		repoSpace:   repoSpace,
-		zlog:        zlog,
+		cfg:            cfg,
+		repoMessage:    repoMessage,
+		repoUser:       repoUser,
+		repoSpace:      repoSpace,
+		repoAttachment: repoAttachment,
+		repoPoll:       repoPoll,
+		store:          store,
+		unfurlQueue:    unfurlQueue,
+		linkFetcher:    linkFetcher,
+		commandRunner:  commandRunner,
+		webhooks:       webhooks,
+		outbox:         outbox,
+		txManager:      txManager,
+		zlog:           zlog,
 	}
 }
 
-func (uc *UcMessage) SendMessage(ctx context.Context, spaceID string, content string) (*model.Message, error) {
+// SendMessage sends a message as the caller. A clientMessageID makes it
+// idempotent: a retry with the same ID returns the message the first
+// attempt stored instead of sending it again.
+func (uc *UcMessage) SendMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32, clientMessageID *string) (*model.Message, error) {
 	userID, err := authctx.GetAuthUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	nonce := ""
+	if clientMessageID != nil {
+		nonce = *clientMessageID
+	}
+
+	if len(nonce) > constant.CLIENT_MESSAGE_ID_MAX_LEN {
+		return nil, constant.ErrClientMessageIDTooLong
+	}
+
+	if nonce != "" {
+		existing, err := uc.sentMessage(ctx, userID, spaceID, nonce)
+		if existing != nil || err != nil {
+			return existing, err
+		}
+	}
+
+	if len(attachmentIDs) == 0 {
+		if name, args, ok := slashcmd.Parse(content); ok {
+			return uc.runCommand(ctx, userID, spaceID, name, args, expiresIn, nonce)
+		}
+	}
+
+	// "//" escapes a message that should start with a literal slash.
+	if strings.HasPrefix(content, "//") {
+		content = content[1:]
+	}
+
+	return uc.sendMessage(ctx, userID, spaceID, content, format, attachmentIDs, expiresIn, nonce)
+}
+
+// sentMessage returns the message userID already sent to the space with
+// the given client message ID, or nil when there is none. Background
+// senders have no GraphQL selection to populate, so they get the full
+// event payload.
+func (uc *UcMessage) sentMessage(ctx context.Context, userID, spaceID, clientMessageID string) (*model.Message, error) {
+	message, err := uc.repoMessage.GetByClientMessageID(ctx, userID, spaceID, clientMessageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("message"), err)
+	}
+
+	if !graphql.HasOperationContext(ctx) {
+		return uc.messageEventPayload(ctx, message)
+	}
+
+	return uc.PopulateMessageField(ctx, message)
+}
+
+// runCommand runs a slash command and turns its response into a message:
+// in-channel responses are sent as the invoker, ephemeral ones are only
+// returned to them.
+func (uc *UcMessage) runCommand(ctx context.Context, userID, spaceID, name, args string, expiresIn *int32, clientMessageID string) (*model.Message, error) {
+	resp, err := uc.commandRunner.RunCommand(ctx, userID, spaceID, name, args)
+	if err != nil {
+		return nil, err
+	}
+
+	format := toMessageFormatModel(resp.Format)
+	if resp.IsEphemeral() {
+		message, err := uc.EphemeralMessage(ctx, userID, spaceID, resp.Text, &format)
+		if err != nil {
+			return nil, err
+		}
+
+		message.ClientMessageID = helper.NilIfEmpty(clientMessageID)
+
+		return message, nil
+	}
+
+	return uc.sendMessage(ctx, userID, spaceID, resp.Text, &format, nil, expiresIn, clientMessageID)
+}
+
+// EphemeralMessage builds a message visible only to userID. It is never
+// stored or published to the space.
+func (uc *UcMessage) EphemeralMessage(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat) (*model.Message, error) {
+	messageFormat := model.MessageFormatPlain
+	if format != nil && format.IsValid() {
+		messageFormat = *format
+	}
+
+	blocks := uc.parseContent(ctx, content, messageFormat)
+
+	return &model.Message{
+		ID:           uuid.New().String(),
+		Content:      content,
+		Format:       messageFormat,
+		Blocks:       toMessageBlocksModel(blocks),
+		HTML:         richtext.RenderHTML(blocks),
+		Ephemeral:    true,
+		User:         &model.User{ID: userID},
+		Space:        &model.Space{ID: spaceID},
+		CreatedAt:    time.Now(),
+		Attachments:  []*model.Attachment{},
+		LinkPreviews: []*model.LinkPreview{},
+		Reactions:    []*model.Reaction{},
+	}, nil
+}
+
+// SendMessageAs stores and publishes a message on behalf of userID. It is
+// the send path shared by the API and background senders. The created
+// event goes through the outbox, so a stored message is always published
+// even if the broker is down or the process dies right after the insert.
+func (uc *UcMessage) SendMessageAs(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32) (*model.Message, error) {
+	return uc.sendMessage(ctx, userID, spaceID, content, format, attachmentIDs, expiresIn, "")
+}
+
+// SendMessageOnce is SendMessageAs for background senders that may retry
+// after a crash: clientMessageID identifies the send, so a retry returns
+// the message the first attempt stored instead of sending it again.
+func (uc *UcMessage) SendMessageOnce(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat, clientMessageID string) (*model.Message, error) {
+	existing, err := uc.sentMessage(ctx, userID, spaceID, clientMessageID)
+	if existing != nil || err != nil {
+		return existing, err
+	}
+
+	return uc.sendMessage(ctx, userID, spaceID, content, format, nil, nil, clientMessageID)
+}
+
+// sendMessage implements SendMessageAs. A non-empty clientMessageID is
+// stored with the message and echoed in its events; when a concurrent
+// retry stored it first, that message is returned instead.
+func (uc *UcMessage) sendMessage(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32, clientMessageID string) (*model.Message, error) {
 	userUUID, err := helper.StrToUUID(userID)
 	if err != nil {
 		return nil, err
@@ -53,46 +264,315 @@ func (uc *UcMessage) SendMessage(ctx context.Context, spaceID string, content st
 		return nil, err
 	}
 
-	payload := &modelDB.MessageDB{
-		Content: content,
-		UserID:  *userUUID,
-		SpaceID: *spaceUUID,
+	attachments, err := uc.pendingAttachments(ctx, userID, spaceID, attachmentIDs)
+	if err != nil {
+		return nil, err
 	}
 
-	msgID, err := uc.repoMessage.Create(ctx, payload)
+	ttl, err := uc.messageTTL(ctx, spaceID, expiresIn)
 	if err != nil {
-		return nil, constant.ErrWithMsg(constant.ErrCreatingField("message"), err)
+		return nil, err
 	}
 
-	resp := &model.Message{
-		ID:      *msgID,
-		Content: content,
-		User:    &model.User{ID: userUUID.String()},
-		Space:   &model.Space{ID: spaceUUID.String()},
+	messageFormat := model.MessageFormatPlain
+	if format != nil && format.IsValid() {
+		messageFormat = *format
 	}
 
-	data, err := json.Marshal(resp)
+	blocks := uc.parseContent(ctx, content, messageFormat)
+	blocksJSON, err := json.Marshal(blocks)
 	if err != nil {
 		uc.zlog.Error().Err(err).Msg(constant.ErrMsgMarshal)
 		return nil, err
 	}
 
-	err = uc.repoMessage.PublishMessage(ctx, spaceID, data)
+	payload := &modelDB.MessageDB{
+		ID:              uuid.New(),
+		Content:         content,
+		Format:          strings.ToLower(messageFormat.String()),
+		Blocks:          blocksJSON,
+		HTML:            richtext.RenderHTML(blocks),
+		UserID:          *userUUID,
+		SpaceID:         *spaceUUID,
+		ClientMessageID: helper.NilIfEmpty(clientMessageID),
+	}
+
+	if ttl > 0 {
+		expiresAt := time.Now().Add(time.Duration(ttl) * time.Second)
+		payload.ExpiresAt = &expiresAt
+	}
+
+	resp := &model.Message{
+		ID:              payload.ID.String(),
+		Content:         content,
+		Format:          messageFormat,
+		Blocks:          toMessageBlocksModel(blocks),
+		HTML:            payload.HTML,
+		ExpiresAt:       payload.ExpiresAt,
+		User:            &model.User{ID: userUUID.String()},
+		Space:           &model.Space{ID: spaceUUID.String()},
+		Attachments:     []*model.Attachment{},
+		LinkPreviews:    []*model.LinkPreview{},
+		Reactions:       []*model.Reaction{},
+		ClientMessageID: payload.ClientMessageID,
+	}
+
+	var attachmentUUIDs []uuid.UUID
+	statuses := map[uuid.UUID]string{}
+	for _, a := range attachments {
+		attachmentUUIDs = append(attachmentUUIDs, a.ID)
+		statuses[a.ID] = a.Status
+		resp.Attachments = append(resp.Attachments, toAttachmentModel(a))
+	}
+
+	event := &model.SpaceEvent{
+		Type:    model.SpaceEventTypeMessageCreated,
+		SpaceID: spaceID,
+		Message: resp,
+	}
+
+	announce := func(message *modelDB.MessageDB) (*modelDB.OutboxDB, error) {
+		seq := int(message.Seq)
+		resp.Seq = &seq
+		resp.CreatedAt = message.CreatedAt
+
+		entry, err := newOutboxEntry(spaceID, event)
+		if err != nil {
+			uc.zlog.Error().Err(err).Msg(constant.ErrMsgMarshal)
+			return nil, err
+		}
+
+		return entry, nil
+	}
+
+	var stored, processed bool
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		stored, err = uc.repoMessage.Create(ctx, payload, announce)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrCreatingField("message"), err)
+		}
+
+		if !stored || len(attachmentUUIDs) == 0 {
+			return nil
+		}
+
+		linked, err := uc.repoAttachment.LinkToMessage(ctx, payload.ID, *userUUID, *spaceUUID, attachmentUUIDs)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrUpdatingField("attachment"), err)
+		}
+
+		// Another message claimed an attachment since it was checked.
+		if len(linked) != len(attachmentUUIDs) {
+			return constant.ErrAttachmentNotFound
+		}
+
+		// An image finished processing after it was checked, possibly too
+		// early for the processor to see the message it now belongs to.
+		for _, a := range linked {
+			processed = processed || a.Status != statuses[a.ID]
+		}
+
+		return nil
+	})
 	if err != nil {
-		uc.zlog.Error().Err(err).Msg(constant.ErrMsgPublish)
 		return nil, err
 	}
 
+	if !stored {
+		return uc.sentMessage(ctx, userID, spaceID, clientMessageID)
+	}
+
+	// Callers such as CreatePoll may store the message as part of a larger
+	// unit of work, so nothing is announced until that commits.
+	uc.txManager.AfterCommit(ctx, func(ctx context.Context) {
+		uc.outbox.Notify()
+		uc.webhooks.Dispatch(ctx, event)
+		uc.notifyMentions(ctx, userID, spaceID, blocks, resp)
+
+		if len(unfurl.ExtractURLs(content, constant.LINK_PREVIEW_MAX_PER_MESSAGE)) > 0 {
+			if err := uc.unfurlQueue.Enqueue(resp.ID); err != nil {
+				uc.zlog.Warn().Err(err).Str("message", resp.ID).Msg("failed to enqueue link unfurling")
+			}
+		}
+
+		if processed {
+			_ = uc.PublishMessageUpdated(ctx, resp.ID)
+		}
+	})
+
+	uc.signAttachments(resp, userID)
+
 	return resp, nil
 }
 
-func (uc *UcMessage) Messages(ctx context.Context, spaceID string) ([]*model.Message, error) {
-	_, err := authctx.GetAuthUserID(ctx)
+// notifyMentions sends a MENTION event to each member of the space the
+// message mentions, except the sender and users who blocked the sender.
+func (uc *UcMessage) notifyMentions(ctx context.Context, userID, spaceID string, blocks []*richtext.Node, message *model.Message) {
+	for _, id := range richtext.Mentions(blocks) {
+		if id == userID {
+			continue
+		}
+
+		_, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, id)
+		if err != nil {
+			if !errors.Is(err, constant.ErrNotSpaceMember) {
+				uc.zlog.Error().Err(err).Str("user", id).Msg("failed to check mentioned user membership")
+			}
+			continue
+		}
+
+		blocked, err := uc.blockedUserSet(ctx, id)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("user", id).Msg("failed to load blocked users")
+			continue
+		}
+
+		if blocked[userID] {
+			continue
+		}
+
+		_ = uc.PublishUserEvent(ctx, id, &model.UserEvent{
+			Type:    model.UserEventTypeMention,
+			Message: message,
+		})
+	}
+}
+
+// messageTTL returns the lifetime in seconds for a new message, or 0 when
+// it does not expire. The space default applies when the sender gives none
+// and caps any lifetime the sender asks for.
+func (uc *UcMessage) messageTTL(ctx context.Context, spaceID string, expiresIn *int32) (int, error) {
+	ttl := 0
+	if expiresIn != nil {
+		if *expiresIn <= 0 || *expiresIn > constant.MAX_MESSAGE_TTL {
+			return 0, constant.ErrInvalidMessageTTL
+		}
+		ttl = int(*expiresIn)
+	}
+
+	spaceTTL, err := uc.repoSpace.GetMessageTTL(ctx, spaceID)
+	if err != nil {
+		return 0, constant.ErrWithMsg(constant.ErrGetField("space"), err)
+	}
+
+	if spaceTTL != nil && (ttl == 0 || *spaceTTL < ttl) {
+		ttl = *spaceTTL
+	}
+
+	return ttl, nil
+}
+
+// parseContent parses message content once at send time. Mentions are
+// resolved to the user's current name; unknown users stay as literal text.
+func (uc *UcMessage) parseContent(ctx context.Context, content string, format model.MessageFormat) []*richtext.Node {
+	blocks := richtext.Parse(content, strings.ToLower(format.String()))
+
+	names := map[string]string{}
+	for _, id := range richtext.Mentions(blocks) {
+		user, err := uc.repoUser.GetByID(ctx, id)
+		if err != nil {
+			continue
+		}
+		names[id] = user.Name
+	}
+
+	richtext.ResolveMentions(blocks, func(userID string) (string, bool) {
+		name, ok := names[userID]
+		return name, ok
+	})
+
+	return blocks
+}
+
+// pendingAttachments checks that every requested attachment was uploaded by
+// the sender to the same space and has not been sent with another message.
+// Images still being processed are linked as placeholders; the processor
+// publishes MESSAGE_UPDATED once they are ready.
+func (uc *UcMessage) pendingAttachments(ctx context.Context, userID, spaceID string, attachmentIDs []string) ([]*modelDB.AttachmentDB, error) {
+	var attachments []*modelDB.AttachmentDB
+	for _, id := range attachmentIDs {
+		attachment, err := uc.repoAttachment.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, constant.ErrAttachmentNotFound
+			}
+			return nil, constant.ErrWithMsg(constant.ErrGetField("attachment"), err)
+		}
+
+		if attachment.UserID.String() != userID || attachment.SpaceID.String() != spaceID || attachment.MessageID != nil {
+			return nil, constant.ErrAttachmentNotFound
+		}
+
+		if attachment.Status == constant.ATTACHMENT_STATUS_FAILED {
+			return nil, constant.ErrAttachmentFailed
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+// signAttachments fills in download links for the given viewer. Links are
+// left empty for anonymous viewers since downloads are membership-checked.
+func (uc *UcMessage) signAttachments(message *model.Message, userID string) {
+	for _, a := range message.Attachments {
+		a.URL = nil
+		if userID != "" {
+			a.URL = signAttachmentURL(uc.cfg, a.ID, "", userID)
+		}
+
+		for _, t := range a.Thumbnails {
+			t.URL = nil
+			if userID != "" {
+				t.URL = signAttachmentURL(uc.cfg, a.ID, strconv.Itoa(int(t.Width)), userID)
+			}
+		}
+	}
+}
+
+// Messages returns a page of a space's messages, newest first. before
+// and after are seqs: before pages back through history, after returns
+// the messages that follow it, e.g. to catch up after being offline. Only
+// members can read a space, since the result carries signed attachment
+// URLs.
+func (uc *UcMessage) Messages(ctx context.Context, spaceID string, first *int32, before, after *int) ([]*model.Message, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := uc.blockedUserSet(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	messages, err := uc.repoMessage.GetMessages(ctx)
+	params := &modelDB.MessageListParams{
+		SpaceID: *spaceUUID,
+		Limit:   helper.PageSize(first, constant.DEFAULT_PAGE_SIZE, constant.MAX_PAGE_SIZE),
+	}
+
+	if before != nil {
+		seq := int64(*before)
+		params.Before = &seq
+	}
+
+	if after != nil {
+		seq := int64(*after)
+		params.After = &seq
+	}
+
+	messages, err := uc.repoMessage.GetMessages(ctx, params)
 	if err != nil {
 		return nil, constant.ErrWithMsg(constant.ErrGetField("messages"), err)
 	}
@@ -101,56 +581,141 @@ func (uc *UcMessage) Messages(ctx context.Context, spaceID string) ([]*model.Mes
 	for _, m := range messages {
 		temp, err := uc.PopulateMessageField(ctx, m)
 		if err != nil {
-			uc.zlog.Error().Err(err)
+			uc.zlog.Error().Err(err).Str("message", m.ID.String()).Msg("failed to populate message")
+			continue
 		}
 
+		temp.FromBlockedUser = blocked[m.UserID.String()]
 		resp = append(resp, temp)
 	}
 
 	return resp, nil
 }
 
-func (uc *UcMessage) MessageSent(ctx context.Context, spaceID string) (<-chan *model.Message, error) {
+// MessageSent streams messages created in the space. With since set it
+// first replays the messages sent after that cursor.
+func (uc *UcMessage) MessageSent(ctx context.Context, spaceID string, since *string) (<-chan *model.Message, error) {
 	ch := make(chan *model.Message, 1)
 
-	pubsub := uc.repoMessage.SubscribeMessage(ctx, spaceID)
-	_, err := pubsub.Receive(ctx)
+	events, err := uc.subscribeSpaceEvents(ctx, spaceID, since)
 	if err != nil {
-		uc.zlog.Error().Err(err).Msg(constant.ErrMsgSubscribe)
-		_ = pubsub.Close()
 		close(ch)
 		return ch, err
 	}
 
-	chRedis := pubsub.Channel()
-
 	go func() {
-		defer func() {
-			_ = pubsub.Close()
-			close(ch)
-		}()
+		defer close(ch)
+
+		for event := range events {
+			if event.Type != model.SpaceEventTypeMessageCreated || event.Message == nil {
+				continue
+			}
 
-		for {
 			select {
+			case ch <- event.Message:
 			case <-ctx.Done():
 				return
-			case msg, ok := <-chRedis:
-				if !ok {
-					return
-				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (uc *UcMessage) SpaceEvents(ctx context.Context, spaceID string, since *string) (<-chan *model.SpaceEvent, error) {
+	return uc.subscribeSpaceEvents(ctx, spaceID, since)
+}
+
+// subscribeSpaceEvents relays events from the space stream, decorating
+// messages for the subscribing viewer (block flags, signed links) and
+// stamping each event with its cursor. Blocks are looked up per message,
+// so blocking someone takes effect on open subscriptions too. Like the
+// Messages query, it is limited to members of the space.
+func (uc *UcMessage) subscribeSpaceEvents(ctx context.Context, spaceID string, since *string) (<-chan *model.SpaceEvent, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.SpaceEvent, 1)
+
+	cursor := ""
+	if since != nil {
+		cursor = *since
+	}
+
+	entries, err := uc.subscribeChannel(ctx, spaceID, cursor)
+	if err != nil {
+		close(ch)
+		return ch, err
+	}
 
-				var message model.Message
-				err := json.Unmarshal([]byte(msg.Payload), &message)
+	send := func(event *model.SpaceEvent) bool {
+		if event.Message != nil {
+			if event.Message.User != nil {
+				blocked, err := uc.blockedUserSet(ctx, userID)
 				if err != nil {
-					uc.zlog.Error().Err(err).Msg(constant.ErrMsgUnmarshal)
+					uc.zlog.Error().Err(err).Str("user", userID).Msg("failed to load blocked users")
+				}
+				event.Message.FromBlockedUser = blocked[event.Message.User.ID]
+			}
+			uc.signAttachments(event.Message, userID)
+		}
+
+		select {
+		case ch <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(ch)
+
+		// lastSeq is the seq of the newest message relayed. Messages of a
+		// space are published in seq order, so a jump means the stream lost
+		// some; they are loaded from the database and relayed first.
+		lastSeq := 0
+
+		for entry := range entries {
+			var event model.SpaceEvent
+			err := json.Unmarshal(entry.Payload, &event)
+			if err != nil {
+				uc.zlog.Error().Err(err).Msg(constant.ErrMsgUnmarshal)
+				continue
+			}
+
+			id := entry.ID
+			event.Cursor = &id
+
+			if event.Message != nil {
+				event.Message.Cursor = &id
+			}
+
+			if event.Type == model.SpaceEventTypeMessageCreated && event.Message != nil && event.Message.Seq != nil {
+				seq := *event.Message.Seq
+				if seq <= lastSeq {
 					continue
 				}
 
-				select {
-				case ch <- &message:
-				default:
-					uc.zlog.Warn().Msg(constant.ErrMsgSubsFull)
+				if lastSeq > 0 && seq > lastSeq+1 {
+					for _, missed := range uc.missedMessages(ctx, spaceID, lastSeq, seq) {
+						if !send(&model.SpaceEvent{Type: model.SpaceEventTypeMessageCreated, SpaceID: spaceID, Message: missed}) {
+							return
+						}
+					}
 				}
+				lastSeq = seq
+			}
+
+			if !send(&event) {
+				return
 			}
 		}
 	}()
@@ -158,41 +723,1048 @@ func (uc *UcMessage) MessageSent(ctx context.Context, spaceID string) (<-chan *m
 	return ch, nil
 }
 
-func (uc *UcMessage) PopulateMessageField(ctx context.Context, message *modelDB.MessageDB) (*model.Message, error) {
-	resp := &model.Message{
-		ID:      message.ID.String(),
-		Content: message.Content,
+// missedMessages loads the messages of a space with a seq between after and
+// before, oldest first. Messages deleted meanwhile are simply absent; on
+// error it returns what it loaded so far.
+func (uc *UcMessage) missedMessages(ctx context.Context, spaceID string, after, before int) []*model.Message {
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil
 	}
 
-	if gqlhelper.IsCalled(ctx, "user") {
-		user, err := uc.repoUser.GetByID(ctx, message.UserID.String())
-		if err != nil {
-			return nil, constant.ErrUserNotFound
-		}
+	afterSeq, beforeSeq := int64(after), int64(before)
+	params := &modelDB.MessageListParams{
+		SpaceID: *spaceUUID,
+		Before:  &beforeSeq,
+		After:   &afterSeq,
+		Limit:   constant.MAX_PAGE_SIZE,
+	}
 
-		tempUser := &model.User{
-			ID:    user.ID.String(),
-			Email: user.Email,
-			Name:  user.Name,
+	var resp []*model.Message
+	for {
+		messages, err := uc.repoMessage.GetMessages(ctx, params)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("space", spaceID).Msg("failed to load messages missed by the stream")
+			return resp
 		}
 
-		resp.User = tempUser
-	}
+		for i := len(messages) - 1; i >= 0; i-- {
+			message, err := uc.messageEventPayload(ctx, messages[i])
+			if err != nil {
+				uc.zlog.Error().Err(err).Str("message", messages[i].ID.String()).Msg("failed to load message missed by the stream")
+				continue
+			}
 
-	if gqlhelper.IsCalled(ctx, "space") {
-		space, err := uc.repoSpace.GetSpaceByID(ctx, message.SpaceID.String())
-		if err != nil {
-			return nil, constant.ErrWithMsg(constant.ErrGetField("space"), err)
+			resp = append(resp, message)
 		}
 
-		tempSpace := &model.Space{
-			ID:          space.ID.String(),
-			Name:        space.Name,
-			Description: &space.Description,
+		if len(messages) < params.Limit {
+			return resp
 		}
 
-		resp.Space = tempSpace
+		afterSeq = messages[0].Seq
 	}
+}
 
-	return resp, nil
+// UserEvents streams events addressed to the authenticated user, such as
+// bookmark reminders.
+func (uc *UcMessage) UserEvents(ctx context.Context) (<-chan *model.UserEvent, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.UserEvent, 1)
+
+	entries, err := uc.subscribeChannel(ctx, userChannel(userID), "")
+	if err != nil {
+		close(ch)
+		return ch, err
+	}
+
+	go func() {
+		defer close(ch)
+
+		for entry := range entries {
+			var event model.UserEvent
+			err := json.Unmarshal(entry.Payload, &event)
+			if err != nil {
+				uc.zlog.Error().Err(err).Msg(constant.ErrMsgUnmarshal)
+				continue
+			}
+
+			if event.SavedMessage != nil && event.SavedMessage.Message != nil {
+				uc.signAttachments(event.SavedMessage.Message, userID)
+			}
+
+			if event.Message != nil {
+				uc.signAttachments(event.Message, userID)
+			}
+
+			select {
+			case ch <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (uc *UcMessage) PublishUserEvent(ctx context.Context, userID string, event *model.UserEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		uc.zlog.Error().Err(err).Msg(constant.ErrMsgMarshal)
+		return err
+	}
+
+	err = uc.repoMessage.PublishMessage(ctx, userChannel(userID), data)
+	if err != nil {
+		uc.zlog.Error().Err(err).Msg(constant.ErrMsgPublish)
+		return err
+	}
+
+	return nil
+}
+
+// subscribeChannel relays stream entries of a broker channel until ctx is
+// done or the subscription closes. Sends block, so a slow subscriber
+// holds back its own stream rather than losing entries.
+func (uc *UcMessage) subscribeChannel(ctx context.Context, channel, since string) (<-chan broker.Message, error) {
+	messages, closeSub, err := uc.repoMessage.SubscribeMessage(ctx, channel, since)
+	switch {
+	case errors.Is(err, broker.ErrInvalidCursor):
+		return nil, constant.ErrInvalidCursor
+	case errors.Is(err, broker.ErrCursorExpired):
+		return nil, constant.ErrCursorExpired
+	case err != nil:
+		uc.zlog.Error().Err(err).Msg(constant.ErrMsgSubscribe)
+		return nil, err
+	}
+
+	ch := make(chan broker.Message)
+
+	go func() {
+		defer func() {
+			_ = closeSub()
+			close(ch)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				select {
+				case ch <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (uc *UcMessage) PublishSpaceEvent(ctx context.Context, event *model.SpaceEvent) error {
+	return uc.publishEvent(ctx, event)
+}
+
+// QueueSpaceEvent records event in the outbox as part of the unit of work
+// in ctx and hands it to the space's webhooks once that commits, so the
+// event goes out if and only if the change it describes is stored.
+func (uc *UcMessage) QueueSpaceEvent(ctx context.Context, event *model.SpaceEvent) error {
+	entry, err := newOutboxEntry(event.SpaceID, event)
+	if err != nil {
+		uc.zlog.Error().Err(err).Msg(constant.ErrMsgMarshal)
+		return err
+	}
+
+	err = uc.repoMessage.Announce(ctx, entry)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrCreatingField("outbox entry"), err)
+	}
+
+	uc.txManager.AfterCommit(ctx, func(ctx context.Context) {
+		uc.outbox.Notify()
+		uc.webhooks.Dispatch(ctx, event)
+	})
+
+	return nil
+}
+
+func (uc *UcMessage) publishEvent(ctx context.Context, event *model.SpaceEvent) error {
+	err := publishSpaceEvent(ctx, uc.repoMessage, uc.webhooks, event)
+	if err != nil {
+		uc.zlog.Error().Err(err).Msg(constant.ErrMsgPublish)
+		return err
+	}
+
+	return nil
+}
+
+// publishSpaceEvent is the single place space events leave the server: it
+// queues the event for the space's webhooks and broadcasts it to
+// subscribers.
+func publishSpaceEvent(ctx context.Context, publisher messagePublisherInterface, webhooks webhookDispatcherInterface, event *model.SpaceEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	webhooks.Dispatch(ctx, event)
+
+	return publisher.PublishMessage(ctx, event.SpaceID, data)
+}
+
+// UnfurlLinks is the unfurl queue handler. It resolves previews for the
+// links in a message, reusing cached ones, and publishes MESSAGE_UPDATED
+// once at least one preview is available.
+func (uc *UcMessage) UnfurlLinks(ctx context.Context, messageID string) error {
+	message, err := uc.repoMessage.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrGetField("message"), err)
+	}
+
+	var urls []string
+	ready := false
+	for _, u := range unfurl.ExtractURLs(message.Content, constant.LINK_PREVIEW_MAX_PER_MESSAGE) {
+		preview, err := uc.linkPreview(ctx, u)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("url", u).Msg("failed to store link preview")
+			continue
+		}
+
+		urls = append(urls, u)
+		ready = ready || preview.Status == constant.LINK_PREVIEW_STATUS_OK
+	}
+
+	if len(urls) == 0 {
+		return nil
+	}
+
+	err = uc.repoMessage.LinkMessagePreviews(ctx, messageID, urls)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrCreatingField("link previews"), err)
+	}
+
+	if !ready {
+		return nil
+	}
+
+	return uc.publishMessageUpdated(ctx, message)
+}
+
+// PublishMessageUpdated announces the current state of a message, e.g.
+// once the images sent with it have been processed.
+func (uc *UcMessage) PublishMessageUpdated(ctx context.Context, messageID string) error {
+	message, err := uc.repoMessage.GetMessageByID(ctx, messageID)
+	if err != nil {
+		uc.zlog.Error().Err(err).Str("message", messageID).Msg("failed to load updated message")
+		return constant.ErrWithMsg(constant.ErrGetField("message"), err)
+	}
+
+	return uc.publishMessageUpdated(ctx, message)
+}
+
+func (uc *UcMessage) publishMessageUpdated(ctx context.Context, message *modelDB.MessageDB) error {
+	payload, err := uc.messageEventPayload(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	return uc.publishEvent(ctx, &model.SpaceEvent{
+		Type:    model.SpaceEventTypeMessageUpdated,
+		SpaceID: message.SpaceID.String(),
+		Message: payload,
+	})
+}
+
+func (uc *UcMessage) linkPreview(ctx context.Context, url string) (*modelDB.LinkPreviewDB, error) {
+	cached, err := uc.repoMessage.GetLinkPreview(ctx, url)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if cached != nil && time.Since(cached.FetchedAt) < constant.LINK_PREVIEW_TTL {
+		return cached, nil
+	}
+
+	preview := &modelDB.LinkPreviewDB{
+		URL:    url,
+		Status: constant.LINK_PREVIEW_STATUS_FAILED,
+	}
+
+	fetched, err := uc.linkFetcher.Fetch(ctx, url)
+	if err != nil {
+		uc.zlog.Debug().Err(err).Str("url", url).Msg("failed to fetch link preview")
+	} else {
+		preview.Status = constant.LINK_PREVIEW_STATUS_OK
+		preview.Title = fetched.Title
+		preview.Description = fetched.Description
+		preview.ImageURL = fetched.ImageURL
+		preview.SiteName = fetched.SiteName
+	}
+
+	err = uc.repoMessage.UpsertLinkPreview(ctx, preview)
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+func (uc *UcMessage) MessageEventPayload(ctx context.Context, message *modelDB.MessageDB) (*model.Message, error) {
+	return uc.messageEventPayload(ctx, message)
+}
+
+// messageEventPayload builds a message for publishing from a background
+// worker, where there is no GraphQL selection to decide what to load.
+func (uc *UcMessage) messageEventPayload(ctx context.Context, message *modelDB.MessageDB) (*model.Message, error) {
+	blocks, err := decodeMessageBlocks(message.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &model.Message{
+		ID:              message.ID.String(),
+		Content:         message.Content,
+		Format:          toMessageFormatModel(message.Format),
+		Blocks:          blocks,
+		HTML:            message.HTML,
+		ExpiresAt:       message.ExpiresAt,
+		User:            &model.User{ID: message.UserID.String()},
+		Space:           &model.Space{ID: message.SpaceID.String()},
+		CreatedAt:       message.CreatedAt,
+		Attachments:     []*model.Attachment{},
+		LinkPreviews:    []*model.LinkPreview{},
+		Reactions:       []*model.Reaction{},
+		ClientMessageID: message.ClientMessageID,
+	}
+
+	if message.Seq > 0 {
+		seq := int(message.Seq)
+		resp.Seq = &seq
+	}
+
+	attachments, err := uc.repoAttachment.GetByMessageID(ctx, resp.ID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("attachments"), err)
+	}
+
+	for _, a := range attachments {
+		resp.Attachments = append(resp.Attachments, toAttachmentModel(a))
+	}
+
+	previews, err := uc.repoMessage.GetMessageLinkPreviews(ctx, resp.ID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("link previews"), err)
+	}
+
+	for _, p := range previews {
+		resp.LinkPreviews = append(resp.LinkPreviews, toLinkPreviewModel(p))
+	}
+
+	poll, err := uc.repoPoll.GetByMessageID(ctx, resp.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("poll"), err)
+	}
+
+	if poll != nil {
+		resp.Poll, err = pollEventPayload(ctx, uc.repoPoll, poll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// RunReaper deletes expired messages until ctx is done.
+func (uc *UcMessage) RunReaper(ctx context.Context) {
+	ticker := time.NewTicker(constant.MESSAGE_REAPER_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		err := uc.ReapExpiredMessages(ctx)
+		if err != nil {
+			uc.zlog.Error().Err(err).Msg("failed to reap expired messages")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReapExpiredMessages hard-deletes expired messages in batches and tells
+// subscribers of each space so clients can drop them right away.
+func (uc *UcMessage) ReapExpiredMessages(ctx context.Context) error {
+	for {
+		deleted, err := uc.repoMessage.DeleteExpired(ctx, time.Now(), constant.MESSAGE_REAPER_BATCH)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrDeletingField("expired messages"), err)
+		}
+
+		uc.AnnounceDeleted(ctx, deleted)
+
+		if len(deleted) < constant.MESSAGE_REAPER_BATCH {
+			return nil
+		}
+	}
+}
+
+// AnnounceDeleted removes the attachment blobs of hard-deleted messages
+// and publishes MESSAGE_DELETED for each to the space and its webhooks.
+// The deletes have already committed, so a failure here only leaves an
+// orphaned blob or a client showing a message until its next refresh.
+func (uc *UcMessage) AnnounceDeleted(ctx context.Context, deleted []*modelDB.DeletedMessageDB) {
+	for _, m := range deleted {
+		id := m.ID.String()
+		for _, key := range m.StorageKeys {
+			err := uc.store.Delete(ctx, key)
+			if err != nil {
+				uc.zlog.Error().Err(err).Str("message", id).Str("key", key).Msg("failed to delete attachment blob")
+			}
+		}
+
+		err := uc.publishEvent(ctx, &model.SpaceEvent{
+			Type:      model.SpaceEventTypeMessageDeleted,
+			SpaceID:   m.SpaceID.String(),
+			MessageID: &id,
+		})
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("message", id).Msg("failed to publish message deletion")
+		}
+	}
+}
+
+func (uc *UcMessage) PinMessage(ctx context.Context, messageID string) (*model.Message, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := uc.adminMessage(ctx, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned, err := uc.repoMessage.IsPinned(ctx, messageID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("pinned message"), err)
+	}
+
+	if !pinned {
+		userUUID, err := helper.StrToUUID(userID)
+		if err != nil {
+			return nil, err
+		}
+
+		stored, err := uc.repoMessage.PinMessage(ctx, &modelDB.PinnedMessageDB{
+			MessageID: message.ID,
+			SpaceID:   message.SpaceID,
+			PinnedBy:  *userUUID,
+		}, constant.MAX_PINNED_MESSAGES_PER_SPACE)
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrCreatingField("pinned message"), err)
+		}
+
+		if !stored {
+			return nil, constant.ErrPinLimitReached
+		}
+
+		payload, err := uc.messageEventPayload(ctx, message)
+		if err != nil {
+			return nil, err
+		}
+
+		err = uc.publishEvent(ctx, &model.SpaceEvent{
+			Type:      model.SpaceEventTypeMessagePinned,
+			SpaceID:   message.SpaceID.String(),
+			Message:   payload,
+			MessageID: &payload.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.PopulateMessageField(ctx, message)
+}
+
+func (uc *UcMessage) UnpinMessage(ctx context.Context, messageID string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	message, err := uc.adminMessage(ctx, messageID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	removed, err := uc.repoMessage.UnpinMessage(ctx, messageID)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrDeletingField("pinned message"), err)
+	}
+
+	if removed {
+		id := message.ID.String()
+		err = uc.publishEvent(ctx, &model.SpaceEvent{
+			Type:      model.SpaceEventTypeMessageUnpinned,
+			SpaceID:   message.SpaceID.String(),
+			MessageID: &id,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// AddReaction reacts to a message as the viewer. Adding a reaction the
+// viewer already made is a no-op.
+func (uc *UcMessage) AddReaction(ctx context.Context, messageID, emoji string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	emoji, err = reactionEmoji(emoji)
+	if err != nil {
+		return false, err
+	}
+
+	message, err := uc.memberMessage(ctx, messageID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	added, err := uc.repoMessage.AddReaction(ctx, &modelDB.ReactionDB{
+		MessageID: message.ID,
+		UserID:    *userUUID,
+		Emoji:     emoji,
+	})
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrCreatingField("reaction"), err)
+	}
+
+	if added {
+		id := message.ID.String()
+		err = uc.publishEvent(ctx, &model.SpaceEvent{
+			Type:      model.SpaceEventTypeReactionAdded,
+			SpaceID:   message.SpaceID.String(),
+			MessageID: &id,
+			User:      &model.User{ID: userID},
+			Emoji:     &emoji,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (uc *UcMessage) RemoveReaction(ctx context.Context, messageID, emoji string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	emoji, err = reactionEmoji(emoji)
+	if err != nil {
+		return false, err
+	}
+
+	message, err := uc.memberMessage(ctx, messageID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	removed, err := uc.repoMessage.RemoveReaction(ctx, messageID, userID, emoji)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrDeletingField("reaction"), err)
+	}
+
+	if removed {
+		id := message.ID.String()
+		err = uc.publishEvent(ctx, &model.SpaceEvent{
+			Type:      model.SpaceEventTypeReactionRemoved,
+			SpaceID:   message.SpaceID.String(),
+			MessageID: &id,
+			User:      &model.User{ID: userID},
+			Emoji:     &emoji,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// reactionEmoji resolves a :shortcode: to its emoji and checks that other
+// values are short enough to be a single emoji sequence.
+func reactionEmoji(emoji string) (string, error) {
+	emoji = strings.TrimSpace(emoji)
+	if emoji == "" {
+		return "", constant.ErrMissingField("emoji")
+	}
+
+	if len(emoji) > 2 && strings.HasPrefix(emoji, ":") && strings.HasSuffix(emoji, ":") {
+		resolved, ok := richtext.LookupEmoji(emoji[1 : len(emoji)-1])
+		if !ok {
+			return "", constant.ErrInvalidReaction
+		}
+
+		return resolved, nil
+	}
+
+	if utf8.RuneCountInString(emoji) > constant.REACTION_MAX_EMOJI_LENGTH || strings.ContainsFunc(emoji, unicode.IsSpace) {
+		return "", constant.ErrInvalidReaction
+	}
+
+	return emoji, nil
+}
+
+// memberMessage loads a live message and checks that userID belongs to its
+// space.
+func (uc *UcMessage) memberMessage(ctx context.Context, messageID, userID string) (*modelDB.MessageDB, error) {
+	_, err := helper.StrToUUID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := uc.repoMessage.GetMessageByID(ctx, messageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrMessageNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("message"), err)
+	}
+
+	if message.ExpiresAt != nil && !message.ExpiresAt.After(time.Now()) {
+		return nil, constant.ErrMessageNotFound
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, message.SpaceID.String(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// adminMessage loads a message and checks that userID administers its space.
+func (uc *UcMessage) adminMessage(ctx context.Context, messageID, userID string) (*modelDB.MessageDB, error) {
+	_, err := helper.StrToUUID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := uc.repoMessage.GetMessageByID(ctx, messageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrMessageNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("message"), err)
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, message.SpaceID.String(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	return message, nil
+}
+
+// PinnedMessages returns the pins of a space, newest first. Only members
+// can see them; other viewers get an empty list.
+func (uc *UcMessage) PinnedMessages(ctx context.Context, spaceID, prefix string) ([]*model.Message, error) {
+	resp := []*model.Message{}
+
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return resp, nil
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotSpaceMember) {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	messages, err := uc.repoMessage.GetPinnedMessages(ctx, spaceID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("pinned messages"), err)
+	}
+
+	blocked, err := uc.blockedUserSet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range messages {
+		temp, err := uc.populateMessageField(ctx, m, prefix)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("message", m.ID.String()).Msg("failed to populate message")
+			continue
+		}
+
+		temp.FromBlockedUser = blocked[m.UserID.String()]
+		resp = append(resp, temp)
+	}
+
+	return resp, nil
+}
+
+func (uc *UcMessage) SearchMessages(ctx context.Context, query string, filter *model.MessageSearchFilter, first *int32, after *string) (*model.MessageSearchConnection, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, constant.ErrEmptySearchQuery
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := helper.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := helper.PageSize(first, constant.DEFAULT_PAGE_SIZE, constant.MAX_PAGE_SIZE)
+	params := &modelDB.MessageSearchParams{
+		UserID: *userUUID,
+		Query:  query,
+		Limit:  limit + 1,
+		Offset: offset,
+	}
+
+	if filter != nil {
+		params.SpaceID, err = helper.StrPtrToUUID(filter.SpaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		params.FromUserID, err = helper.StrPtrToUUID(filter.FromUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		params.Before = filter.Before
+		params.After = filter.After
+		params.HasAttachment = filter.HasAttachment
+	}
+
+	messages, err := uc.repoMessage.SearchMessages(ctx, params)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("messages"), err)
+	}
+
+	hasNextPage := len(messages) > limit
+	if hasNextPage {
+		messages = messages[:limit]
+	}
+
+	blocked, err := uc.blockedUserSet(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := []*model.MessageSearchResult{}
+	for _, m := range messages {
+		temp, err := uc.populateMessageField(ctx, &m.MessageDB, "edges.message")
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("message", m.ID.String()).Msg("failed to populate message")
+			continue
+		}
+
+		temp.FromBlockedUser = blocked[m.UserID.String()]
+		edges = append(edges, &model.MessageSearchResult{
+			Message: temp,
+			Snippet: highlightSnippet(m.Snippet),
+			Rank:    m.Rank,
+		})
+	}
+
+	resp := &model.MessageSearchConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	if len(edges) > 0 {
+		endCursor := helper.EncodeCursor(offset + len(edges))
+		resp.PageInfo.EndCursor = &endCursor
+	}
+
+	return resp, nil
+}
+
+var snippetMarks = strings.NewReplacer(
+	constant.SEARCH_SNIPPET_START, "<mark>",
+	constant.SEARCH_SNIPPET_STOP, "</mark>",
+)
+
+// highlightSnippet turns a snippet whose matches are delimited by the
+// search sentinels into HTML: the content is escaped and only the
+// matches are wrapped in <mark>.
+func highlightSnippet(snippet string) string {
+	return snippetMarks.Replace(html.EscapeString(snippet))
+}
+
+func (uc *UcMessage) PopulateMessageField(ctx context.Context, message *modelDB.MessageDB) (*model.Message, error) {
+	return uc.populateMessageField(ctx, message, "")
+}
+
+func (uc *UcMessage) PopulateMessageFieldWithPrefix(ctx context.Context, message *modelDB.MessageDB, prefix string) (*model.Message, error) {
+	return uc.populateMessageField(ctx, message, prefix)
+}
+
+func (uc *UcMessage) populateMessageField(ctx context.Context, message *modelDB.MessageDB, prefix string) (*model.Message, error) {
+	resp := &model.Message{
+		ID:              message.ID.String(),
+		Content:         message.Content,
+		Format:          toMessageFormatModel(message.Format),
+		HTML:            message.HTML,
+		CreatedAt:       message.CreatedAt,
+		ExpiresAt:       message.ExpiresAt,
+		ClientMessageID: message.ClientMessageID,
+	}
+
+	if message.Seq > 0 {
+		seq := int(message.Seq)
+		resp.Seq = &seq
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "blocks")) {
+		blocks, err := decodeMessageBlocks(message.Blocks)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Blocks = blocks
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "user")) {
+		user, err := uc.repoUser.GetByID(ctx, message.UserID.String())
+		if err != nil {
+			return nil, constant.ErrUserNotFound
+		}
+
+		tempUser := &model.User{
+			ID:        user.ID.String(),
+			Email:     user.Email,
+			Name:      user.Name,
+			Bot:       user.Bot,
+			AvatarURL: user.AvatarURL,
+		}
+
+		resp.User = tempUser
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "attachments")) {
+		attachments, err := uc.repoAttachment.GetByMessageID(ctx, message.ID.String())
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("attachments"), err)
+		}
+
+		withThumbnails := gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "attachments.thumbnails"))
+
+		resp.Attachments = []*model.Attachment{}
+		for _, a := range attachments {
+			temp := toAttachmentModel(a)
+			if withThumbnails {
+				thumbnails, err := uc.repoAttachment.GetThumbnails(ctx, a.ID.String())
+				if err != nil {
+					return nil, constant.ErrWithMsg(constant.ErrGetField("thumbnails"), err)
+				}
+
+				for _, t := range thumbnails {
+					temp.Thumbnails = append(temp.Thumbnails, toAttachmentThumbnailModel(t))
+				}
+			}
+			resp.Attachments = append(resp.Attachments, temp)
+		}
+
+		viewerID, _ := authctx.GetAuthUserID(ctx)
+		uc.signAttachments(resp, viewerID)
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "linkPreviews")) {
+		previews, err := uc.repoMessage.GetMessageLinkPreviews(ctx, message.ID.String())
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("link previews"), err)
+		}
+
+		resp.LinkPreviews = []*model.LinkPreview{}
+		for _, p := range previews {
+			resp.LinkPreviews = append(resp.LinkPreviews, toLinkPreviewModel(p))
+		}
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "space")) {
+		space, err := uc.repoSpace.GetSpaceByID(ctx, message.SpaceID.String())
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("space"), err)
+		}
+
+		tempSpace := &model.Space{
+			ID:          space.ID.String(),
+			Name:        space.Name,
+			Description: &space.Description,
+		}
+
+		resp.Space = tempSpace
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "reactions")) {
+		viewerID, _ := authctx.GetAuthUserID(ctx)
+		reactions, err := uc.repoMessage.GetReactions(ctx, message.ID.String(), viewerID)
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("reactions"), err)
+		}
+
+		resp.Reactions = []*model.Reaction{}
+		for _, r := range reactions {
+			resp.Reactions = append(resp.Reactions, &model.Reaction{
+				Emoji:   r.Emoji,
+				Count:   int32(r.Count),
+				Reacted: r.Reacted,
+			})
+		}
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "poll")) {
+		poll, err := uc.repoPoll.GetByMessageID(ctx, message.ID.String())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("poll"), err)
+		}
+
+		if poll != nil {
+			resp.Poll, err = populatePoll(ctx, uc.repoPoll, poll, gqlhelper.GetPreloadString(prefix, "poll"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+func (uc *UcMessage) blockedUserSet(ctx context.Context, userID string) (map[string]bool, error) {
+	ids, err := uc.repoUser.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("blocked users"), err)
+	}
+
+	blocked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		blocked[id.String()] = true
+	}
+
+	return blocked, nil
+}
+
+func userChannel(userID string) string {
+	return constant.USER_CHANNEL_PREFIX + userID
+}
+
+func toLinkPreviewModel(p *modelDB.LinkPreviewDB) *model.LinkPreview {
+	return &model.LinkPreview{
+		URL:         p.URL,
+		Title:       helper.NilIfEmpty(p.Title),
+		Description: helper.NilIfEmpty(p.Description),
+		ImageURL:    helper.NilIfEmpty(p.ImageURL),
+		SiteName:    helper.NilIfEmpty(p.SiteName),
+	}
+}
+
+func toMessageFormatModel(format string) model.MessageFormat {
+	if format == richtext.FormatMarkdown {
+		return model.MessageFormatMarkdown
+	}
+
+	return model.MessageFormatPlain
+}
+
+// decodeMessageBlocks converts the AST stored at send time into its
+// GraphQL representation without re-parsing the content.
+func decodeMessageBlocks(data []byte) ([]*model.MessageBlock, error) {
+	var blocks []*richtext.Node
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &blocks); err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("message blocks"), err)
+		}
+	}
+
+	return toMessageBlocksModel(blocks), nil
+}
+
+func toMessageBlocksModel(blocks []*richtext.Node) []*model.MessageBlock {
+	resp := []*model.MessageBlock{}
+	for _, b := range blocks {
+		temp := &model.MessageBlock{
+			Type:    model.MessageBlockType(strings.ToUpper(b.Type)),
+			Inlines: toMessageInlinesModel(b.Children),
+		}
+
+		if b.Type == richtext.BlockCode {
+			temp.Language = helper.NilIfEmpty(b.Language)
+			temp.Text = &b.Text
+		}
+
+		resp = append(resp, temp)
+	}
+
+	return resp
+}
+
+func toMessageInlinesModel(nodes []*richtext.Node) []*model.MessageInline {
+	resp := []*model.MessageInline{}
+	for _, n := range nodes {
+		temp := &model.MessageInline{
+			Type:   model.MessageInlineType(strings.ToUpper(n.Type)),
+			Text:   n.Text,
+			URL:    helper.NilIfEmpty(n.URL),
+			UserID: helper.NilIfEmpty(n.UserID),
+			Emoji:  helper.NilIfEmpty(n.Emoji),
+		}
+
+		if len(n.Children) > 0 {
+			temp.Children = toMessageInlinesModel(n.Children)
+		}
+
+		resp = append(resp, temp)
+	}
+
+	return resp
 }
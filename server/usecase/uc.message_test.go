@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/blobstore"
+	"chatspace-server/pkg/broker"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func TestReactionEmoji(t *testing.T) {
+	tests := []struct {
+		name    string
+		emoji   string
+		want    string
+		wantErr error
+	}{
+		{"emoji", "👍", "👍", nil},
+		{"trimmed", " 🎉 ", "🎉", nil},
+		{"shortcode", ":thumbsup:", "👍", nil},
+		{"alias shortcode", ":+1:", "👍", nil},
+		{"zwj sequence", "👩🏽‍💻", "👩🏽‍💻", nil},
+		{"unknown shortcode", ":not-an-emoji:", "", constant.ErrInvalidReaction},
+		{"too long", "this is not an emoji", "", constant.ErrInvalidReaction},
+		{"whitespace", "👍 👍", "", constant.ErrInvalidReaction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reactionEmoji(tt.emoji)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("reactionEmoji(%q) error = %v, want %v", tt.emoji, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Fatalf("reactionEmoji(%q) = %q, want %q", tt.emoji, got, tt.want)
+			}
+		})
+	}
+
+	_, err := reactionEmoji("  ")
+	if err == nil {
+		t.Fatal("reactionEmoji of blank input succeeded, want a missing field error")
+	}
+}
+
+func TestSpaceEventsRechecksBlocks(t *testing.T) {
+	viewer, sender := uuid.New(), uuid.New()
+	users := &fakeRepoUser{}
+	messages := &fakeRepoMessage{stream: make(chan broker.Message)}
+	spaceID := uuid.NewString()
+	spaces := &fakeRepoSpace{roles: map[[2]string]string{{spaceID, viewer.String()}: constant.ROLE_MEMBER}}
+	uc := &UcMessage{repoUser: users, repoMessage: messages, repoSpace: spaces, zlog: zerolog.Nop()}
+
+	ctx, cancel := context.WithCancel(authed(viewer.String()))
+	defer cancel()
+
+	events, err := uc.SpaceEvents(ctx, spaceID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publish := func(id string) *model.SpaceEvent {
+		payload, err := json.Marshal(&model.SpaceEvent{
+			Type:    model.SpaceEventTypeMessageCreated,
+			SpaceID: spaceID,
+			Message: &model.Message{ID: id, Format: model.MessageFormatPlain, User: &model.User{ID: sender.String()}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		messages.stream <- broker.Message{ID: id, Channel: spaceID, Payload: payload}
+
+		return <-events
+	}
+
+	if event := publish("1"); event.Message.FromBlockedUser {
+		t.Fatal("message flagged as from a blocked user before blocking")
+	}
+
+	users.block(viewer.String(), sender)
+
+	if event := publish("2"); !event.Message.FromBlockedUser {
+		t.Fatal("message not flagged after blocking the sender mid-subscription")
+	}
+}
+
+func TestSpaceEventsRequiresMembership(t *testing.T) {
+	spaceID, member, outsider := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	spaces := &fakeRepoSpace{roles: map[[2]string]string{{spaceID, member}: constant.ROLE_MEMBER}}
+	messages := &fakeRepoMessage{stream: make(chan broker.Message)}
+	uc := &UcMessage{repoMessage: messages, repoSpace: spaces, zlog: zerolog.Nop()}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{"anonymous", context.Background(), constant.ErrForbiddenAccess},
+		{"non-member", authed(outsider), constant.ErrNotSpaceMember},
+		{"member", authed(member), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(tt.ctx)
+			defer cancel()
+
+			_, err := uc.SpaceEvents(ctx, spaceID, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SpaceEvents() error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, err = uc.MessageSent(ctx, spaceID, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MessageSent() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	mark := func(s string) string {
+		return constant.SEARCH_SNIPPET_START + s + constant.SEARCH_SNIPPET_STOP
+	}
+
+	tests := []struct {
+		name    string
+		snippet string
+		want    string
+	}{
+		{"plain", "no match here", "no match here"},
+		{"match", "say " + mark("hello") + " there", "say <mark>hello</mark> there"},
+		{"markup in content", "<img src=x onerror=alert(1)> " + mark("hi"), "&lt;img src=x onerror=alert(1)&gt; <mark>hi</mark>"},
+		{"literal mark tags", "<mark>" + mark("x") + "</mark>", "&lt;mark&gt;<mark>x</mark>&lt;/mark&gt;"},
+		{"quotes and ampersands", `a & "b" ` + mark("c"), "a &amp; &#34;b&#34; <mark>c</mark>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlightSnippet(tt.snippet); got != tt.want {
+				t.Fatalf("highlightSnippet(%q) = %q, want %q", tt.snippet, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendMessageRejectsFailedAttachment(t *testing.T) {
+	spaceID, botID := uuid.New(), uuid.New()
+	failed := &modelDB.AttachmentDB{ID: uuid.New(), SpaceID: spaceID, UserID: botID, Status: constant.ATTACHMENT_STATUS_FAILED}
+
+	uc := NewMessageUseCase(&config.Config{}, &fakeRepoMessage{}, &fakeRepoUser{}, &fakeRepoSpace{},
+		&fakeRepoAttachment{attachments: map[string]*modelDB.AttachmentDB{failed.ID.String(): failed}}, &fakeRepoPoll{}, nil,
+		&fakeQueue{}, nil, nil, &fakeWebhooks{}, fakeOutbox{}, fakeTxManager{}, zerolog.Nop())
+
+	_, err := uc.SendMessageAs(context.Background(), botID.String(), spaceID.String(), "", nil, []string{failed.ID.String()}, nil)
+	if !errors.Is(err, constant.ErrAttachmentFailed) {
+		t.Fatalf("SendMessageAs() error = %v, want %v", err, constant.ErrAttachmentFailed)
+	}
+}
+
+// testDeleted stores a blob for each key and returns a deleted message of
+// spaceID that refers to them.
+func testDeleted(t *testing.T, store blobstore.BlobStore, spaceID uuid.UUID, keys ...string) *modelDB.DeletedMessageDB {
+	t.Helper()
+
+	for _, key := range keys {
+		err := store.Put(context.Background(), key, bytes.NewReader([]byte("blob")), 4, "image/png")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &modelDB.DeletedMessageDB{ID: uuid.New(), SpaceID: spaceID, UserID: uuid.New(), StorageKeys: keys}
+}
+
+// checkDeleted fails unless every message in deleted was announced to the
+// space and its webhooks and none of its blobs remain.
+func checkDeleted(t *testing.T, store blobstore.BlobStore, repoMessage *fakeRepoMessage, webhooks *fakeWebhooks, deleted []*modelDB.DeletedMessageDB) {
+	t.Helper()
+
+	for name, events := range map[string][]*model.SpaceEvent{"space": repoMessage.published, "webhooks": webhooks.events} {
+		if len(events) != len(deleted) {
+			t.Fatalf("%d events sent to the %s, want %d", len(events), name, len(deleted))
+		}
+
+		for i, event := range events {
+			if event.Type != model.SpaceEventTypeMessageDeleted || event.MessageID == nil || *event.MessageID != deleted[i].ID.String() {
+				t.Fatalf("%s event %d = %s for %v, want %s for %s", name, i, event.Type, event.MessageID, model.SpaceEventTypeMessageDeleted, deleted[i].ID)
+			}
+		}
+	}
+
+	for _, m := range deleted {
+		for _, key := range m.StorageKeys {
+			_, err := store.Get(context.Background(), key)
+			if !errors.Is(err, blobstore.ErrNotFound) {
+				t.Fatalf("Get(%q) after the delete error = %v, want %v", key, err, blobstore.ErrNotFound)
+			}
+		}
+	}
+}
+
+func TestReapExpiredMessages(t *testing.T) {
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted := []*modelDB.DeletedMessageDB{
+		testDeleted(t, store, uuid.New(), "attachments/a/original", "attachments/a/thumb-320"),
+		testDeleted(t, store, uuid.New()),
+	}
+
+	repoMessage := &fakeRepoMessage{deleted: [][]*modelDB.DeletedMessageDB{deleted}}
+	webhooks := &fakeWebhooks{}
+	uc := NewMessageUseCase(&config.Config{}, repoMessage, &fakeRepoUser{}, &fakeRepoSpace{}, &fakeRepoAttachment{}, &fakeRepoPoll{}, store,
+		&fakeQueue{}, nil, nil, webhooks, fakeOutbox{}, fakeTxManager{}, zerolog.Nop())
+
+	err = uc.ReapExpiredMessages(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkDeleted(t, store, repoMessage, webhooks, deleted)
+}
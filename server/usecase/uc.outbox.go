@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"chatspace-server/constant"
+	modelDB "chatspace-server/model"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type repoOutboxInterface interface {
+	Relay(ctx context.Context, limit int, publish func(ctx context.Context, entry *modelDB.OutboxDB) error) (int, bool, error)
+}
+
+type outboxPublisherInterface interface {
+	PublishMessageOnce(ctx context.Context, spaceID, dedupID string, data []byte) error
+}
+
+type outboxNotifierInterface interface {
+	Notify()
+}
+
+// UcOutbox relays outbox rows to the broker. Every row is published at
+// least once; its dedup ID lets the broker drop the repeats.
+type UcOutbox struct {
+	repoOutbox repoOutboxInterface
+	publisher  outboxPublisherInterface
+	wake       chan struct{}
+	zlog       zerolog.Logger
+}
+
+func NewOutboxUseCase(repoOutbox repoOutboxInterface, publisher outboxPublisherInterface, zlog zerolog.Logger) *UcOutbox {
+	return &UcOutbox{
+		repoOutbox: repoOutbox,
+		publisher:  publisher,
+		wake:       make(chan struct{}, 1),
+		zlog:       zlog,
+	}
+}
+
+// Notify makes the relay run now instead of at its next poll. Writers
+// call it after committing an outbox row.
+func (uc *UcOutbox) Notify() {
+	select {
+	case uc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// RunRelay publishes outbox rows until ctx is done. Polling picks up rows
+// written by other replicas or left behind by a crash.
+func (uc *UcOutbox) RunRelay(ctx context.Context) {
+	for {
+		wait := constant.OUTBOX_POLL_INTERVAL
+
+		published, locked, err := uc.repoOutbox.Relay(ctx, constant.OUTBOX_BATCH, uc.publish)
+		switch {
+		case err != nil:
+			uc.zlog.Error().Err(err).Msg("failed to relay outbox")
+		case !locked:
+			wait = constant.OUTBOX_BUSY_WAIT
+		case published == constant.OUTBOX_BATCH:
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-uc.wake:
+		case <-time.After(wait):
+		}
+	}
+}
+
+// publish relays one row. The repository parks a row once it has failed
+// OUTBOX_MAX_ATTEMPTS times, after which its event is never delivered.
+func (uc *UcOutbox) publish(ctx context.Context, entry *modelDB.OutboxDB) error {
+	err := uc.publisher.PublishMessageOnce(ctx, entry.Channel, entry.DedupID.String(), entry.Payload)
+	if err != nil && entry.Attempts+1 >= constant.OUTBOX_MAX_ATTEMPTS {
+		uc.zlog.Error().Err(err).Int64("outbox", entry.ID).Str("channel", entry.Channel).Msg("parked outbox entry after repeated failures")
+	}
+
+	return err
+}
+
+// newOutboxEntry encodes an event for the outbox of channel.
+func newOutboxEntry(channel string, event any) (*modelDB.OutboxDB, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelDB.OutboxDB{
+		DedupID: uuid.New(),
+		Channel: channel,
+		Payload: data,
+	}, nil
+}
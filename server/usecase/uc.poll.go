@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/gqlhelper"
+	"chatspace-server/pkg/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type repoPollInterface interface {
+	Create(ctx context.Context, poll *modelDB.PollDB, options []string) error
+	GetByID(ctx context.Context, id string) (*modelDB.PollDB, error)
+	GetByMessageID(ctx context.Context, messageID string) (*modelDB.PollDB, error)
+	GetOptions(ctx context.Context, pollID string) ([]*modelDB.PollOptionDB, error)
+	CountVoters(ctx context.Context, pollID string) (int, error)
+	GetOptionVoters(ctx context.Context, optionID string) ([]*modelDB.UserDB, error)
+	GetUserVotes(ctx context.Context, pollID, userID string) ([]string, error)
+	ReplaceVotes(ctx context.Context, pollID, userID string, optionIDs []uuid.UUID) (bool, error)
+	Close(ctx context.Context, pollID string) error
+}
+
+type pollMessageInterface interface {
+	SendMessageAs(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32) (*model.Message, error)
+	QueueSpaceEvent(ctx context.Context, event *model.SpaceEvent) error
+}
+
+type UcPoll struct {
+	repoPoll  repoPollInterface
+	repoSpace repoSpaceInterface
+	ucMessage pollMessageInterface
+	txManager txManagerInterface
+	zlog      zerolog.Logger
+}
+
+func NewPollUseCase(repoPoll repoPollInterface, repoSpace repoSpaceInterface, ucMessage pollMessageInterface, txManager txManagerInterface, zlog zerolog.Logger) *UcPoll {
+	return &UcPoll{
+		repoPoll:  repoPoll,
+		repoSpace: repoSpace,
+		ucMessage: ucMessage,
+		txManager: txManager,
+		zlog:      zlog,
+	}
+}
+
+// CreatePoll posts the question as a message and attaches the poll to it.
+func (uc *UcPoll) CreatePoll(ctx context.Context, request model.PollRequest) (*model.Message, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	question := strings.TrimSpace(request.Question)
+	if question == "" {
+		return nil, constant.ErrMissingField("question")
+	}
+
+	if utf8.RuneCountInString(question) > constant.POLL_MAX_QUESTION_LENGTH {
+		return nil, constant.ErrPollQuestionTooLong
+	}
+
+	options, err := pollOptions(request.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.ClosesAt != nil && !request.ClosesAt.After(time.Now()) {
+		return nil, constant.ErrPollClosesInPast
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, request.SpaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(request.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	poll := &modelDB.PollDB{
+		SpaceID:     *spaceUUID,
+		Question:    question,
+		MultiChoice: request.MultiChoice != nil && *request.MultiChoice,
+		Anonymous:   request.Anonymous != nil && *request.Anonymous,
+		ClosesAt:    request.ClosesAt,
+		CreatedBy:   *userUUID,
+	}
+
+	// The message and its poll are stored together, so a failed poll never
+	// leaves a bare question (or its outbox event) behind.
+	var message *model.Message
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		message, err = uc.ucMessage.SendMessageAs(ctx, userID, request.SpaceID, question, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		messageUUID, err := helper.StrToUUID(message.ID)
+		if err != nil {
+			return err
+		}
+		poll.MessageID = *messageUUID
+
+		err = uc.repoPoll.Create(ctx, poll, options)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrCreatingField("poll"), err)
+		}
+
+		message.Poll, err = uc.queuePoll(ctx, poll)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// VotePoll replaces the viewer's votes with optionIDs. An empty list
+// withdraws the vote.
+func (uc *UcPoll) VotePoll(ctx context.Context, pollID string, optionIDs []string) (*model.Poll, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := uc.memberPoll(ctx, pollID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pollClosed(poll) {
+		return nil, constant.ErrPollClosed
+	}
+
+	if !poll.MultiChoice && len(optionIDs) > 1 {
+		return nil, constant.ErrPollSingleChoice
+	}
+
+	options, err := uc.repoPoll.GetOptions(ctx, pollID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("poll options"), err)
+	}
+
+	valid := make(map[uuid.UUID]bool, len(options))
+	for _, o := range options {
+		valid[o.ID] = true
+	}
+
+	var ids []uuid.UUID
+	for _, id := range optionIDs {
+		optionUUID, err := helper.StrToUUID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !valid[*optionUUID] {
+			return nil, constant.ErrPollInvalidOption
+		}
+		ids = append(ids, *optionUUID)
+	}
+
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		stored, err := uc.repoPoll.ReplaceVotes(ctx, pollID, userID, ids)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrUpdatingField("poll votes"), err)
+		}
+
+		if !stored {
+			return constant.ErrPollClosed
+		}
+
+		_, err = uc.queuePoll(ctx, poll)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return populatePoll(ctx, uc.repoPoll, poll, "")
+}
+
+// ClosePoll stops voting. Only the poll creator or a space admin can close.
+func (uc *UcPoll) ClosePoll(ctx context.Context, pollID string) (*model.Poll, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := uc.memberPoll(ctx, pollID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if poll.CreatedBy.String() != userID {
+		role, err := spaceMemberRole(ctx, uc.repoSpace, poll.SpaceID.String(), userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if role != constant.ROLE_ADMIN {
+			return nil, constant.ErrNotSpaceAdmin
+		}
+	}
+
+	if poll.ClosedAt == nil {
+		err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			err := uc.repoPoll.Close(ctx, pollID)
+			if err != nil {
+				return constant.ErrWithMsg(constant.ErrUpdatingField("poll"), err)
+			}
+
+			now := time.Now()
+			poll.ClosedAt = &now
+
+			_, err = uc.queuePoll(ctx, poll)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return populatePoll(ctx, uc.repoPoll, poll, "")
+}
+
+// memberPoll loads a poll and checks that userID belongs to its space.
+func (uc *UcPoll) memberPoll(ctx context.Context, pollID, userID string) (*modelDB.PollDB, error) {
+	_, err := helper.StrToUUID(pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := uc.repoPoll.GetByID(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrPollNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("poll"), err)
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, poll.SpaceID.String(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return poll, nil
+}
+
+// queuePoll announces the tallies as of the unit of work in ctx through
+// the outbox and returns them. The event is relayed once the unit commits,
+// so a broker outage never fails a vote that was stored.
+func (uc *UcPoll) queuePoll(ctx context.Context, poll *modelDB.PollDB) (*model.Poll, error) {
+	payload, err := pollEventPayload(ctx, uc.repoPoll, poll)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := poll.MessageID.String()
+	err = uc.ucMessage.QueueSpaceEvent(ctx, &model.SpaceEvent{
+		Type:      model.SpaceEventTypePollUpdated,
+		SpaceID:   poll.SpaceID.String(),
+		MessageID: &messageID,
+		Poll:      payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// pollOptions trims options and checks their count, length and uniqueness.
+func pollOptions(raw []string) ([]string, error) {
+	seen := map[string]bool{}
+	var options []string
+	for _, o := range raw {
+		o = strings.TrimSpace(o)
+		if o == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(o) > constant.POLL_MAX_OPTION_LENGTH {
+			return nil, constant.ErrPollOptionTooLong
+		}
+
+		key := strings.ToLower(o)
+		if seen[key] {
+			return nil, constant.ErrPollDuplicateOption
+		}
+		seen[key] = true
+		options = append(options, o)
+	}
+
+	if len(options) < constant.POLL_MIN_OPTIONS || len(options) > constant.POLL_MAX_OPTIONS {
+		return nil, constant.ErrPollOptionsCount
+	}
+
+	return options, nil
+}
+
+func pollClosed(poll *modelDB.PollDB) bool {
+	return poll.ClosedAt != nil || (poll.ClosesAt != nil && !poll.ClosesAt.After(time.Now()))
+}
+
+// populatePoll builds a poll for a GraphQL read. Voters are only loaded
+// when requested and never for anonymous polls.
+func populatePoll(ctx context.Context, repoPoll repoPollInterface, poll *modelDB.PollDB, prefix string) (*model.Poll, error) {
+	withVoters := !poll.Anonymous && gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "options.voters"))
+
+	resp, err := buildPoll(ctx, repoPoll, poll, withVoters)
+	if err != nil {
+		return nil, err
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "myVotes")) {
+		userID, err := authctx.GetAuthUserID(ctx)
+		if err == nil {
+			votes, err := repoPoll.GetUserVotes(ctx, poll.ID.String(), userID)
+			if err != nil {
+				return nil, constant.ErrWithMsg(constant.ErrGetField("poll votes"), err)
+			}
+			resp.MyVotes = append([]string{}, votes...)
+		}
+	}
+
+	return resp, nil
+}
+
+// pollEventPayload builds a poll for publishing. It carries tallies only,
+// with no voter identities or viewer-specific fields.
+func pollEventPayload(ctx context.Context, repoPoll repoPollInterface, poll *modelDB.PollDB) (*model.Poll, error) {
+	return buildPoll(ctx, repoPoll, poll, false)
+}
+
+func buildPoll(ctx context.Context, repoPoll repoPollInterface, poll *modelDB.PollDB, withVoters bool) (*model.Poll, error) {
+	pollID := poll.ID.String()
+
+	options, err := repoPoll.GetOptions(ctx, pollID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("poll options"), err)
+	}
+
+	voters, err := repoPoll.CountVoters(ctx, pollID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("poll votes"), err)
+	}
+
+	resp := &model.Poll{
+		ID:          pollID,
+		Question:    poll.Question,
+		Options:     []*model.PollOption{},
+		MultiChoice: poll.MultiChoice,
+		Anonymous:   poll.Anonymous,
+		ClosesAt:    poll.ClosesAt,
+		Closed:      pollClosed(poll),
+		TotalVoters: int32(voters),
+	}
+
+	for _, o := range options {
+		temp := &model.PollOption{
+			ID:        o.ID.String(),
+			Text:      o.Text,
+			VoteCount: int32(o.VoteCount),
+		}
+
+		if withVoters && !poll.Anonymous {
+			users, err := repoPoll.GetOptionVoters(ctx, temp.ID)
+			if err != nil {
+				return nil, constant.ErrWithMsg(constant.ErrGetField("poll voters"), err)
+			}
+
+			temp.Voters = []*model.User{}
+			for _, u := range users {
+				temp.Voters = append(temp.Voters, &model.User{
+					ID:    u.ID.String(),
+					Email: u.Email,
+					Name:  u.Name,
+				})
+			}
+		}
+
+		resp.Options = append(resp.Options, temp)
+	}
+
+	return resp, nil
+}
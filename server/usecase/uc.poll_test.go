@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func TestVotePollQueuesEvent(t *testing.T) {
+	spaceID, voterID := uuid.New(), uuid.New()
+	yes, no := &modelDB.PollOptionDB{ID: uuid.New(), Text: "yes"}, &modelDB.PollOptionDB{ID: uuid.New(), Text: "no"}
+	repoPoll := &fakeRepoPoll{
+		poll:    &modelDB.PollDB{ID: uuid.New(), MessageID: uuid.New(), SpaceID: spaceID, Question: "lunch?"},
+		options: []*modelDB.PollOptionDB{yes, no},
+	}
+	repoSpace := &fakeRepoSpace{roles: map[[2]string]string{{spaceID.String(), voterID.String()}: constant.ROLE_MEMBER}}
+
+	// The broker is down; the vote is stored and its event waits in the
+	// outbox instead of failing the request.
+	repoMessage := &fakeRepoMessage{publishErr: errors.New("broker unavailable")}
+	webhooks := &fakeWebhooks{}
+	ucMessage := NewMessageUseCase(&config.Config{}, repoMessage, &fakeRepoUser{}, repoSpace, &fakeRepoAttachment{}, repoPoll, nil,
+		&fakeQueue{}, nil, nil, webhooks, fakeOutbox{}, fakeTxManager{}, zerolog.Nop())
+	uc := NewPollUseCase(repoPoll, repoSpace, ucMessage, fakeTxManager{}, zerolog.Nop())
+
+	poll, err := uc.VotePoll(resolving(authed(voterID.String())), repoPoll.poll.ID.String(), []string{yes.ID.String()})
+	if err != nil {
+		t.Fatalf("VotePoll() error = %v, want nil", err)
+	}
+
+	if poll.TotalVoters != 1 || poll.Options[0].VoteCount != 1 {
+		t.Fatalf("VotePoll() = %d voters, %d for %q, want 1 and 1", poll.TotalVoters, poll.Options[0].VoteCount, yes.Text)
+	}
+
+	if len(repoMessage.announced) != 1 {
+		t.Fatalf("%d outbox entries written, want 1", len(repoMessage.announced))
+	}
+
+	entry := repoMessage.announced[0]
+	var event model.SpaceEvent
+	err = json.Unmarshal(entry.Payload, &event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Channel != spaceID.String() || event.Type != model.SpaceEventTypePollUpdated || event.Poll.TotalVoters != 1 {
+		t.Fatalf("outbox entry = %s on %s with %d voters, want %s on %s with 1", event.Type, entry.Channel, event.Poll.TotalVoters, model.SpaceEventTypePollUpdated, spaceID)
+	}
+
+	if len(webhooks.events) != 1 || webhooks.events[0].Type != model.SpaceEventTypePollUpdated {
+		t.Fatalf("webhook events = %v, want one %s", webhooks.events, model.SpaceEventTypePollUpdated)
+	}
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"context"
+	"expvar"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// retentionMetrics is published on /debug/vars.
+var retentionMetrics = expvar.NewMap("retention")
+
+type messageDeleterInterface interface {
+	AnnounceDeleted(ctx context.Context, deleted []*modelDB.DeletedMessageDB)
+}
+
+type UcRetention struct {
+	cfg         *config.Config
+	repoSpace   repoSpaceInterface
+	repoMessage repoMessageInterface
+	messages    messageDeleterInterface
+	zlog        zerolog.Logger
+}
+
+func NewRetentionUseCase(cfg *config.Config, repoSpace repoSpaceInterface, repoMessage repoMessageInterface, messages messageDeleterInterface, zlog zerolog.Logger) *UcRetention {
+	return &UcRetention{
+		cfg:         cfg,
+		repoSpace:   repoSpace,
+		repoMessage: repoMessage,
+		messages:    messages,
+		zlog:        zlog,
+	}
+}
+
+// RunPurge enforces retention policies periodically until ctx is done.
+func (uc *UcRetention) RunPurge(ctx context.Context) {
+	interval := constant.DEFAULT_RETENTION_PERIOD
+	if uc.cfg.Retention.Interval > 0 {
+		interval = time.Duration(uc.cfg.Retention.Interval) * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := uc.PurgeMessages(ctx)
+		if err != nil {
+			uc.zlog.Error().Err(err).Msg("failed to enforce retention policies")
+		}
+		if purged > 0 {
+			uc.zlog.Info().Int64("rows", purged).Msg("purged messages past retention")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeMessages deletes messages that fall outside their space's effective
+// retention policy. Deletes run in small batches, each its own statement,
+// so no lock is held for long; after each batch the attachment blobs are
+// removed and the deletions announced like expired messages. It returns
+// the number of rows purged.
+func (uc *UcRetention) PurgeMessages(ctx context.Context) (int64, error) {
+	policies, err := uc.repoSpace.GetRetentionPolicies(ctx)
+	if err != nil {
+		return 0, constant.ErrWithMsg(constant.ErrGetField("retention policies"), err)
+	}
+
+	retentionMetrics.Add("runs", 1)
+
+	var total int64
+	for _, p := range policies {
+		mode, value := effectiveRetention(uc.cfg, p)
+		if mode == constant.RETENTION_MODE_FOREVER {
+			continue
+		}
+
+		purged, err := uc.purgeSpace(ctx, p.SpaceID.String(), mode, value)
+		total += purged
+		retentionMetrics.Add("rows_purged", purged)
+		if err != nil {
+			retentionMetrics.Add("errors", 1)
+			uc.zlog.Error().Err(err).Str("space", p.SpaceID.String()).Msg("failed to purge space messages")
+		}
+
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+	}
+
+	lastRun := new(expvar.Int)
+	lastRun.Set(time.Now().Unix())
+	retentionMetrics.Set("last_run_unix", lastRun)
+
+	return total, nil
+}
+
+func (uc *UcRetention) purgeSpace(ctx context.Context, spaceID, mode string, value int) (int64, error) {
+	batch := constant.DEFAULT_RETENTION_BATCH
+	if uc.cfg.Retention.BatchSize > 0 {
+		batch = uc.cfg.Retention.BatchSize
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -value)
+
+	var total int64
+	for {
+		var deleted []*modelDB.DeletedMessageDB
+		var err error
+		if mode == constant.RETENTION_MODE_DAYS {
+			deleted, err = uc.repoMessage.PurgeOlderThan(ctx, spaceID, cutoff, batch)
+		} else {
+			deleted, err = uc.repoMessage.PurgeBeyondCount(ctx, spaceID, value, batch)
+		}
+		if err != nil {
+			return total, constant.ErrWithMsg(constant.ErrDeletingField("messages"), err)
+		}
+
+		uc.messages.AnnounceDeleted(ctx, deleted)
+		total += int64(len(deleted))
+
+		if len(deleted) < batch {
+			return total, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return total, ctx.Err()
+		case <-time.After(constant.RETENTION_BATCH_PAUSE):
+		}
+	}
+}
+
+// effectiveRetention resolves a space policy against the configured
+// default. Anything invalid falls back to keeping messages forever.
+func effectiveRetention(cfg *config.Config, policy *modelDB.RetentionPolicyDB) (string, int) {
+	mode := policy.Mode
+	value := 0
+	if policy.Value != nil {
+		value = *policy.Value
+	}
+
+	if mode == constant.RETENTION_MODE_DEFAULT {
+		mode = strings.ToLower(cfg.Retention.DefaultMode)
+		value = cfg.Retention.DefaultValue
+	}
+
+	if validRetention(mode, value) != nil || mode == constant.RETENTION_MODE_DEFAULT {
+		return constant.RETENTION_MODE_FOREVER, 0
+	}
+
+	return mode, value
+}
+
+func validRetention(mode string, value int) error {
+	switch mode {
+	case constant.RETENTION_MODE_DEFAULT, constant.RETENTION_MODE_FOREVER:
+		return nil
+	case constant.RETENTION_MODE_DAYS:
+		if value > 0 && value <= constant.MAX_RETENTION_DAYS {
+			return nil
+		}
+	case constant.RETENTION_MODE_MESSAGES:
+		if value > 0 && value <= constant.MAX_RETENTION_MESSAGES {
+			return nil
+		}
+	}
+
+	return constant.ErrInvalidRetentionPolicy
+}
+
+func toRetentionPolicyModel(cfg *config.Config, policy *modelDB.RetentionPolicyDB) *model.RetentionPolicy {
+	mode, value := effectiveRetention(cfg, policy)
+	resp := &model.RetentionPolicy{
+		Mode:      model.RetentionMode(strings.ToUpper(mode)),
+		Inherited: policy.Mode == constant.RETENTION_MODE_DEFAULT,
+	}
+
+	if mode != constant.RETENTION_MODE_FOREVER {
+		v := int32(value)
+		resp.Value = &v
+	}
+
+	return resp
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/blobstore"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func TestPurgeMessagesAnnouncesDeletions(t *testing.T) {
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spaceID := uuid.New()
+	deleted := []*modelDB.DeletedMessageDB{
+		testDeleted(t, store, spaceID, "attachments/a/original", "attachments/a/thumb-320"),
+		testDeleted(t, store, spaceID),
+		testDeleted(t, store, spaceID, "attachments/b/original"),
+	}
+
+	keep := 10
+	cfg := &config.Config{Retention: config.Retention{BatchSize: 2}}
+	repoSpace := &fakeRepoSpace{policies: []*modelDB.RetentionPolicyDB{
+		{SpaceID: spaceID, Mode: constant.RETENTION_MODE_MESSAGES, Value: &keep},
+	}}
+	repoMessage := &fakeRepoMessage{deleted: [][]*modelDB.DeletedMessageDB{deleted[:2], deleted[2:]}}
+	webhooks := &fakeWebhooks{}
+
+	ucMessage := NewMessageUseCase(cfg, repoMessage, &fakeRepoUser{}, repoSpace, &fakeRepoAttachment{}, &fakeRepoPoll{}, store,
+		&fakeQueue{}, nil, nil, webhooks, fakeOutbox{}, fakeTxManager{}, zerolog.Nop())
+	uc := NewRetentionUseCase(cfg, repoSpace, repoMessage, ucMessage, zerolog.Nop())
+
+	purged, err := uc.PurgeMessages(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if purged != int64(len(deleted)) {
+		t.Fatalf("PurgeMessages() = %d, want %d", purged, len(deleted))
+	}
+
+	checkDeleted(t, store, repoMessage, webhooks, deleted)
+}
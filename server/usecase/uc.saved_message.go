@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/gqlhelper"
+	"chatspace-server/pkg/helper"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog"
+)
+
+type repoSavedMessageInterface interface {
+	Save(ctx context.Context, saved *modelDB.SavedMessageDB) error
+	Delete(ctx context.Context, userID, messageID string) error
+	GetByID(ctx context.Context, id string) (*modelDB.SavedMessageDB, error)
+	GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*modelDB.SavedMessageDB, error)
+	ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+type savedMessageUcMessageInterface interface {
+	PopulateMessageFieldWithPrefix(ctx context.Context, message *modelDB.MessageDB, prefix string) (*model.Message, error)
+	MessageEventPayload(ctx context.Context, message *modelDB.MessageDB) (*model.Message, error)
+	PublishUserEvent(ctx context.Context, userID string, event *model.UserEvent) error
+}
+
+type UcSavedMessage struct {
+	repoSavedMessage repoSavedMessageInterface
+	repoMessage      repoMessageInterface
+	repoSpace        repoSpaceInterface
+	ucMessage        savedMessageUcMessageInterface
+	zlog             zerolog.Logger
+}
+
+func NewSavedMessageUseCase(
+	repoSavedMessage repoSavedMessageInterface,
+	repoMessage repoMessageInterface,
+	repoSpace repoSpaceInterface,
+	ucMessage savedMessageUcMessageInterface,
+	zlog zerolog.Logger,
+) *UcSavedMessage {
+	return &UcSavedMessage{
+		repoSavedMessage: repoSavedMessage,
+		repoMessage:      repoMessage,
+		repoSpace:        repoSpace,
+		ucMessage:        ucMessage,
+		zlog:             zlog,
+	}
+}
+
+func (uc *UcSavedMessage) SaveMessage(ctx context.Context, messageID string, note *string, remindAt *time.Time) (*model.SavedMessage, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = helper.StrToUUID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if note != nil {
+		trimmed := strings.TrimSpace(*note)
+		if utf8.RuneCountInString(trimmed) > constant.SAVED_MESSAGE_NOTE_MAX_LENGTH {
+			return nil, constant.ErrSavedNoteTooLong
+		}
+		note = helper.NilIfEmpty(trimmed)
+	}
+
+	if remindAt != nil && !remindAt.After(time.Now()) {
+		return nil, constant.ErrReminderInPast
+	}
+
+	message, err := uc.repoMessage.GetMessageByID(ctx, messageID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrMessageNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("message"), err)
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, message.SpaceID.String(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := &modelDB.SavedMessageDB{
+		UserID:    *userUUID,
+		MessageID: message.ID,
+		Note:      note,
+		RemindAt:  remindAt,
+		Message:   *message,
+	}
+
+	err = uc.repoSavedMessage.Save(ctx, saved)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("saved message"), err)
+	}
+
+	return uc.populateSavedMessageField(ctx, saved, "")
+}
+
+func (uc *UcSavedMessage) UnsaveMessage(ctx context.Context, messageID string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = helper.StrToUUID(messageID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.repoSavedMessage.Delete(ctx, userID, messageID)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrDeletingField("saved message"), err)
+	}
+
+	return true, nil
+}
+
+func (uc *UcSavedMessage) SavedMessages(ctx context.Context, first *int32, after *string) (*model.SavedMessageConnection, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := helper.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := helper.PageSize(first, constant.DEFAULT_PAGE_SIZE, constant.MAX_PAGE_SIZE)
+	saved, err := uc.repoSavedMessage.GetByUserID(ctx, userID, limit+1, offset)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("saved messages"), err)
+	}
+
+	hasNextPage := len(saved) > limit
+	if hasNextPage {
+		saved = saved[:limit]
+	}
+
+	edges := []*model.SavedMessage{}
+	for _, s := range saved {
+		temp, err := uc.populateSavedMessageField(ctx, s, "edges")
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("saved_message", s.ID.String()).Msg("failed to populate saved message")
+			continue
+		}
+
+		edges = append(edges, temp)
+	}
+
+	resp := &model.SavedMessageConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	if len(edges) > 0 {
+		endCursor := helper.EncodeCursor(offset + len(edges))
+		resp.PageInfo.EndCursor = &endCursor
+	}
+
+	return resp, nil
+}
+
+// RunReminders polls for due bookmark reminders until ctx is done.
+func (uc *UcSavedMessage) RunReminders(ctx context.Context) {
+	ticker := time.NewTicker(constant.SAVED_MESSAGE_REMINDER_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		err := uc.SendDueReminders(ctx)
+		if err != nil {
+			uc.zlog.Error().Err(err).Msg("failed to send saved message reminders")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDueReminders claims due reminders and pushes them to each user's
+// real-time stream. A reminder is claimed before it is published, so it is
+// delivered at most once even when several replicas poll.
+func (uc *UcSavedMessage) SendDueReminders(ctx context.Context) error {
+	for {
+		ids, err := uc.repoSavedMessage.ClaimDueReminders(ctx, time.Now(), constant.SAVED_MESSAGE_REMINDER_BATCH)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrUpdatingField("saved message reminders"), err)
+		}
+
+		for _, id := range ids {
+			err := uc.sendReminder(ctx, id)
+			if err != nil {
+				uc.zlog.Error().Err(err).Str("saved_message", id).Msg("failed to send saved message reminder")
+			}
+		}
+
+		if len(ids) < constant.SAVED_MESSAGE_REMINDER_BATCH {
+			return nil
+		}
+	}
+}
+
+func (uc *UcSavedMessage) sendReminder(ctx context.Context, id string) error {
+	saved, err := uc.repoSavedMessage.GetByID(ctx, id)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrGetField("saved message"), err)
+	}
+
+	message, err := uc.ucMessage.MessageEventPayload(ctx, &saved.Message)
+	if err != nil {
+		return err
+	}
+
+	resp := toSavedMessageModel(saved)
+	resp.Message = message
+
+	return uc.ucMessage.PublishUserEvent(ctx, saved.UserID.String(), &model.UserEvent{
+		Type:         model.UserEventTypeSavedMessageReminder,
+		SavedMessage: resp,
+	})
+}
+
+func (uc *UcSavedMessage) populateSavedMessageField(ctx context.Context, saved *modelDB.SavedMessageDB, prefix string) (*model.SavedMessage, error) {
+	resp := toSavedMessageModel(saved)
+
+	message, err := uc.ucMessage.PopulateMessageFieldWithPrefix(ctx, &saved.Message, gqlhelper.GetPreloadString(prefix, "message"))
+	if err != nil {
+		return nil, err
+	}
+	resp.Message = message
+
+	return resp, nil
+}
+
+func toSavedMessageModel(saved *modelDB.SavedMessageDB) *model.SavedMessage {
+	return &model.SavedMessage{
+		ID:        saved.ID.String(),
+		Note:      saved.Note,
+		RemindAt:  saved.RemindAt,
+		CreatedAt: saved.CreatedAt,
+	}
+}
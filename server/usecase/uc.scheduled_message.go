@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/helper"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type repoScheduledMessageInterface interface {
+	Create(ctx context.Context, scheduled *modelDB.ScheduledMessageDB) error
+	Cancel(ctx context.Context, id, userID string) (bool, error)
+	GetPendingByUserID(ctx context.Context, userID string, spaceID *uuid.UUID) ([]*modelDB.ScheduledMessageDB, error)
+	DeliverNext(ctx context.Context, now time.Time, deliver func(ctx context.Context, scheduled *modelDB.ScheduledMessageDB) (*string, error)) (bool, error)
+}
+
+type messageSenderInterface interface {
+	SendMessageAs(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat, attachmentIDs []string, expiresIn *int32) (*model.Message, error)
+	SendMessageOnce(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat, clientMessageID string) (*model.Message, error)
+	EphemeralMessage(ctx context.Context, userID, spaceID string, content string, format *model.MessageFormat) (*model.Message, error)
+	PublishUserEvent(ctx context.Context, userID string, event *model.UserEvent) error
+}
+
+type UcScheduledMessage struct {
+	repoScheduledMessage repoScheduledMessageInterface
+	repoSpace            repoSpaceInterface
+	ucMessage            messageSenderInterface
+	zlog                 zerolog.Logger
+}
+
+func NewScheduledMessageUseCase(
+	repoScheduledMessage repoScheduledMessageInterface,
+	repoSpace repoSpaceInterface,
+	ucMessage messageSenderInterface,
+	zlog zerolog.Logger,
+) *UcScheduledMessage {
+	return &UcScheduledMessage{
+		repoScheduledMessage: repoScheduledMessage,
+		repoSpace:            repoSpace,
+		ucMessage:            ucMessage,
+		zlog:                 zlog,
+	}
+}
+
+func (uc *UcScheduledMessage) ScheduleMessage(ctx context.Context, spaceID string, content string, format *model.MessageFormat, sendAt time.Time) (*model.ScheduledMessage, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return nil, constant.ErrMissingField("content")
+	}
+
+	if !sendAt.After(time.Now()) {
+		return nil, constant.ErrSendAtInPast
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	messageFormat := model.MessageFormatPlain
+	if format != nil && format.IsValid() {
+		messageFormat = *format
+	}
+
+	scheduled := &modelDB.ScheduledMessageDB{
+		UserID:  *userUUID,
+		SpaceID: *spaceUUID,
+		Content: content,
+		Format:  strings.ToLower(messageFormat.String()),
+		SendAt:  sendAt,
+	}
+
+	err = uc.repoScheduledMessage.Create(ctx, scheduled)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("scheduled message"), err)
+	}
+
+	return toScheduledMessageModel(scheduled), nil
+}
+
+func (uc *UcScheduledMessage) CancelScheduledMessage(ctx context.Context, id string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = helper.StrToUUID(id)
+	if err != nil {
+		return false, err
+	}
+
+	canceled, err := uc.repoScheduledMessage.Cancel(ctx, id, userID)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrUpdatingField("scheduled message"), err)
+	}
+
+	if !canceled {
+		return false, constant.ErrScheduledMessageNotFound
+	}
+
+	return true, nil
+}
+
+func (uc *UcScheduledMessage) ScheduledMessages(ctx context.Context, spaceID *string) ([]*model.ScheduledMessage, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrPtrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := uc.repoScheduledMessage.GetPendingByUserID(ctx, userID, spaceUUID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("scheduled messages"), err)
+	}
+
+	resp := []*model.ScheduledMessage{}
+	for _, s := range scheduled {
+		resp = append(resp, toScheduledMessageModel(s))
+	}
+
+	return resp, nil
+}
+
+// RunScheduler delivers due scheduled messages until ctx is done.
+func (uc *UcScheduledMessage) RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(constant.SCHEDULED_MESSAGE_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		err := uc.DeliverDue(ctx)
+		if err != nil {
+			uc.zlog.Error().Err(err).Msg("failed to deliver scheduled messages")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue sends every scheduled message that is due, one row per
+// transaction so a slow send does not hold locks on the rest.
+func (uc *UcScheduledMessage) DeliverDue(ctx context.Context) error {
+	for {
+		delivered, err := uc.repoScheduledMessage.DeliverNext(ctx, time.Now(), uc.deliver)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrUpdatingField("scheduled message"), err)
+		}
+
+		if !delivered {
+			return nil
+		}
+	}
+}
+
+func (uc *UcScheduledMessage) deliver(ctx context.Context, scheduled *modelDB.ScheduledMessageDB) (*string, error) {
+	userID := scheduled.UserID.String()
+	spaceID := scheduled.SpaceID.String()
+
+	_, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	format := toMessageFormatModel(scheduled.Format)
+	if scheduled.Ephemeral {
+		return nil, uc.deliverEphemeral(ctx, scheduled, &format)
+	}
+
+	// DeliverNext retries a row whose send committed but whose status did
+	// not, so the send is keyed on the scheduled message to happen once.
+	message, err := uc.ucMessage.SendMessageOnce(ctx, userID, spaceID, scheduled.Content, &format, scheduled.ID.String())
+	if err != nil {
+		uc.zlog.Error().Err(err).Str("scheduled_message", scheduled.ID.String()).Msg("failed to send scheduled message")
+		return nil, err
+	}
+
+	return &message.ID, nil
+}
+
+// deliverEphemeral pushes a message such as a /remind reminder to the
+// owner's user stream. Nothing is stored in the space.
+func (uc *UcScheduledMessage) deliverEphemeral(ctx context.Context, scheduled *modelDB.ScheduledMessageDB, format *model.MessageFormat) error {
+	userID := scheduled.UserID.String()
+
+	message, err := uc.ucMessage.EphemeralMessage(ctx, userID, scheduled.SpaceID.String(), scheduled.Content, format)
+	if err != nil {
+		return err
+	}
+
+	err = uc.ucMessage.PublishUserEvent(ctx, userID, &model.UserEvent{
+		Type:    model.UserEventTypeEphemeralMessage,
+		Message: message,
+	})
+	if err != nil {
+		uc.zlog.Error().Err(err).Str("scheduled_message", scheduled.ID.String()).Msg("failed to send scheduled message")
+		return err
+	}
+
+	return nil
+}
+
+func toScheduledMessageModel(scheduled *modelDB.ScheduledMessageDB) *model.ScheduledMessage {
+	return &model.ScheduledMessage{
+		ID:        scheduled.ID.String(),
+		SpaceID:   scheduled.SpaceID.String(),
+		Content:   scheduled.Content,
+		Format:    toMessageFormatModel(scheduled.Format),
+		Ephemeral: scheduled.Ephemeral,
+		SendAt:    scheduled.SendAt,
+		Status:    model.ScheduledMessageStatus(strings.ToUpper(scheduled.Status)),
+		CreatedAt: scheduled.CreatedAt,
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	modelDB "chatspace-server/model"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// fakeRepoScheduledMessage delivers one due row. With crash set, delivery
+// fails after deliver returns, before the outcome is recorded, as if the
+// process died between the send and the status update.
+type fakeRepoScheduledMessage struct {
+	repoScheduledMessageInterface
+
+	scheduled *modelDB.ScheduledMessageDB
+	crash     bool
+}
+
+var errCrashed = errors.New("crashed before recording the delivery")
+
+func (r *fakeRepoScheduledMessage) DeliverNext(ctx context.Context, now time.Time, deliver func(ctx context.Context, scheduled *modelDB.ScheduledMessageDB) (*string, error)) (bool, error) {
+	if r.scheduled.Status != constant.SCHEDULED_MESSAGE_STATUS_PENDING {
+		return false, nil
+	}
+
+	messageID, err := deliver(ctx, r.scheduled)
+	if err != nil {
+		return false, err
+	}
+
+	if r.crash {
+		r.crash = false
+		return false, errCrashed
+	}
+
+	id := uuid.MustParse(*messageID)
+	r.scheduled.Status = constant.SCHEDULED_MESSAGE_STATUS_SENT
+	r.scheduled.MessageID = &id
+
+	return true, nil
+}
+
+func TestDeliverDueAfterCrash(t *testing.T) {
+	ctx := context.Background()
+	spaceID, userID := uuid.New(), uuid.New()
+
+	repoSpace := &fakeRepoSpace{roles: map[[2]string]string{{spaceID.String(), userID.String()}: constant.ROLE_MEMBER}}
+	repoMessage := &fakeRepoMessage{}
+	ucMessage := NewMessageUseCase(&config.Config{}, repoMessage, &fakeRepoUser{}, repoSpace, &fakeRepoAttachment{}, &fakeRepoPoll{}, nil,
+		&fakeQueue{}, nil, nil, &fakeWebhooks{}, fakeOutbox{}, fakeTxManager{}, zerolog.Nop())
+
+	repo := &fakeRepoScheduledMessage{
+		scheduled: &modelDB.ScheduledMessageDB{
+			ID:      uuid.New(),
+			UserID:  userID,
+			SpaceID: spaceID,
+			Content: "standup in 5",
+			Format:  "plain",
+			Status:  constant.SCHEDULED_MESSAGE_STATUS_PENDING,
+		},
+		crash: true,
+	}
+	uc := NewScheduledMessageUseCase(repo, repoSpace, ucMessage, zerolog.Nop())
+
+	err := uc.DeliverDue(ctx)
+	if err == nil {
+		t.Fatal("first DeliverDue() succeeded, want the simulated crash")
+	}
+
+	err = uc.DeliverDue(ctx)
+	if err != nil {
+		t.Fatalf("DeliverDue() after the crash error = %v", err)
+	}
+
+	if len(repoMessage.messages) != 1 {
+		t.Fatalf("stored %d messages, want 1", len(repoMessage.messages))
+	}
+
+	for id := range repoMessage.messages {
+		if repo.scheduled.MessageID == nil || repo.scheduled.MessageID.String() != id {
+			t.Fatalf("scheduled message recorded message %v, want %s", repo.scheduled.MessageID, id)
+		}
+	}
+}
@@ -0,0 +1,488 @@
+package usecase
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/helper"
+	"chatspace-server/pkg/safehttp"
+	"chatspace-server/pkg/signer"
+	"chatspace-server/pkg/slashcmd"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type repoSlashCommandInterface interface {
+	Create(ctx context.Context, command *modelDB.SlashCommandDB, limit int) (bool, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*modelDB.SlashCommandDB, error)
+	GetByName(ctx context.Context, spaceID, name string) (*modelDB.SlashCommandDB, error)
+	GetBySpaceID(ctx context.Context, spaceID string) ([]*modelDB.SlashCommandDB, error)
+}
+
+type slashCommandClientInterface interface {
+	Invoke(ctx context.Context, url, secret string, request *slashcmd.Request) (*slashcmd.Response, error)
+}
+
+// commandInvocation is what a built-in command handler receives.
+type commandInvocation struct {
+	user    *modelDB.UserDB
+	spaceID string
+	role    string
+	args    string
+}
+
+// builtinCommands maps built-in command names to their descriptions.
+var builtinCommands = map[string]string{
+	"help":   "List the commands available in this space",
+	"me":     "Post an action, e.g. /me waves",
+	"shrug":  `Append ¯\_(ツ)_/¯ to your message`,
+	"topic":  "Show or set the space topic",
+	"invite": "Add a user to this space by mention or email (admins only)",
+	"remind": "Remind yourself later, e.g. /remind 2h check the build",
+}
+
+type UcSlashCommand struct {
+	repoSlashCommand     repoSlashCommandInterface
+	repoSpace            repoSpaceInterface
+	repoUser             repoUserInterface
+	repoScheduledMessage repoScheduledMessageInterface
+	publisher            messagePublisherInterface
+	webhooks             webhookDispatcherInterface
+	client               slashCommandClientInterface
+	zlog                 zerolog.Logger
+}
+
+func NewSlashCommandUseCase(
+	repoSlashCommand repoSlashCommandInterface,
+	repoSpace repoSpaceInterface,
+	repoUser repoUserInterface,
+	repoScheduledMessage repoScheduledMessageInterface,
+	publisher messagePublisherInterface,
+	webhooks webhookDispatcherInterface,
+	client slashCommandClientInterface,
+	zlog zerolog.Logger,
+) *UcSlashCommand {
+	return &UcSlashCommand{
+		repoSlashCommand:     repoSlashCommand,
+		repoSpace:            repoSpace,
+		repoUser:             repoUser,
+		repoScheduledMessage: repoScheduledMessage,
+		publisher:            publisher,
+		webhooks:             webhooks,
+		client:               client,
+		zlog:                 zlog,
+	}
+}
+
+// RunCommand runs a parsed slash command for userID in a space. Built-ins
+// take precedence over commands registered for the space. Failures of
+// external endpoints are reported to the invoker as an ephemeral response
+// rather than an error.
+func (uc *UcSlashCommand) RunCommand(ctx context.Context, userID, spaceID, name, args string) (*slashcmd.Response, error) {
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.repoUser.GetByID(ctx, userID)
+	if err != nil {
+		return nil, constant.ErrUserNotFound
+	}
+
+	inv := &commandInvocation{
+		user:    user,
+		spaceID: spaceID,
+		role:    role,
+		args:    args,
+	}
+
+	switch name {
+	case "help":
+		return uc.commandHelp(ctx, inv)
+	case "me":
+		return uc.commandMe(ctx, inv)
+	case "shrug":
+		return uc.commandShrug(ctx, inv)
+	case "topic":
+		return uc.commandTopic(ctx, inv)
+	case "invite":
+		return uc.commandInvite(ctx, inv)
+	case "remind":
+		return uc.commandRemind(ctx, inv)
+	}
+
+	command, err := uc.repoSlashCommand.GetByName(ctx, spaceID, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return slashcmd.Ephemeral("Unknown command /%s. Type /help to see the available commands.", name), nil
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("slash command"), err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constant.SLASH_COMMAND_TIMEOUT)
+	defer cancel()
+
+	resp, err := uc.client.Invoke(ctx, command.URL, command.Secret, &slashcmd.Request{
+		Command:  "/" + name,
+		Text:     args,
+		SpaceID:  spaceID,
+		UserID:   userID,
+		UserName: user.Name,
+	})
+	if err != nil {
+		uc.zlog.Warn().Err(err).Str("command", command.ID.String()).Msg("slash command endpoint failed")
+		return slashcmd.Ephemeral("/%s did not respond, try again later.", name), nil
+	}
+
+	if strings.TrimSpace(resp.Text) == "" {
+		return slashcmd.Ephemeral("/%s ran without a reply.", name), nil
+	}
+
+	return resp, nil
+}
+
+func (uc *UcSlashCommand) commandHelp(ctx context.Context, inv *commandInvocation) (*slashcmd.Response, error) {
+	commands, err := uc.slashCommands(ctx, inv.spaceID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(commands))
+	for _, c := range commands {
+		lines = append(lines, fmt.Sprintf("/%s - %s", c.Name, c.Description))
+	}
+
+	return slashcmd.Ephemeral("%s", strings.Join(lines, "\n")), nil
+}
+
+func (uc *UcSlashCommand) commandMe(ctx context.Context, inv *commandInvocation) (*slashcmd.Response, error) {
+	if inv.args == "" {
+		return slashcmd.Ephemeral("Usage: /me <action>"), nil
+	}
+
+	text := "*" + slashcmd.EscapeMarkdown(inv.user.Name+" "+inv.args) + "*"
+
+	return slashcmd.InChannel(text, slashcmd.FormatMarkdown), nil
+}
+
+func (uc *UcSlashCommand) commandShrug(ctx context.Context, inv *commandInvocation) (*slashcmd.Response, error) {
+	text := strings.TrimSpace(inv.args + ` ¯\_(ツ)_/¯`)
+
+	return slashcmd.InChannel(text, slashcmd.FormatPlain), nil
+}
+
+func (uc *UcSlashCommand) commandTopic(ctx context.Context, inv *commandInvocation) (*slashcmd.Response, error) {
+	if inv.args == "" {
+		space, err := uc.repoSpace.GetSpaceByID(ctx, inv.spaceID)
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("space"), err)
+		}
+
+		if space.Description == "" {
+			return slashcmd.Ephemeral("This space has no topic."), nil
+		}
+
+		return slashcmd.Ephemeral("Topic: %s", space.Description), nil
+	}
+
+	if inv.role != constant.ROLE_ADMIN {
+		return slashcmd.Ephemeral("Only space admins can change the topic."), nil
+	}
+
+	err := uc.repoSpace.UpdateDescription(ctx, inv.spaceID, inv.args)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrUpdatingField("space"), err)
+	}
+
+	text := inv.user.Name + " changed the topic to: " + inv.args
+
+	return slashcmd.InChannel(text, slashcmd.FormatPlain), nil
+}
+
+func (uc *UcSlashCommand) commandInvite(ctx context.Context, inv *commandInvocation) (*slashcmd.Response, error) {
+	// Spaces have no invite flow of their own, so adding members is an
+	// admin action like changing the topic.
+	if inv.role != constant.ROLE_ADMIN {
+		return slashcmd.Ephemeral("Only space admins can invite users."), nil
+	}
+
+	target := strings.TrimSuffix(strings.TrimPrefix(inv.args, "<@"), ">")
+	if target == "" {
+		return slashcmd.Ephemeral("Usage: /invite <@user> or /invite email@example.com"), nil
+	}
+
+	var (
+		user *modelDB.UserDB
+		err  error
+	)
+	if _, parseErr := uuid.Parse(target); parseErr == nil {
+		user, err = uc.repoUser.GetByID(ctx, target)
+	} else {
+		user, err = uc.repoUser.GetByEmail(ctx, target)
+	}
+	if err != nil || user == nil {
+		return slashcmd.Ephemeral("No user found for %s.", target), nil
+	}
+
+	blocked, err := blockedBetween(ctx, uc.repoUser, inv.user.ID.String(), user.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if blocked {
+		return slashcmd.Ephemeral("You can't invite %s.", user.Name), nil
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, inv.spaceID, user.ID.String())
+	if err == nil {
+		return slashcmd.Ephemeral("%s is already a member of this space.", user.Name), nil
+	}
+	if !errors.Is(err, constant.ErrNotSpaceMember) {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(inv.spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repoSpace.CreateSpaceMember(ctx, &modelDB.SpaceMemberDB{
+		UserID:  user.ID,
+		SpaceID: *spaceUUID,
+		Role:    constant.ROLE_MEMBER,
+	})
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("space member"), err)
+	}
+
+	err = publishSpaceEvent(ctx, uc.publisher, uc.webhooks, &model.SpaceEvent{
+		Type:    model.SpaceEventTypeMemberJoined,
+		SpaceID: inv.spaceID,
+		User:    &model.User{ID: user.ID.String(), Name: user.Name},
+	})
+	if err != nil {
+		uc.zlog.Warn().Err(err).Str("space", inv.spaceID).Msg("failed to publish member joined event")
+	}
+
+	return slashcmd.Ephemeral("Added %s to this space.", user.Name), nil
+}
+
+// commandRemind schedules an ephemeral message that the scheduler delivers
+// to the invoker's user stream.
+func (uc *UcSlashCommand) commandRemind(ctx context.Context, inv *commandInvocation) (*slashcmd.Response, error) {
+	durationArg, text, _ := strings.Cut(inv.args, " ")
+	text = strings.TrimSpace(text)
+
+	in, err := slashcmd.ParseDuration(durationArg)
+	if err != nil || in <= 0 || text == "" {
+		return slashcmd.Ephemeral("Usage: /remind <duration> <text>, e.g. /remind 1d2h review the release notes"), nil
+	}
+
+	spaceUUID, err := helper.StrToUUID(inv.spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	sendAt := time.Now().Add(in)
+	err = uc.repoScheduledMessage.Create(ctx, &modelDB.ScheduledMessageDB{
+		UserID:    inv.user.ID,
+		SpaceID:   *spaceUUID,
+		Content:   "Reminder: " + text,
+		Format:    slashcmd.FormatPlain,
+		Ephemeral: true,
+		SendAt:    sendAt,
+	})
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("reminder"), err)
+	}
+
+	return slashcmd.Ephemeral("I will remind you at %s.", sendAt.UTC().Format(time.RFC1123)), nil
+}
+
+// RegisterSlashCommand registers an external command for a space. The
+// signing secret is only returned here.
+func (uc *UcSlashCommand) RegisterSlashCommand(ctx context.Context, spaceID string, request model.SlashCommandRequest) (*model.SlashCommandRegistration, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	name := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(request.Name), "/"))
+	if !slashcmd.ValidName(name) {
+		return nil, constant.ErrInvalidSlashCommandName
+	}
+
+	if _, ok := builtinCommands[name]; ok {
+		return nil, constant.ErrSlashCommandBuiltin
+	}
+
+	u, err := url.Parse(strings.TrimSpace(request.URL))
+	if err != nil || !safehttp.IsHTTP(u) {
+		return nil, constant.ErrInvalidSlashCommandURL
+	}
+
+	secret, err := signer.NewSecret()
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("slash command secret"), err)
+	}
+
+	command := &modelDB.SlashCommandDB{
+		SpaceID:   *spaceUUID,
+		Name:      name,
+		URL:       u.String(),
+		Secret:    secret,
+		CreatedBy: *userUUID,
+	}
+
+	if request.Description != nil {
+		command.Description = strings.TrimSpace(*request.Description)
+	}
+
+	created, err := uc.repoSlashCommand.Create(ctx, command, constant.SLASH_COMMAND_MAX_PER_SPACE)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("slash command"), err)
+	}
+
+	if !created {
+		existing, err := uc.repoSlashCommand.GetByName(ctx, spaceID, name)
+		if err == nil && existing != nil {
+			return nil, constant.ErrSlashCommandExists
+		}
+		return nil, constant.ErrSlashCommandLimitReached
+	}
+
+	return &model.SlashCommandRegistration{
+		Command: toSlashCommandModel(command, true),
+		Secret:  secret,
+	}, nil
+}
+
+func (uc *UcSlashCommand) DeleteSlashCommand(ctx context.Context, id string) (bool, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = helper.StrToUUID(id)
+	if err != nil {
+		return false, err
+	}
+
+	command, err := uc.repoSlashCommand.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, constant.ErrSlashCommandNotFound
+		}
+		return false, constant.ErrWithMsg(constant.ErrGetField("slash command"), err)
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, command.SpaceID.String(), userID)
+	if err != nil {
+		return false, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return false, constant.ErrNotSpaceAdmin
+	}
+
+	err = uc.repoSlashCommand.Delete(ctx, id)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrDeletingField("slash command"), err)
+	}
+
+	return true, nil
+}
+
+// SlashCommands lists the built-in and registered commands of a space.
+// Endpoint URLs are only shown to admins.
+func (uc *UcSlashCommand) SlashCommands(ctx context.Context, spaceID string) ([]*model.SlashCommand, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.slashCommands(ctx, spaceID, role == constant.ROLE_ADMIN)
+}
+
+func (uc *UcSlashCommand) slashCommands(ctx context.Context, spaceID string, withURL bool) ([]*model.SlashCommand, error) {
+	resp := []*model.SlashCommand{}
+	for name, description := range builtinCommands {
+		resp = append(resp, &model.SlashCommand{
+			ID:          name,
+			SpaceID:     spaceID,
+			Name:        name,
+			Description: description,
+			Builtin:     true,
+		})
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Name < resp[j].Name
+	})
+
+	commands, err := uc.repoSlashCommand.GetBySpaceID(ctx, spaceID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("slash commands"), err)
+	}
+
+	for _, c := range commands {
+		resp = append(resp, toSlashCommandModel(c, withURL))
+	}
+
+	return resp, nil
+}
+
+func toSlashCommandModel(command *modelDB.SlashCommandDB, withURL bool) *model.SlashCommand {
+	resp := &model.SlashCommand{
+		ID:          command.ID.String(),
+		SpaceID:     command.SpaceID.String(),
+		Name:        command.Name,
+		Description: command.Description,
+	}
+
+	if withURL {
+		resp.URL = &command.URL
+	}
+
+	return resp
+}
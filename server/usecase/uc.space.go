@@ -2,12 +2,16 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"chatspace-server/config"
 	"chatspace-server/constant"
 	"chatspace-server/graph/model"
 	modelDB "chatspace-server/model"
 	"chatspace-server/pkg/authctx"
 	"chatspace-server/pkg/gqlhelper"
 	"chatspace-server/pkg/helper"
+	"strings"
 
 	"github.com/rs/zerolog"
 )
@@ -19,16 +23,47 @@ type repoSpaceInterface interface {
 	GetSpaceMember(ctx context.Context, spaceID string) ([]*modelDB.SpaceMemberDB, error)
 	GetMemberBySpaceID(ctx context.Context, spaceID, role string) ([]*modelDB.UserDB, error)
 	GetSpaces(ctx context.Context) ([]*modelDB.SpaceDB, error)
+	GetSpacesByUserID(ctx context.Context, userID string) ([]*modelDB.SpaceDB, error)
+	GetSpaceStats(ctx context.Context, spaceID, userID string) (*modelDB.SpaceStatsDB, error)
+	SearchSpaces(ctx context.Context, params *modelDB.SpaceSearchParams) ([]*modelDB.SpaceSearchDB, error)
+	GetMemberRole(ctx context.Context, spaceID, userID string) (string, error)
+	GetAttachmentPolicy(ctx context.Context, spaceID string) (*modelDB.AttachmentPolicyDB, error)
+	UpdateAttachmentPolicy(ctx context.Context, spaceID string, policy *modelDB.AttachmentPolicyDB) error
+	GetMessageTTL(ctx context.Context, spaceID string) (*int, error)
+	UpdateMessageTTL(ctx context.Context, spaceID string, ttl *int) error
+	GetRetentionPolicy(ctx context.Context, spaceID string) (*modelDB.RetentionPolicyDB, error)
+	GetRetentionPolicies(ctx context.Context) ([]*modelDB.RetentionPolicyDB, error)
+	UpdateRetentionPolicy(ctx context.Context, policy *modelDB.RetentionPolicyDB) error
+	UpdateDescription(ctx context.Context, spaceID, description string) error
+	UpdateLastReadSeq(ctx context.Context, spaceID, userID string, seq int64) error
+}
+
+// txManagerInterface runs repository calls made with the ctx passed to fn
+// as one unit of work. AfterCommit defers fn until that unit commits.
+type txManagerInterface interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
+}
+
+type spaceMessageInterface interface {
+	PinnedMessages(ctx context.Context, spaceID, prefix string) ([]*model.Message, error)
+	PublishSpaceEvent(ctx context.Context, event *model.SpaceEvent) error
 }
 
 type UcSpace struct {
+	cfg       *config.Config
 	repoSpace repoSpaceInterface
+	ucMessage spaceMessageInterface
+	txManager txManagerInterface
 	zlog      zerolog.Logger
 }
 
-func NewSpaceUseCase(repoSpace repoSpaceInterface, zlog zerolog.Logger) *UcSpace {
+func NewSpaceUseCase(cfg *config.Config, repoSpace repoSpaceInterface, ucMessage spaceMessageInterface, txManager txManagerInterface, zlog zerolog.Logger) *UcSpace {
 	return &UcSpace{
+		cfg:       cfg,
 		repoSpace: repoSpace,
+		ucMessage: ucMessage,
+		txManager: txManager,
 		zlog:      zlog,
 	}
 }
@@ -43,35 +78,40 @@ func (uc *UcSpace) CreateSpace(ctx context.Context, request model.SpaceRequest)
 		return nil, constant.ErrMissingField("name")
 	}
 
-	payload := &modelDB.SpaceDB{
-		Name:        request.Name,
-		Description: *request.Description,
-	}
-
-	spaceID, err := uc.repoSpace.Create(ctx, payload)
-	if err != nil {
-		return nil, constant.ErrWithMsg(constant.ErrCreatingField("space"), err)
-	}
-
 	userUUID, err := helper.StrToUUID(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	spaceUUID, err := helper.StrToUUID(*spaceID)
-	if err != nil {
-		return nil, err
+	payload := &modelDB.SpaceDB{
+		Name:        request.Name,
+		Description: *request.Description,
 	}
 
-	memberPayload := &modelDB.SpaceMemberDB{
-		UserID:  *userUUID,
-		SpaceID: *spaceUUID,
-		Role:    constant.ROLE_ADMIN,
-	}
+	// The space and its first admin are stored together so a failure can
+	// never leave a space nobody administers.
+	var spaceID *string
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		spaceID, err = uc.repoSpace.Create(ctx, payload)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrCreatingField("space"), err)
+		}
+
+		memberPayload := &modelDB.SpaceMemberDB{
+			UserID:  *userUUID,
+			SpaceID: payload.ID,
+			Role:    constant.ROLE_ADMIN,
+		}
+
+		err = uc.repoSpace.CreateSpaceMember(ctx, memberPayload)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrCreatingField("space member"), err)
+		}
 
-	err = uc.repoSpace.CreateSpaceMember(ctx, memberPayload)
+		return nil
+	})
 	if err != nil {
-		return nil, constant.ErrWithMsg(constant.ErrCreatingField("space member"), err)
+		return nil, err
 	}
 
 	resp := &model.Space{
@@ -114,6 +154,15 @@ func (uc *UcSpace) JoinSpace(ctx context.Context, spaceID string) (*model.Space,
 		return nil, constant.ErrWithMsg(constant.ErrGetField("space"), err)
 	}
 
+	err = uc.ucMessage.PublishSpaceEvent(ctx, &model.SpaceEvent{
+		Type:    model.SpaceEventTypeMemberJoined,
+		SpaceID: spaceID,
+		User:    &model.User{ID: userID},
+	})
+	if err != nil {
+		uc.zlog.Warn().Err(err).Str("space", spaceID).Msg("failed to publish member joined event")
+	}
+
 	resp := &model.Space{
 		ID:          space.ID.String(),
 		Name:        space.Name,
@@ -123,8 +172,111 @@ func (uc *UcSpace) JoinSpace(ctx context.Context, spaceID string) (*model.Space,
 	return resp, nil
 }
 
+// UpdateSpaceMessageTTL sets the default lifetime of new messages in a
+// space. A nil ttl keeps messages forever.
+func (uc *UcSpace) UpdateSpaceMessageTTL(ctx context.Context, spaceID string, ttl *int32) (*model.Space, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	var payload *int
+	if ttl != nil {
+		if *ttl <= 0 || *ttl > constant.MAX_MESSAGE_TTL {
+			return nil, constant.ErrInvalidMessageTTL
+		}
+		value := int(*ttl)
+		payload = &value
+	}
+
+	err = uc.repoSpace.UpdateMessageTTL(ctx, spaceID, payload)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrUpdatingField("space"), err)
+	}
+
+	return uc.Space(ctx, spaceID)
+}
+
+// UpdateRetentionPolicy sets how long a space keeps its messages. DEFAULT
+// follows the server-wide policy from config.
+func (uc *UcSpace) UpdateRetentionPolicy(ctx context.Context, spaceID string, request model.RetentionPolicyRequest) (*model.Space, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	payload := &modelDB.RetentionPolicyDB{
+		SpaceID: *spaceUUID,
+		Mode:    strings.ToLower(request.Mode.String()),
+	}
+
+	if payload.Mode == constant.RETENTION_MODE_DAYS || payload.Mode == constant.RETENTION_MODE_MESSAGES {
+		if request.Value == nil {
+			return nil, constant.ErrMissingField("value")
+		}
+
+		value := int(*request.Value)
+		err = validRetention(payload.Mode, value)
+		if err != nil {
+			return nil, err
+		}
+		payload.Value = &value
+	}
+
+	err = uc.repoSpace.UpdateRetentionPolicy(ctx, payload)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrUpdatingField("retention policy"), err)
+	}
+
+	return uc.Space(ctx, spaceID)
+}
+
+// MarkSpaceRead moves the caller's read marker in a space to seq. Marking
+// an older seq than the current marker is a no-op.
+func (uc *UcSpace) MarkSpaceRead(ctx context.Context, spaceID string, seq int) (*model.Space, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repoSpace.UpdateLastReadSeq(ctx, spaceID, userID, int64(seq))
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrUpdatingField("read marker"), err)
+	}
+
+	return uc.Space(ctx, spaceID)
+}
+
 func (uc *UcSpace) Spaces(ctx context.Context) ([]*model.Space, error) {
-	_, err := authctx.GetAuthUserID(ctx)
+	userID, err := authctx.GetAuthUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +290,14 @@ func (uc *UcSpace) Spaces(ctx context.Context) ([]*model.Space, error) {
 	for _, s := range spaces {
 		temp, err := uc.PopulateSpaceField(ctx, *s)
 		if err != nil {
-			uc.zlog.Error().Err(err)
+			uc.zlog.Error().Err(err).Str("space", s.ID.String()).Msg("failed to populate space")
+			continue
+		}
+
+		err = uc.populateSpaceStats(ctx, temp, userID)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("space", s.ID.String()).Msg("failed to load space stats")
+			continue
 		}
 
 		resp = append(resp, temp)
@@ -148,7 +307,7 @@ func (uc *UcSpace) Spaces(ctx context.Context) ([]*model.Space, error) {
 }
 
 func (uc *UcSpace) Space(ctx context.Context, id string) (*model.Space, error) {
-	_, err := authctx.GetAuthUserID(ctx)
+	userID, err := authctx.GetAuthUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -163,10 +322,126 @@ func (uc *UcSpace) Space(ctx context.Context, id string) (*model.Space, error) {
 		return nil, err
 	}
 
+	err = uc.populateSpaceStats(ctx, resp, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (uc *UcSpace) MySpaces(ctx context.Context) ([]*model.Space, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spaces, err := uc.repoSpace.GetSpacesByUserID(ctx, userID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("spaces"), err)
+	}
+
+	resp := []*model.Space{}
+	for _, s := range spaces {
+		temp, err := uc.PopulateSpaceField(ctx, *s)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("space", s.ID.String()).Msg("failed to populate space")
+			continue
+		}
+
+		err = uc.populateSpaceStats(ctx, temp, userID)
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("space", s.ID.String()).Msg("failed to load space stats")
+			continue
+		}
+
+		resp = append(resp, temp)
+	}
+
+	return resp, nil
+}
+
+func (uc *UcSpace) SearchSpaces(ctx context.Context, query *string, first *int32, after *string, sort *model.SpaceSort) (*model.SpaceConnection, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := helper.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &modelDB.SpaceSearchParams{
+		UserID: *userUUID,
+		Sort:   constant.SPACE_SORT_CREATED,
+		Limit:  helper.PageSize(first, constant.DEFAULT_PAGE_SIZE, constant.MAX_PAGE_SIZE),
+		Offset: offset,
+	}
+
+	if query != nil {
+		params.Query = strings.TrimSpace(*query)
+	}
+
+	switch {
+	case sort != nil:
+		params.Sort = strings.ToLower(sort.String())
+	case params.Query != "":
+		params.Sort = constant.SPACE_SORT_RELEVANCE
+	}
+
+	limit := params.Limit
+	params.Limit++
+
+	spaces, err := uc.repoSpace.SearchSpaces(ctx, params)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("spaces"), err)
+	}
+
+	hasNextPage := len(spaces) > limit
+	if hasNextPage {
+		spaces = spaces[:limit]
+	}
+
+	nodes := []*model.Space{}
+	for _, s := range spaces {
+		temp, err := uc.populateSpaceField(ctx, s.SpaceDB, "nodes")
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("space", s.ID.String()).Msg("failed to populate space")
+			continue
+		}
+
+		temp.MemberCount = int32(s.MemberCount)
+		temp.LastActivityAt = s.LastActivityAt
+		temp.IsMember = s.IsMember
+		temp.LastReadSeq = int(s.LastReadSeq)
+		temp.UnreadCount = int32(s.UnreadCount)
+		nodes = append(nodes, temp)
+	}
+
+	resp := &model.SpaceConnection{
+		Nodes:    nodes,
+		PageInfo: &model.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	if len(spaces) > 0 {
+		endCursor := helper.EncodeCursor(offset + len(spaces))
+		resp.PageInfo.EndCursor = &endCursor
+	}
+
 	return resp, nil
 }
 
 func (uc *UcSpace) PopulateSpaceField(ctx context.Context, space modelDB.SpaceDB) (*model.Space, error) {
+	return uc.populateSpaceField(ctx, space, "")
+}
+
+func (uc *UcSpace) populateSpaceField(ctx context.Context, space modelDB.SpaceDB, prefix string) (*model.Space, error) {
 	spaceID := space.ID.String()
 	resp := &model.Space{
 		ID:          spaceID,
@@ -174,7 +449,7 @@ func (uc *UcSpace) PopulateSpaceField(ctx context.Context, space modelDB.SpaceDB
 		Description: &space.Description,
 	}
 
-	if gqlhelper.IsCalled(ctx, "members") {
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "members")) {
 		members, err := uc.repoSpace.GetMemberBySpaceID(ctx, spaceID, constant.ROLE_MEMBER)
 		if err != nil {
 			return nil, constant.ErrWithMsg(constant.ErrGetField("member"), err)
@@ -193,7 +468,7 @@ func (uc *UcSpace) PopulateSpaceField(ctx context.Context, space modelDB.SpaceDB
 		resp.Members = respMembers
 	}
 
-	if gqlhelper.IsCalled(ctx, "admins") {
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "admins")) {
 		admins, err := uc.repoSpace.GetMemberBySpaceID(ctx, spaceID, constant.ROLE_ADMIN)
 		if err != nil {
 			return nil, constant.ErrWithMsg(constant.ErrGetField("member"), err)
@@ -212,5 +487,69 @@ func (uc *UcSpace) PopulateSpaceField(ctx context.Context, space modelDB.SpaceDB
 		resp.Admins = respAdmins
 	}
 
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "messageTTL")) {
+		ttl, err := uc.repoSpace.GetMessageTTL(ctx, spaceID)
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("space"), err)
+		}
+
+		if ttl != nil {
+			value := int32(*ttl)
+			resp.MessageTTL = &value
+		}
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "retentionPolicy")) {
+		policy, err := uc.repoSpace.GetRetentionPolicy(ctx, spaceID)
+		if err != nil {
+			return nil, constant.ErrWithMsg(constant.ErrGetField("retention policy"), err)
+		}
+
+		resp.RetentionPolicy = toRetentionPolicyModel(uc.cfg, policy)
+	}
+
+	if gqlhelper.IsCalled(ctx, gqlhelper.GetPreloadString(prefix, "pinnedMessages")) {
+		pinned, err := uc.ucMessage.PinnedMessages(ctx, spaceID, gqlhelper.GetPreloadString(prefix, "pinnedMessages"))
+		if err != nil {
+			return nil, err
+		}
+
+		resp.PinnedMessages = pinned
+	}
+
 	return resp, nil
 }
+
+func (uc *UcSpace) populateSpaceStats(ctx context.Context, space *model.Space, userID string) error {
+	if !gqlhelper.IsCalled(ctx, "memberCount") && !gqlhelper.IsCalled(ctx, "lastActivityAt") && !gqlhelper.IsCalled(ctx, "isMember") &&
+		!gqlhelper.IsCalled(ctx, "lastReadSeq") && !gqlhelper.IsCalled(ctx, "unreadCount") {
+		return nil
+	}
+
+	stats, err := uc.repoSpace.GetSpaceStats(ctx, space.ID, userID)
+	if err != nil {
+		return constant.ErrWithMsg(constant.ErrGetField("space stats"), err)
+	}
+
+	space.MemberCount = int32(stats.MemberCount)
+	space.LastActivityAt = stats.LastActivityAt
+	space.IsMember = stats.IsMember
+	space.LastReadSeq = int(stats.LastReadSeq)
+	space.UnreadCount = int32(stats.UnreadCount)
+
+	return nil
+}
+
+// spaceMemberRole returns the role of userID in spaceID, or
+// ErrNotSpaceMember when the user has not joined the space.
+func spaceMemberRole(ctx context.Context, repoSpace repoSpaceInterface, spaceID, userID string) (string, error) {
+	role, err := repoSpace.GetMemberRole(ctx, spaceID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", constant.ErrNotSpaceMember
+		}
+		return "", constant.ErrWithMsg(constant.ErrGetField("space member"), err)
+	}
+
+	return role, nil
+}
@@ -9,9 +9,15 @@ import (
 	"chatspace-server/graph/model"
 	modelDB "chatspace-server/model"
 	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/helper"
+	"chatspace-server/pkg/safehttp"
+	"net/url"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -20,19 +26,28 @@ type repoUserInterface interface {
 	Create(ctx context.Context, user *modelDB.UserDB) (*string, error)
 	GetByID(ctx context.Context, id string) (*modelDB.UserDB, error)
 	GetByEmail(ctx context.Context, email string) (*modelDB.UserDB, error)
+	BlockUser(ctx context.Context, block *modelDB.UserBlockDB) error
+	UnblockUser(ctx context.Context, blockerID, blockedID string) error
+	GetBlockedUsers(ctx context.Context, blockerID string) ([]*modelDB.UserDB, error)
+	GetBlockedUserIDs(ctx context.Context, blockerID string) ([]uuid.UUID, error)
+	SearchUsers(ctx context.Context, params *modelDB.UserSearchParams) ([]*modelDB.UserSearchDB, error)
+	CreateBot(ctx context.Context, user *modelDB.UserDB, spaceID uuid.UUID) error
+	RotateBotToken(ctx context.Context, botID, spaceID string) (*modelDB.UserDB, error)
 }
 
 type UcUser struct {
-	cfg      *config.Config
-	repoUser repoUserInterface
-	zlog     zerolog.Logger
+	cfg       *config.Config
+	repoUser  repoUserInterface
+	repoSpace repoSpaceInterface
+	zlog      zerolog.Logger
 }
 
-func NewUserUsecase(cfg *config.Config, repoUser repoUserInterface, zlog zerolog.Logger) *UcUser {
+func NewUserUsecase(cfg *config.Config, repoUser repoUserInterface, repoSpace repoSpaceInterface, zlog zerolog.Logger) *UcUser {
 	return &UcUser{
-		cfg:      cfg,
-		repoUser: repoUser,
-		zlog:     zlog,
+		cfg:       cfg,
+		repoUser:  repoUser,
+		repoSpace: repoSpace,
+		zlog:      zlog,
 	}
 }
 
@@ -89,24 +104,50 @@ func (uc *UcUser) Login(ctx context.Context, request model.LoginRequest) (*model
 }
 
 func (uc *UcUser) RefreshToken(ctx context.Context, request model.RefreshRequest) (*model.AuthResponse, error) {
-	token, err := jwt.Parse(request.RefreshToken, func(token *jwt.Token) (interface{}, error) {
-		return []byte(uc.cfg.Settings.JWTSecret), nil
-	})
-	if err != nil || !token.Valid {
+	claims, err := uc.parseJWT(request.RefreshToken, constant.TOKEN_TYPE_REFRESH)
+	if err != nil {
 		return nil, constant.ErrInvalidRefreshToken
 	}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
+	userID, ok := claims["sub"].(string)
 	if !ok {
-		return nil, constant.ErrInvalidClaims
+		return nil, constant.ErrInvalidSubject
 	}
 
-	userID, ok := claims["sub"].(string)
+	return uc.generateAuthResponse(userID)
+}
+
+// BotAccessToken exchanges a bot token for a short-lived access token. The
+// token must carry the bot's current token version, so rotating it takes
+// effect at the next exchange. No refresh token is returned: bots exchange
+// their bot token again instead.
+func (uc *UcUser) BotAccessToken(ctx context.Context, token string) (*model.AuthResponse, error) {
+	claims, err := uc.parseJWT(token, constant.TOKEN_TYPE_BOT)
+	if err != nil {
+		return nil, constant.ErrInvalidBotToken
+	}
+
+	botID, ok := claims["sub"].(string)
 	if !ok {
 		return nil, constant.ErrInvalidSubject
 	}
 
-	return uc.generateAuthResponse(userID)
+	version, ok := claims["ver"].(float64)
+	if !ok {
+		return nil, constant.ErrInvalidClaims
+	}
+
+	bot, err := uc.repoUser.GetByID(ctx, botID)
+	if err != nil || !bot.Bot || float64(bot.TokenVersion) != version {
+		return nil, constant.ErrInvalidBotToken
+	}
+
+	accessToken, err := uc.generateJWT(botID, false)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGeneratingJWT, err)
+	}
+
+	return &model.AuthResponse{Token: accessToken}, nil
 }
 
 func (uc *UcUser) User(ctx context.Context) (*model.User, error) {
@@ -129,6 +170,297 @@ func (uc *UcUser) User(ctx context.Context) (*model.User, error) {
 	return resp, nil
 }
 
+func (uc *UcUser) BlockUser(ctx context.Context, userID string) (*model.User, error) {
+	authUserID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if authUserID == userID {
+		return nil, constant.ErrBlockSelf
+	}
+
+	blockerUUID, err := helper.StrToUUID(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := uc.repoUser.GetByID(ctx, userID)
+	if err != nil {
+		return nil, constant.ErrUserNotFound
+	}
+
+	payload := &modelDB.UserBlockDB{
+		BlockerID: *blockerUUID,
+		BlockedID: blocked.ID,
+	}
+
+	err = uc.repoUser.BlockUser(ctx, payload)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("user block"), err)
+	}
+
+	resp := &model.User{
+		ID:   blocked.ID.String(),
+		Name: blocked.Name,
+	}
+
+	return resp, nil
+}
+
+func (uc *UcUser) UnblockUser(ctx context.Context, userID string) (bool, error) {
+	authUserID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = helper.StrToUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.repoUser.UnblockUser(ctx, authUserID, userID)
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrDeletingField("user block"), err)
+	}
+
+	return true, nil
+}
+
+func (uc *UcUser) BlockedUsers(ctx context.Context) ([]*model.User, error) {
+	authUserID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := uc.repoUser.GetBlockedUsers(ctx, authUserID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("blocked users"), err)
+	}
+
+	resp := []*model.User{}
+	for _, u := range users {
+		temp := &model.User{
+			ID:   u.ID.String(),
+			Name: u.Name,
+		}
+		resp = append(resp, temp)
+	}
+
+	return resp, nil
+}
+
+// blockedBetween reports whether either user has blocked the other.
+func blockedBetween(ctx context.Context, repoUser repoUserInterface, userID, otherID string) (bool, error) {
+	for _, pair := range [][2]string{{userID, otherID}, {otherID, userID}} {
+		ids, err := repoUser.GetBlockedUserIDs(ctx, pair[0])
+		if err != nil {
+			return false, constant.ErrWithMsg(constant.ErrGetField("blocked users"), err)
+		}
+
+		for _, id := range ids {
+			if id.String() == pair[1] {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (uc *UcUser) SearchUsers(ctx context.Context, query string, first *int32, after *string) (*model.UserSearchConnection, error) {
+	authUserID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, constant.ErrEmptySearchQuery
+	}
+
+	userUUID, err := helper.StrToUUID(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := helper.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := helper.PageSize(first, constant.DEFAULT_PAGE_SIZE, constant.MAX_PAGE_SIZE)
+	params := &modelDB.UserSearchParams{
+		UserID: *userUUID,
+		Query:  query,
+		Limit:  limit + 1,
+		Offset: offset,
+	}
+
+	users, err := uc.repoUser.SearchUsers(ctx, params)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("users"), err)
+	}
+
+	hasNextPage := len(users) > limit
+	if hasNextPage {
+		users = users[:limit]
+	}
+
+	edges := []*model.UserSearchResult{}
+	for _, u := range users {
+		temp := &model.User{
+			ID:   u.ID.String(),
+			Name: u.Name,
+		}
+
+		// email is only revealed to someone who already knows the exact address
+		if u.EmailMatch {
+			temp.Email = u.Email
+		}
+
+		edges = append(edges, &model.UserSearchResult{
+			User:             temp,
+			SharedSpaceCount: int32(u.SharedSpaceCount),
+		})
+	}
+
+	resp := &model.UserSearchConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	if len(edges) > 0 {
+		endCursor := helper.EncodeCursor(offset + len(edges))
+		resp.PageInfo.EndCursor = &endCursor
+	}
+
+	return resp, nil
+}
+
+// CreateBot creates a bot user that is a member of the space and returns a
+// long-lived bot token for it. Bots exchange the token through
+// botAccessToken for short-lived access tokens; it is not accepted by
+// refreshToken or as an access token itself.
+func (uc *UcUser) CreateBot(ctx context.Context, spaceID string, name string, avatar *string) (*model.BotRegistration, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	name, avatarURL, err := botProfile(name, avatar)
+	if err != nil {
+		return nil, err
+	}
+
+	bot := &modelDB.UserDB{
+		Email:     "bot+" + uuid.NewString() + "@" + constant.BOT_EMAIL_DOMAIN,
+		Name:      name,
+		AvatarURL: avatarURL,
+	}
+
+	err = uc.repoUser.CreateBot(ctx, bot, *spaceUUID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("bot"), err)
+	}
+
+	return uc.botRegistration(bot)
+}
+
+// RotateBotToken revokes every token of a bot in the space and returns a
+// new one. Only space admins can rotate. Access tokens already exchanged
+// stay valid until they expire.
+func (uc *UcUser) RotateBotToken(ctx context.Context, spaceID, botID string) (*model.BotRegistration, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = helper.StrToUUID(botID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return nil, constant.ErrNotSpaceAdmin
+	}
+
+	bot, err := uc.repoUser.RotateBotToken(ctx, botID, spaceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrBotNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrUpdatingField("bot"), err)
+	}
+
+	return uc.botRegistration(bot)
+}
+
+// botRegistration signs a bot token for the bot's current token version.
+func (uc *UcUser) botRegistration(bot *modelDB.UserDB) (*model.BotRegistration, error) {
+	token, err := uc.signJWT(jwt.MapClaims{
+		"sub": bot.ID.String(),
+		"typ": constant.TOKEN_TYPE_BOT,
+		"ver": bot.TokenVersion,
+	}, constant.BOT_TOKEN_DURATION)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGeneratingJWT, err)
+	}
+
+	return &model.BotRegistration{
+		Bot: &model.User{
+			ID:        bot.ID.String(),
+			Email:     bot.Email,
+			Name:      bot.Name,
+			Bot:       true,
+			AvatarURL: bot.AvatarURL,
+		},
+		Token: token,
+	}, nil
+}
+
+// botProfile validates the display name and optional avatar URL of a bot
+// identity. Bots never log in: they are created without a password hash,
+// which fails every bcrypt comparison.
+func botProfile(name string, avatar *string) (string, *string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > constant.BOT_NAME_MAX_LENGTH {
+		return "", nil, constant.ErrInvalidBotName
+	}
+
+	if avatar == nil || strings.TrimSpace(*avatar) == "" {
+		return name, nil, nil
+	}
+
+	u, err := url.Parse(strings.TrimSpace(*avatar))
+	if err != nil || !safehttp.IsHTTP(u) {
+		return "", nil, constant.ErrInvalidAvatarURL
+	}
+
+	avatarURL := u.String()
+
+	return name, &avatarURL, nil
+}
+
 func (uc *UcUser) generateAuthResponse(userID string) (*model.AuthResponse, error) {
 	accessToken, err := uc.generateJWT(userID, false)
 	if err != nil {
@@ -147,19 +479,37 @@ func (uc *UcUser) generateAuthResponse(userID string) (*model.AuthResponse, erro
 }
 
 func (uc *UcUser) generateJWT(userID string, isRefreshToken bool) (string, error) {
+	tokenType := constant.TOKEN_TYPE_ACCESS
 	tokenDuration := uc.cfg.Settings.TokenDuration
 	if isRefreshToken {
+		tokenType = constant.TOKEN_TYPE_REFRESH
 		tokenDuration = uc.cfg.Settings.RefreshTokenDuration
 	}
 
-	duration := time.Hour * time.Duration(tokenDuration)
+	return uc.signJWT(jwt.MapClaims{"sub": userID, "typ": tokenType}, time.Hour*time.Duration(tokenDuration))
+}
 
-	claims := jwt.MapClaims{
-		"sub": userID,
-		"exp": time.Now().Add(duration).Unix(),
-		"iat": time.Now().Unix(),
-	}
+func (uc *UcUser) signJWT(claims jwt.MapClaims, duration time.Duration) (string, error) {
+	claims["exp"] = time.Now().Add(duration).Unix()
+	claims["iat"] = time.Now().Unix()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(uc.cfg.Settings.JWTSecret))
 }
+
+// parseJWT verifies a token and checks that it is of the given type.
+func (uc *UcUser) parseJWT(tokenString, tokenType string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(uc.cfg.Settings.JWTSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, constant.ErrInvalidClaims
+	}
+
+	if claims["typ"] != tokenType {
+		return nil, constant.ErrInvalidClaims
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"chatspace-server/config"
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	"chatspace-server/handler/middleware"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func TestBotTokens(t *testing.T) {
+	cfg := &config.Config{Settings: config.Settings{JWTSecret: "secret", TokenDuration: 1, RefreshTokenDuration: 24}}
+	spaceID, admin, member := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	repoSpace := &fakeRepoSpace{roles: map[[2]string]string{
+		{spaceID, admin}:  constant.ROLE_ADMIN,
+		{spaceID, member}: constant.ROLE_MEMBER,
+	}}
+	uc := NewUserUsecase(cfg, &fakeRepoUser{}, repoSpace, zerolog.Nop())
+	ctx := context.Background()
+
+	registration, err := uc.CreateBot(authed(admin), spaceID, "deploy bot", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	botID, botToken := registration.Bot.ID, registration.Token
+
+	_, err = uc.RefreshToken(ctx, model.RefreshRequest{RefreshToken: botToken})
+	if !errors.Is(err, constant.ErrInvalidRefreshToken) {
+		t.Fatalf("RefreshToken() with a bot token error = %v, want %v", err, constant.ErrInvalidRefreshToken)
+	}
+
+	if _, ok := middleware.AccessTokenUserID(botToken, cfg.Settings.JWTSecret); ok {
+		t.Fatal("bot token accepted as an access token")
+	}
+
+	exchange := func(token string) (string, error) {
+		t.Helper()
+
+		resp, err := uc.BotAccessToken(ctx, token)
+		if err != nil {
+			return "", err
+		}
+
+		if resp.RefreshToken != nil {
+			t.Fatal("BotAccessToken() returned a refresh token")
+		}
+
+		userID, ok := middleware.AccessTokenUserID(resp.Token, cfg.Settings.JWTSecret)
+		if !ok {
+			t.Fatal("BotAccessToken() returned a token the middleware rejects")
+		}
+
+		return userID, nil
+	}
+
+	userID, err := exchange(botToken)
+	if err != nil || userID != botID {
+		t.Fatalf("BotAccessToken() = %q, %v, want an access token for %s", userID, err, botID)
+	}
+
+	_, err = uc.RotateBotToken(authed(member), spaceID, botID)
+	if !errors.Is(err, constant.ErrNotSpaceAdmin) {
+		t.Fatalf("RotateBotToken() by a member error = %v, want %v", err, constant.ErrNotSpaceAdmin)
+	}
+
+	_, err = uc.RotateBotToken(authed(admin), uuid.NewString(), botID)
+	if !errors.Is(err, constant.ErrNotSpaceMember) {
+		t.Fatalf("RotateBotToken() in another space error = %v, want %v", err, constant.ErrNotSpaceMember)
+	}
+
+	rotated, err := uc.RotateBotToken(authed(admin), spaceID, botID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = exchange(botToken)
+	if !errors.Is(err, constant.ErrInvalidBotToken) {
+		t.Fatalf("BotAccessToken() with a rotated token error = %v, want %v", err, constant.ErrInvalidBotToken)
+	}
+
+	userID, err = exchange(rotated.Token)
+	if err != nil || userID != botID {
+		t.Fatalf("BotAccessToken() with the new token = %q, %v, want an access token for %s", userID, err, botID)
+	}
+
+	// A user's refresh token is not a bot token either.
+	auth, err := uc.generateAuthResponse(admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = uc.BotAccessToken(ctx, *auth.RefreshToken)
+	if !errors.Is(err, constant.ErrInvalidBotToken) {
+		t.Fatalf("BotAccessToken() with a refresh token error = %v, want %v", err, constant.ErrInvalidBotToken)
+	}
+}
@@ -0,0 +1,498 @@
+package usecase
+
+import (
+	"chatspace-server/constant"
+	"chatspace-server/graph/model"
+	modelDB "chatspace-server/model"
+	"chatspace-server/pkg/authctx"
+	"chatspace-server/pkg/helper"
+	"chatspace-server/pkg/safehttp"
+	"chatspace-server/pkg/signer"
+	"chatspace-server/pkg/webhook"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type repoWebhookInterface interface {
+	Create(ctx context.Context, webhook *modelDB.WebhookDB, limit int) (bool, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*modelDB.WebhookDB, error)
+	GetBySpaceID(ctx context.Context, spaceID string) ([]*modelDB.WebhookDB, error)
+	GetSubscribed(ctx context.Context, spaceID, eventType string) ([]*modelDB.WebhookDB, error)
+	CreateDelivery(ctx context.Context, delivery *modelDB.WebhookDeliveryDB) error
+	GetDeliveryByID(ctx context.Context, id string) (*modelDB.WebhookDeliveryDB, error)
+	GetDeliveries(ctx context.Context, webhookID string, limit, offset int) ([]*modelDB.WebhookDeliveryDB, error)
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*modelDB.WebhookJobDB, error)
+	RecordAttempt(ctx context.Context, delivery *modelDB.WebhookDeliveryDB) error
+}
+
+type webhookClientInterface interface {
+	Deliver(ctx context.Context, url, secret, deliveryID, event string, body []byte) (int, error)
+}
+
+// webhookPayload is the JSON body posted to webhook endpoints.
+type webhookPayload struct {
+	Event     model.WebhookEventType `json:"event"`
+	SpaceID   string                 `json:"spaceID"`
+	Timestamp time.Time              `json:"timestamp"`
+	Message   *model.Message         `json:"message,omitempty"`
+	MessageID *string                `json:"messageID,omitempty"`
+	User      *webhookUser           `json:"user,omitempty"`
+}
+
+type webhookUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type UcWebhook struct {
+	repoWebhook repoWebhookInterface
+	repoSpace   repoSpaceInterface
+	client      webhookClientInterface
+	zlog        zerolog.Logger
+}
+
+func NewWebhookUseCase(repoWebhook repoWebhookInterface, repoSpace repoSpaceInterface, client webhookClientInterface, zlog zerolog.Logger) *UcWebhook {
+	return &UcWebhook{
+		repoWebhook: repoWebhook,
+		repoSpace:   repoSpace,
+		client:      client,
+		zlog:        zlog,
+	}
+}
+
+// Dispatch queues a space event for the space's webhooks that subscribe to
+// it. Failures are logged and never fail the caller: the event has already
+// happened.
+func (uc *UcWebhook) Dispatch(ctx context.Context, event *model.SpaceEvent) {
+	eventType, ok := toWebhookEventType(event.Type)
+	if !ok {
+		return
+	}
+
+	webhooks, err := uc.repoWebhook.GetSubscribed(ctx, event.SpaceID, eventType.String())
+	if err != nil {
+		uc.zlog.Error().Err(err).Str("space", event.SpaceID).Msg("failed to get webhooks")
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload := &webhookPayload{
+		Event:     eventType,
+		SpaceID:   event.SpaceID,
+		Timestamp: time.Now().UTC(),
+		Message:   event.Message,
+		MessageID: event.MessageID,
+	}
+
+	if event.User != nil {
+		payload.User = &webhookUser{ID: event.User.ID, Name: event.User.Name}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		uc.zlog.Error().Err(err).Msg(constant.ErrMsgMarshal)
+		return
+	}
+
+	for _, w := range webhooks {
+		err := uc.repoWebhook.CreateDelivery(ctx, &modelDB.WebhookDeliveryDB{
+			WebhookID: w.ID,
+			EventType: eventType.String(),
+			Payload:   data,
+		})
+		if err != nil {
+			uc.zlog.Error().Err(err).Str("webhook", w.ID.String()).Msg("failed to queue webhook delivery")
+		}
+	}
+}
+
+// RunDeliveries sends due webhook deliveries until ctx is done.
+func (uc *UcWebhook) RunDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(constant.WEBHOOK_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		err := uc.DeliverDue(ctx)
+		if err != nil {
+			uc.zlog.Error().Err(err).Msg("failed to deliver webhooks")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue claims due deliveries in batches and sends each batch with a
+// bounded number of concurrent requests.
+func (uc *UcWebhook) DeliverDue(ctx context.Context) error {
+	for {
+		jobs, err := uc.repoWebhook.ClaimDueDeliveries(ctx, time.Now(), constant.WEBHOOK_LEASE, constant.WEBHOOK_BATCH)
+		if err != nil {
+			return constant.ErrWithMsg(constant.ErrGetField("webhook deliveries"), err)
+		}
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, constant.WEBHOOK_WORKERS)
+		for _, job := range jobs {
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func(job *modelDB.WebhookJobDB) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				uc.deliver(ctx, job)
+			}(job)
+		}
+		wg.Wait()
+
+		if len(jobs) < constant.WEBHOOK_BATCH || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// deliver makes one attempt and records it. Failed attempts are retried
+// with exponential backoff until WEBHOOK_MAX_ATTEMPTS is reached.
+func (uc *UcWebhook) deliver(ctx context.Context, job *modelDB.WebhookJobDB) {
+	attemptCtx, cancel := context.WithTimeout(ctx, constant.WEBHOOK_TIMEOUT)
+	defer cancel()
+
+	delivery := &job.WebhookDeliveryDB
+	status, err := uc.client.Deliver(attemptCtx, job.URL, job.Secret, delivery.ID.String(), delivery.EventType, delivery.Payload)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	if err == nil {
+		delivery.Status = constant.WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+		delivery.DeliveredAt = &now
+		delivery.LastError = nil
+	} else {
+		msg := err.Error()
+		delivery.LastError = &msg
+
+		if delivery.Attempts >= constant.WEBHOOK_MAX_ATTEMPTS {
+			delivery.Status = constant.WEBHOOK_DELIVERY_STATUS_FAILED
+		} else {
+			delivery.NextAttemptAt = now.Add(webhook.Backoff(delivery.Attempts, constant.WEBHOOK_BACKOFF_BASE, constant.WEBHOOK_BACKOFF_MAX))
+		}
+
+		uc.zlog.Warn().Err(err).Str("delivery", delivery.ID.String()).Int("attempt", delivery.Attempts).Msg("webhook delivery failed")
+	}
+
+	err = uc.repoWebhook.RecordAttempt(ctx, delivery)
+	if err != nil {
+		uc.zlog.Error().Err(err).Str("delivery", delivery.ID.String()).Msg("failed to record webhook delivery")
+	}
+}
+
+// CreateWebhook subscribes an endpoint to events of a space. The signing
+// secret is only returned here.
+func (uc *UcWebhook) CreateWebhook(ctx context.Context, spaceID string, request model.WebhookRequest) (*model.WebhookRegistration, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := helper.StrToUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceUUID, err := helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.requireAdmin(ctx, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(strings.TrimSpace(request.URL))
+	if err != nil || !safehttp.IsHTTP(u) {
+		return nil, constant.ErrInvalidWebhookURL
+	}
+
+	events := []string{}
+	seen := map[model.WebhookEventType]bool{}
+	for _, e := range request.Events {
+		if e.IsValid() && !seen[e] {
+			seen[e] = true
+			events = append(events, e.String())
+		}
+	}
+
+	if len(events) == 0 {
+		return nil, constant.ErrWebhookNoEvents
+	}
+
+	secret, err := signer.NewSecret()
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("webhook secret"), err)
+	}
+
+	w := &modelDB.WebhookDB{
+		SpaceID:   *spaceUUID,
+		URL:       u.String(),
+		Secret:    secret,
+		Events:    events,
+		CreatedBy: *userUUID,
+	}
+
+	created, err := uc.repoWebhook.Create(ctx, w, constant.WEBHOOK_MAX_PER_SPACE)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("webhook"), err)
+	}
+
+	if !created {
+		return nil, constant.ErrWebhookLimitReached
+	}
+
+	return &model.WebhookRegistration{
+		Webhook: toWebhookModel(w),
+		Secret:  secret,
+	}, nil
+}
+
+func (uc *UcWebhook) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	w, err := uc.adminWebhook(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.repoWebhook.Delete(ctx, w.ID.String())
+	if err != nil {
+		return false, constant.ErrWithMsg(constant.ErrDeletingField("webhook"), err)
+	}
+
+	return true, nil
+}
+
+func (uc *UcWebhook) Webhooks(ctx context.Context, spaceID string) ([]*model.Webhook, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = helper.StrToUUID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.requireAdmin(ctx, spaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.repoWebhook.GetBySpaceID(ctx, spaceID)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("webhooks"), err)
+	}
+
+	resp := []*model.Webhook{}
+	for _, w := range webhooks {
+		resp = append(resp, toWebhookModel(w))
+	}
+
+	return resp, nil
+}
+
+// WebhookDeliveries pages through the delivery log of a webhook.
+func (uc *UcWebhook) WebhookDeliveries(ctx context.Context, webhookID string, first *int32, after *string) (*model.WebhookDeliveryConnection, error) {
+	w, err := uc.adminWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := helper.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := helper.PageSize(first, constant.DEFAULT_PAGE_SIZE, constant.MAX_PAGE_SIZE)
+	deliveries, err := uc.repoWebhook.GetDeliveries(ctx, w.ID.String(), limit+1, offset)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrGetField("webhook deliveries"), err)
+	}
+
+	hasNextPage := len(deliveries) > limit
+	if hasNextPage {
+		deliveries = deliveries[:limit]
+	}
+
+	edges := []*model.WebhookDelivery{}
+	for _, d := range deliveries {
+		edges = append(edges, toWebhookDeliveryModel(d))
+	}
+
+	resp := &model.WebhookDeliveryConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	if len(edges) > 0 {
+		endCursor := helper.EncodeCursor(offset + len(edges))
+		resp.PageInfo.EndCursor = &endCursor
+	}
+
+	return resp, nil
+}
+
+// RedeliverWebhook queues a fresh copy of a past delivery. The original
+// stays in the log unchanged.
+func (uc *UcWebhook) RedeliverWebhook(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	_, err := helper.StrToUUID(deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := uc.repoWebhook.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrWebhookDeliveryNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("webhook delivery"), err)
+	}
+
+	_, err = uc.adminWebhook(ctx, original.WebhookID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &modelDB.WebhookDeliveryDB{
+		WebhookID:    original.WebhookID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		RedeliveryOf: &original.ID,
+	}
+
+	err = uc.repoWebhook.CreateDelivery(ctx, delivery)
+	if err != nil {
+		return nil, constant.ErrWithMsg(constant.ErrCreatingField("webhook delivery"), err)
+	}
+
+	return toWebhookDeliveryModel(delivery), nil
+}
+
+// adminWebhook loads a webhook and checks the user administers its space.
+func (uc *UcWebhook) adminWebhook(ctx context.Context, id string) (*modelDB.WebhookDB, error) {
+	userID, err := authctx.GetAuthUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = helper.StrToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := uc.repoWebhook.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, constant.ErrWebhookNotFound
+		}
+		return nil, constant.ErrWithMsg(constant.ErrGetField("webhook"), err)
+	}
+
+	err = uc.requireAdmin(ctx, w.SpaceID.String(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (uc *UcWebhook) requireAdmin(ctx context.Context, spaceID, userID string) error {
+	role, err := spaceMemberRole(ctx, uc.repoSpace, spaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role != constant.ROLE_ADMIN {
+		return constant.ErrNotSpaceAdmin
+	}
+
+	return nil
+}
+
+func toWebhookEventType(eventType model.SpaceEventType) (model.WebhookEventType, bool) {
+	switch eventType {
+	case model.SpaceEventTypeMessageCreated:
+		return model.WebhookEventTypeMessageCreated, true
+	case model.SpaceEventTypeMessageDeleted:
+		return model.WebhookEventTypeMessageDeleted, true
+	case model.SpaceEventTypeMemberJoined:
+		return model.WebhookEventTypeMemberJoined, true
+	}
+
+	return "", false
+}
+
+func toWebhookModel(w *modelDB.WebhookDB) *model.Webhook {
+	resp := &model.Webhook{
+		ID:        w.ID.String(),
+		SpaceID:   w.SpaceID.String(),
+		URL:       w.URL,
+		Events:    []model.WebhookEventType{},
+		CreatedAt: w.CreatedAt,
+	}
+
+	for _, e := range w.Events {
+		resp.Events = append(resp.Events, model.WebhookEventType(e))
+	}
+
+	return resp
+}
+
+func toWebhookDeliveryModel(d *modelDB.WebhookDeliveryDB) *model.WebhookDelivery {
+	resp := &model.WebhookDelivery{
+		ID:          d.ID.String(),
+		WebhookID:   d.WebhookID.String(),
+		EventType:   model.WebhookEventType(d.EventType),
+		Payload:     string(d.Payload),
+		Status:      model.WebhookDeliveryStatus(strings.ToUpper(d.Status)),
+		Attempts:    int32(d.Attempts),
+		Error:       d.LastError,
+		DeliveredAt: d.DeliveredAt,
+		CreatedAt:   d.CreatedAt,
+	}
+
+	if d.Status == constant.WEBHOOK_DELIVERY_STATUS_PENDING {
+		resp.NextAttemptAt = &d.NextAttemptAt
+	}
+
+	if d.ResponseStatus != nil {
+		status := int32(*d.ResponseStatus)
+		resp.ResponseStatus = &status
+	}
+
+	if d.RedeliveryOf != nil {
+		redeliveryOf := d.RedeliveryOf.String()
+		resp.RedeliveryOf = &redeliveryOf
+	}
+
+	return resp
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"testing"
+
+	"chatspace-server/graph/model"
+)
+
+// Every event a webhook can subscribe to must come from some space event,
+// or subscribers would wait for deliveries that never happen.
+func TestWebhookEventTypesAreEmitted(t *testing.T) {
+	emitted := map[model.WebhookEventType]bool{}
+	for _, eventType := range model.AllSpaceEventType {
+		webhookEvent, ok := toWebhookEventType(eventType)
+		if !ok {
+			continue
+		}
+
+		if !webhookEvent.IsValid() {
+			t.Fatalf("toWebhookEventType(%s) = %s, which is not a WebhookEventType", eventType, webhookEvent)
+		}
+		emitted[webhookEvent] = true
+	}
+
+	for _, webhookEvent := range model.AllWebhookEventType {
+		if !emitted[webhookEvent] {
+			t.Errorf("%s can be subscribed to but no space event produces it", webhookEvent)
+		}
+	}
+}